@@ -26,6 +26,7 @@ func Run() {
 	cmd.AddCommand(
 		convertCommand(),
 		fmtCommand(),
+		graphCommand(),
 		runCommand(),
 		toolsCommand(),
 		validateCommand(),
@@ -0,0 +1,317 @@
+package alloycli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+
+	"github.com/grafana/alloy/internal/featuregate"
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/service"
+	"github.com/grafana/alloy/internal/service/cluster"
+	httpservice "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/service/opamp"
+	"github.com/grafana/alloy/internal/service/otel"
+	"github.com/grafana/alloy/internal/service/remotecfg"
+	"github.com/grafana/alloy/internal/service/ui"
+	"github.com/grafana/alloy/internal/validator"
+)
+
+const (
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+	graphFormatJSON    = "json"
+)
+
+func graphCommand() *cobra.Command {
+	g := &alloyGraph{
+		format:       graphFormatDOT,
+		configFormat: "alloy",
+		minStability: featuregate.StabilityGenerallyAvailable,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "graph [flags] path",
+		Short: "Export the component graph of a configuration",
+		Long: `The graph subcommand loads the configuration at path without running
+any components and writes the graph of components to stdout.
+
+Both the references between components and the direction in which data flows
+between components are included. Custom components and foreach blocks are
+written as subgraphs.
+
+The -o flag can be used to write the graph to a file instead of stdout.`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+
+		RunE: func(_ *cobra.Command, args []string) error {
+			return g.Run(args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&g.format, "format", "f", g.format, fmt.Sprintf("The format of the graph. Supported formats: %s.", strings.Join([]string{graphFormatDOT, graphFormatMermaid, graphFormatJSON}, ", ")))
+	cmd.Flags().StringVarP(&g.output, "output", "o", g.output, "The filepath and filename where the graph is written.")
+
+	// Config flags
+	cmd.Flags().StringVar(&g.configFormat, "config.format", g.configFormat, fmt.Sprintf("The format of the source file. Supported formats: %s.", supportedFormatsList()))
+	cmd.Flags().BoolVar(&g.configBypassConversionErrors, "config.bypass-conversion-errors", g.configBypassConversionErrors, "Enable bypassing errors when converting")
+	cmd.Flags().StringVar(&g.configExtraArgs, "config.extra-args", g.configExtraArgs, "Extra arguments from the original format used by the converter. Multiple arguments can be passed by separating them with a space.")
+
+	// Misc flags
+	cmd.Flags().Var(&g.minStability, "stability.level", fmt.Sprintf("Minimum stability level of features to enable. Supported values: %s", strings.Join(featuregate.AllowedValues(), ", ")))
+	cmd.Flags().BoolVar(&g.enableCommunityComps, "feature.community-components.enabled", g.enableCommunityComps, "Enable community components.")
+
+	return cmd
+}
+
+type alloyGraph struct {
+	format string
+	output string
+
+	configFormat                 string
+	configBypassConversionErrors bool
+	configExtraArgs              string
+
+	minStability         featuregate.Stability
+	enableCommunityComps bool
+}
+
+func (g *alloyGraph) Run(configPath string) error {
+	if err := featuregate.CheckAllowed(
+		featuregate.StabilityExperimental,
+		g.minStability,
+		"the graph command"); err != nil {
+		return err
+	}
+
+	var render func(io.Writer, *alloy_runtime.ComponentGraph) error
+	switch g.format {
+	case graphFormatDOT:
+		render = renderGraphDOT
+	case graphFormatMermaid:
+		render = renderGraphMermaid
+	case graphFormatJSON:
+		render = renderGraphJSON
+	default:
+		return fmt.Errorf("unsupported graph format %q", g.format)
+	}
+
+	sources, err := loadSourceFiles(configPath, g.configFormat, g.configBypassConversionErrors, g.configExtraArgs)
+	if err != nil {
+		return err
+	}
+	source, err := alloy_runtime.ParseSources(sources)
+	if err != nil {
+		validator.Report(os.Stderr, err, sources)
+		return fmt.Errorf("reading config path %q: %w", configPath, err)
+	}
+
+	graph, err := g.load(configPath, source, sources)
+	if err != nil {
+		return err
+	}
+
+	if g.output == "" {
+		return render(os.Stdout, graph)
+	}
+	f, err := os.Create(g.output)
+	if err != nil {
+		return err
+	}
+	if err := render(f, graph); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// load evaluates source without building its components and returns its
+// component graph. configPath is the path source was read from, which
+// module_path refers to.
+func (g *alloyGraph) load(configPath string, source *alloy_runtime.Source, sources map[string][]byte) (*alloy_runtime.ComponentGraph, error) {
+	logger, err := logging.New(io.Discard, logging.DefaultOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Import blocks may write to their data directory when they're evaluated.
+	dataPath, err := os.MkdirTemp("", "alloy-graph-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dataPath)
+
+	services, err := graphServices(logger, dataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := alloy_runtime.LoadGraph(alloy_runtime.Options{
+		Logger:               logger,
+		DataPath:             dataPath,
+		Reg:                  prometheus.NewRegistry(),
+		MinStability:         g.minStability,
+		EnableCommunityComps: g.enableCommunityComps,
+		Services:             services,
+	}, source, configPath)
+
+	// Components which fail to evaluate are still part of the graph. Report
+	// the errors but still write the graph.
+	if err != nil {
+		validator.Report(os.Stderr, err, sources)
+		fmt.Fprintln(os.Stderr, "warning: the configuration was evaluated with errors, the graph may be incomplete")
+	}
+	return graph, nil
+}
+
+// graphServices returns the services of Alloy wrapped so that loading a
+// configuration doesn't start them or apply their configuration blocks.
+func graphServices(logger *logging.Logger, dataPath string) ([]service.Service, error) {
+	clusterService, err := cluster.New(cluster.Options{
+		Log:              logger,
+		EnableClustering: false,
+		NodeName:         "graph",
+		AdvertiseAddress: "127.0.0.1:80",
+	})
+	if err != nil {
+		return nil, err
+	}
+	opampService, err := opamp.New(opamp.Options{
+		Logger:      logger,
+		StoragePath: dataPath,
+		Metrics:     prometheus.NewRegistry(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	remotecfgService, err := remotecfg.New(remotecfg.Options{
+		Logger:      logger,
+		StoragePath: dataPath,
+		Metrics:     prometheus.NewRegistry(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	liveDebuggingService := livedebugging.New()
+
+	services := []service.Service{
+		clusterService,
+		httpservice.New(httpservice.Options{}),
+		labelstore.New(logger, prometheus.NewRegistry()),
+		liveDebuggingService,
+		opampService,
+		otel.New(logger),
+		remotecfgService,
+		ui.New(ui.Options{
+			CallbackManager: liveDebuggingService.Data().(livedebugging.CallbackManager),
+			Logger:          logger,
+		}),
+	}
+	for i, svc := range services {
+		services[i] = offlineService{svc}
+	}
+	return services, nil
+}
+
+// offlineService wraps a service so that it ignores its configuration block.
+// This prevents services such as remotecfg from connecting to remote servers
+// while the graph is loaded.
+type offlineService struct {
+	service.Service
+}
+
+func (s offlineService) Run(ctx context.Context, _ service.Host) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (s offlineService) Update(any) error { return nil }
+
+// graphNodeID returns the ID of a component which is unique across all
+// subgraphs.
+func graphNodeID(g *alloy_runtime.ComponentGraph, localID string) string {
+	return path.Join(g.ID, localID)
+}
+
+func renderGraphJSON(w io.Writer, g *alloy_runtime.ComponentGraph) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+func renderGraphDOT(w io.Writer, g *alloy_runtime.ComponentGraph) error {
+	var sb strings.Builder
+	sb.WriteString("digraph alloy {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	writeDOTGraph(&sb, g, "  ")
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeDOTGraph(sb *strings.Builder, g *alloy_runtime.ComponentGraph, indent string) {
+	for _, c := range g.Components {
+		fmt.Fprintf(sb, "%s%s [label=%s];\n", indent, strconv.Quote(graphNodeID(g, c.ID)), strconv.Quote(c.ID))
+	}
+	for _, sub := range g.Subgraphs {
+		fmt.Fprintf(sb, "%ssubgraph %s {\n", indent, strconv.Quote("cluster_"+sub.ID))
+		fmt.Fprintf(sb, "%s  label=%s;\n", indent, strconv.Quote(sub.ID))
+		writeDOTGraph(sb, sub, indent+"  ")
+		fmt.Fprintf(sb, "%s}\n", indent)
+	}
+	for _, e := range g.Edges {
+		from, to := strconv.Quote(graphNodeID(g, e.From)), strconv.Quote(graphNodeID(g, e.To))
+		switch e.Kind {
+		case alloy_runtime.EdgeReference:
+			fmt.Fprintf(sb, "%s%s -> %s [style=dashed];\n", indent, from, to)
+		default:
+			fmt.Fprintf(sb, "%s%s -> %s;\n", indent, from, to)
+		}
+	}
+}
+
+func renderGraphMermaid(w io.Writer, g *alloy_runtime.ComponentGraph) error {
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	// Mermaid doesn't allow most punctuation in node IDs, so nodes and
+	// subgraphs are given generated IDs.
+	ids := make(map[string]string)
+	writeMermaidGraph(&sb, g, "  ", ids)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMermaidGraph(sb *strings.Builder, g *alloy_runtime.ComponentGraph, indent string, ids map[string]string) {
+	for _, c := range g.Components {
+		id := fmt.Sprintf("n%d", len(ids))
+		ids[graphNodeID(g, c.ID)] = id
+		fmt.Fprintf(sb, "%s%s[%s]\n", indent, id, strconv.Quote(c.ID))
+	}
+	for i, sub := range g.Subgraphs {
+		fmt.Fprintf(sb, "%ssubgraph %s_%d[%s]\n", indent, ids[graphNodeID(g, sub.Parent)], i, strconv.Quote(sub.ID))
+		writeMermaidGraph(sb, sub, indent+"  ", ids)
+		fmt.Fprintf(sb, "%send\n", indent)
+	}
+	for _, e := range g.Edges {
+		from, to := ids[graphNodeID(g, e.From)], ids[graphNodeID(g, e.To)]
+		switch e.Kind {
+		case alloy_runtime.EdgeReference:
+			fmt.Fprintf(sb, "%s%s -.-> %s\n", indent, from, to)
+		default:
+			fmt.Fprintf(sb, "%s%s --> %s\n", indent, from, to)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package alloycli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/featuregate"
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+)
+
+var testComponentGraph = &alloy_runtime.ComponentGraph{
+	Components: []alloy_runtime.GraphComponent{
+		{ID: "pipeline.default", Name: "pipeline", Label: "default", Type: "custom"},
+		{ID: "prometheus.remote_write.default", Name: "prometheus.remote_write", Label: "default", Type: "builtin"},
+	},
+	Edges: []alloy_runtime.GraphEdge{
+		{From: "pipeline.default", To: "prometheus.remote_write.default", Kind: alloy_runtime.EdgeReference},
+		{From: "pipeline.default", To: "prometheus.remote_write.default", Kind: alloy_runtime.EdgeDataFlow},
+	},
+	Subgraphs: []*alloy_runtime.ComponentGraph{{
+		ID:     "pipeline.default",
+		Parent: "pipeline.default",
+		Components: []alloy_runtime.GraphComponent{
+			{ID: "discovery.relabel.default", Name: "discovery.relabel", Label: "default", Type: "builtin"},
+			{ID: "prometheus.scrape.default", Name: "prometheus.scrape", Label: "default", Type: "builtin"},
+		},
+		Edges: []alloy_runtime.GraphEdge{
+			{From: "discovery.relabel.default", To: "prometheus.scrape.default", Kind: alloy_runtime.EdgeDataFlow},
+			{From: "prometheus.scrape.default", To: "discovery.relabel.default", Kind: alloy_runtime.EdgeReference},
+		},
+	}},
+}
+
+func TestRenderGraphDOT(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, renderGraphDOT(&sb, testComponentGraph))
+
+	expect := `digraph alloy {
+  rankdir=LR;
+  "pipeline.default" [label="pipeline.default"];
+  "prometheus.remote_write.default" [label="prometheus.remote_write.default"];
+  subgraph "cluster_pipeline.default" {
+    label="pipeline.default";
+    "pipeline.default/discovery.relabel.default" [label="discovery.relabel.default"];
+    "pipeline.default/prometheus.scrape.default" [label="prometheus.scrape.default"];
+    "pipeline.default/discovery.relabel.default" -> "pipeline.default/prometheus.scrape.default";
+    "pipeline.default/prometheus.scrape.default" -> "pipeline.default/discovery.relabel.default" [style=dashed];
+  }
+  "pipeline.default" -> "prometheus.remote_write.default" [style=dashed];
+  "pipeline.default" -> "prometheus.remote_write.default";
+}
+`
+	require.Equal(t, expect, sb.String())
+}
+
+func TestRenderGraphMermaid(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, renderGraphMermaid(&sb, testComponentGraph))
+
+	expect := `flowchart LR
+  n0["pipeline.default"]
+  n1["prometheus.remote_write.default"]
+  subgraph n0_0["pipeline.default"]
+    n2["discovery.relabel.default"]
+    n3["prometheus.scrape.default"]
+    n2 --> n3
+    n3 -.-> n2
+  end
+  n0 -.-> n1
+  n0 --> n1
+`
+	require.Equal(t, expect, sb.String())
+}
+
+func TestGraphRelativeImport(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	writeFile("main.alloy", `
+import.file "lib" {
+	filename = file.path_join(module_path, "lib.alloy")
+}
+
+lib.targets "default" { }
+`)
+	writeFile("lib.alloy", `
+declare "targets" {
+	discovery.relabel "default" {
+		targets = []
+	}
+}
+`)
+
+	output := filepath.Join(dir, "graph.json")
+	g := &alloyGraph{
+		format:       graphFormatJSON,
+		output:       output,
+		configFormat: "alloy",
+		minStability: featuregate.StabilityExperimental,
+	}
+	require.NoError(t, g.Run(filepath.Join(dir, "main.alloy")))
+
+	buf, err := os.ReadFile(output)
+	require.NoError(t, err)
+	var graph alloy_runtime.ComponentGraph
+	require.NoError(t, json.Unmarshal(buf, &graph))
+
+	require.Len(t, graph.Subgraphs, 1)
+	require.Equal(t, "lib.targets.default", graph.Subgraphs[0].Parent)
+	require.Equal(t, []alloy_runtime.GraphComponent{
+		{ID: "discovery.relabel.default", Name: "discovery.relabel", Label: "default", Type: "builtin"},
+	}, graph.Subgraphs[0].Components)
+}
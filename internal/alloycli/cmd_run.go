@@ -34,6 +34,7 @@ import (
 	convert_diag "github.com/grafana/alloy/internal/converter/diag"
 	"github.com/grafana/alloy/internal/featuregate"
 	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	"github.com/grafana/alloy/internal/runtime/componentprof"
 	"github.com/grafana/alloy/internal/runtime/logging"
 	"github.com/grafana/alloy/internal/runtime/logging/level"
 	"github.com/grafana/alloy/internal/runtime/tracing"
@@ -41,11 +42,13 @@ import (
 	httpservice "github.com/grafana/alloy/internal/service/http"
 	"github.com/grafana/alloy/internal/service/labelstore"
 	"github.com/grafana/alloy/internal/service/livedebugging"
+	opampservice "github.com/grafana/alloy/internal/service/opamp"
 	otel_service "github.com/grafana/alloy/internal/service/otel"
 	remotecfgservice "github.com/grafana/alloy/internal/service/remotecfg"
 	uiservice "github.com/grafana/alloy/internal/service/ui"
 	"github.com/grafana/alloy/internal/static/config/instrumentation"
 	"github.com/grafana/alloy/internal/usagestats"
+	"github.com/grafana/alloy/internal/util/profilerate"
 	"github.com/grafana/alloy/internal/util/windowspriority"
 	"github.com/grafana/alloy/syntax/diag"
 
@@ -69,6 +72,11 @@ func runCommand() *cobra.Command {
 		disableSupportBundle:  false,
 		windowsPriority:       windowspriority.PriorityNormal,
 		taskShutdownDeadline:  10 * time.Minute,
+		restartInitialBackoff: time.Second,
+		restartMaxBackoff:     5 * time.Minute,
+		rollbackGracePeriod:   time.Minute,
+		cpuMetricsInterval:    time.Minute,
+		cpuMetricsProfileTime: 10 * time.Second,
 	}
 
 	cmd := &cobra.Command{
@@ -168,6 +176,16 @@ depending on the nature of the reload error.
 		cmd.Flags().StringVar(&r.windowsPriority, "windows.priority", r.windowsPriority, fmt.Sprintf("Process priority to use when running on windows. This flag is currently in public preview. Supported values: %s", strings.Join(slices.Collect(windowspriority.PriorityValues()), ", ")))
 	}
 	cmd.Flags().DurationVar(&r.taskShutdownDeadline, "feature.component-shutdown-deadline", r.taskShutdownDeadline, "Maximum duration to wait for a component to shut down before giving up and logging an error")
+	cmd.Flags().IntVar(&r.restartMaxAttempts, "feature.component-restart.max-attempts", r.restartMaxAttempts, "Maximum number of consecutive restarts of a component which exited with an error. Set to 0 to disable restarts, or to -1 to restart indefinitely. This flag is experimental.")
+	cmd.Flags().DurationVar(&r.restartInitialBackoff, "feature.component-restart.initial-backoff", r.restartInitialBackoff, "Delay before the first restart of a component which exited with an error")
+	cmd.Flags().DurationVar(&r.restartMaxBackoff, "feature.component-restart.max-backoff", r.restartMaxBackoff, "Maximum delay between two restarts of a component which exited with an error")
+	cmd.Flags().BoolVar(&r.rollbackEnabled, "feature.config-rollback.enabled", r.rollbackEnabled, "Keep a last-known-good copy of the configuration and roll back reloads which fail or make components unhealthy. This flag is experimental.")
+	cmd.Flags().DurationVar(&r.rollbackGracePeriod, "feature.config-rollback.grace-period", r.rollbackGracePeriod, "How long to check the health of the components after a reload")
+	cmd.Flags().IntVar(&r.rollbackMaxNewUnhealthy, "feature.config-rollback.max-new-unhealthy", r.rollbackMaxNewUnhealthy, "Maximum number of components which may become unhealthy during the grace period of a reload before it's rolled back")
+	cmd.Flags().BoolVar(&r.dryRunEnabled, "feature.config-dry-run.enabled", r.dryRunEnabled, "Serve the /-/dry-run endpoint to evaluate a configuration against the running instance without applying it. This flag is experimental.")
+	cmd.Flags().BoolVar(&r.cpuMetricsEnabled, "feature.component-cpu-metrics.enabled", r.cpuMetricsEnabled, "Periodically record CPU profiles to export the estimated CPU time used by each component. This flag is experimental.")
+	cmd.Flags().DurationVar(&r.cpuMetricsInterval, "feature.component-cpu-metrics.interval", r.cpuMetricsInterval, "How often to record a CPU profile to estimate the CPU time used by each component")
+	cmd.Flags().DurationVar(&r.cpuMetricsProfileTime, "feature.component-cpu-metrics.profile-duration", r.cpuMetricsProfileTime, "How long each CPU profile used to estimate the CPU time of components is recorded for")
 
 	addDeprecatedFlags(cmd)
 	return cmd
@@ -204,6 +222,16 @@ type alloyRun struct {
 	disableSupportBundle         bool
 	windowsPriority              string
 	taskShutdownDeadline         time.Duration
+	restartMaxAttempts           int
+	restartInitialBackoff        time.Duration
+	restartMaxBackoff            time.Duration
+	rollbackEnabled              bool
+	rollbackGracePeriod          time.Duration
+	rollbackMaxNewUnhealthy      int
+	dryRunEnabled                bool
+	cpuMetricsEnabled            bool
+	cpuMetricsInterval           time.Duration
+	cpuMetricsProfileTime        time.Duration
 }
 
 func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
@@ -245,6 +273,52 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 		}
 	}
 
+	if fr.restartMaxAttempts != 0 {
+		if err := featuregate.CheckAllowed(
+			featuregate.StabilityExperimental,
+			fr.minStability,
+			"component restart policy"); err != nil {
+			return err
+		}
+		if fr.restartInitialBackoff <= 0 || fr.restartMaxBackoff < fr.restartInitialBackoff {
+			return fmt.Errorf("the component restart initial backoff must be positive and at most the max backoff")
+		}
+	}
+
+	if fr.rollbackEnabled {
+		if err := featuregate.CheckAllowed(
+			featuregate.StabilityExperimental,
+			fr.minStability,
+			"configuration rollback"); err != nil {
+			return err
+		}
+	}
+
+	if fr.dryRunEnabled {
+		if err := featuregate.CheckAllowed(
+			featuregate.StabilityExperimental,
+			fr.minStability,
+			"configuration dry runs"); err != nil {
+			return err
+		}
+	}
+
+	cpuMetricsOpts := componentprof.SamplerOptions{
+		Interval:        fr.cpuMetricsInterval,
+		ProfileDuration: fr.cpuMetricsProfileTime,
+	}
+	if fr.cpuMetricsEnabled {
+		if err := featuregate.CheckAllowed(
+			featuregate.StabilityExperimental,
+			fr.minStability,
+			"component CPU metrics"); err != nil {
+			return err
+		}
+		if err := cpuMetricsOpts.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// Set the global tracer provider to catch global traces, but ideally things
 	// use the tracer provider given to them so the appropriate attributes get
 	// injected.
@@ -286,8 +360,10 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 	// To work around this, we lazily create variables for the functions the HTTP
 	// service needs and set them after the Alloy controller exists.
 	var (
-		reload func() (map[string][]byte, error)
-		ready  func() bool
+		reload       func() (map[string][]byte, error)
+		ready        func() bool
+		reloadStatus func() any
+		dryRun       func(sources map[string][]byte) (any, error)
 	)
 
 	clusterService, err := buildClusterService(ClusterOptions{
@@ -324,6 +400,18 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 		})
 	}
 
+	// The status of reloads is only tracked when configuration rollback is
+	// enabled.
+	var reloadStatusFunc func() any
+	if fr.rollbackEnabled {
+		reloadStatusFunc = func() any { return reloadStatus() }
+	}
+
+	var dryRunFunc func(sources map[string][]byte) (any, error)
+	if fr.dryRunEnabled {
+		dryRunFunc = func(sources map[string][]byte) (any, error) { return dryRun(sources) }
+	}
+
 	httpService := httpservice.New(httpservice.Options{
 		Logger:   l,
 		Tracer:   t,
@@ -334,6 +422,8 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 			_, err := reload()
 			return err
 		},
+		ReloadStatusFunc: reloadStatusFunc,
+		DryRunFunc:       dryRunFunc,
 
 		HTTPListenAddr:   fr.httpListenAddr,
 		MemoryListenAddr: fr.inMemoryAddr,
@@ -345,16 +435,68 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 		},
 	})
 
-	remoteCfgService, err := remotecfgservice.New(remotecfgservice.Options{
+	// Remote configuration is loaded into the isolated controller of the
+	// remotecfg service, so it has its own last-known-good configuration.
+	var (
+		remoteCfgService  *remotecfgservice.Service
+		remoteCfgRollback *configRollback
+		wrapRemoteCfgLoad func(load func(b []byte) error) func(b []byte) error
+	)
+	if fr.rollbackEnabled {
+		var loadRemoteCfg func(b []byte) error
+		remoteCfgRollback, err = newConfigRollback(ctx, configRollbackOptions{
+			Logger:          log.With(l, "component", "config_rollback", "config", rollbackConfigRemotecfg),
+			StoragePath:     filepath.Join(fr.storagePath, remotecfgservice.ServiceName),
+			Metrics:         prometheus.WrapRegistererWith(prometheus.Labels{"config": rollbackConfigRemotecfg}, reg),
+			GracePeriod:     fr.rollbackGracePeriod,
+			MaxNewUnhealthy: fr.rollbackMaxNewUnhealthy,
+			Load: func(sources map[string][]byte) error {
+				return loadRemoteCfg(sources[remotecfgservice.ServiceName])
+			},
+			Components: func() []*component.Info {
+				host := remoteCfgService.Data().(remotecfgservice.Data).Host
+				if host == nil {
+					return nil
+				}
+				return component.GetAllComponents(host, component.InfoOptions{GetHealth: true})
+			},
+		})
+		if err != nil {
+			return err
+		}
+		wrapRemoteCfgLoad = func(load func(b []byte) error) func(b []byte) error {
+			loadRemoteCfg = load
+			return func(b []byte) error {
+				return remoteCfgRollback.Load(map[string][]byte{remotecfgservice.ServiceName: b})
+			}
+		}
+	}
+
+	remoteCfgService, err = remotecfgservice.New(remotecfgservice.Options{
 		Logger:      log.With(l, "service", "remotecfg"),
 		ConfigPath:  configPath,
 		StoragePath: fr.storagePath,
 		Metrics:     reg,
+		WrapLoad:    wrapRemoteCfgLoad,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create the remotecfg service: %w", err)
 	}
 
+	opampService, err := opampservice.New(opampservice.Options{
+		Logger:      log.With(l, "service", "opamp"),
+		ConfigPath:  configPath,
+		StoragePath: fr.storagePath,
+		Metrics:     reg,
+		ReloadFunc: func() error {
+			_, err := reload()
+			return err
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the opamp service: %w", err)
+	}
+
 	liveDebuggingService := livedebugging.New()
 
 	uiService := uiservice.New(uiservice.Options{
@@ -383,33 +525,74 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 			httpService,
 			labelService,
 			liveDebuggingService,
+			opampService,
 			otelService,
 			remoteCfgService,
 			uiService,
 		},
 		TaskShutdownDeadline: fr.taskShutdownDeadline,
+		ComponentRestartPolicy: alloy_runtime.RestartPolicy{
+			MaxAttempts:    fr.restartMaxAttempts,
+			InitialBackoff: fr.restartInitialBackoff,
+			MaxBackoff:     fr.restartMaxBackoff,
+		},
 	})
 
 	ready = f.Ready
-	reload = func() (map[string][]byte, error) {
-		sources, err := loadSourceFiles(configPath, fr.configFormat, fr.configBypassConversionErrors, fr.configExtraArgs)
+	dryRun = func(sources map[string][]byte) (any, error) {
+		res, err := f.DryRun(sources, configPath)
 		if err != nil {
-			instrumentation.InstrumentConfig(false, [32]byte{}, fr.clusterName)
-			return nil, fmt.Errorf("reading config path %q: %w", configPath, err)
+			return nil, err
 		}
-
+		return newDryRunResult(res), nil
+	}
+	loadSources := func(sources map[string][]byte) error {
 		alloySource, err := alloy_runtime.ParseSources(sources)
 		defer instrumentation.InstrumentConfig(err == nil, hashSourceFiles(sources), fr.clusterName)
 		if err != nil {
-			return sources, fmt.Errorf("reading config path %q: %w", configPath, err)
+			return fmt.Errorf("reading config path %q: %w", configPath, err)
 		}
 
 		httpService.SetSources(alloySource.SourceFiles())
+		opampService.SetSources(alloySource.SourceFiles())
 		if err := f.LoadSource(alloySource, nil, configPath); err != nil {
-			return sources, fmt.Errorf("error during the initial load: %w", err)
+			return fmt.Errorf("error during the initial load: %w", err)
 		}
+		return nil
+	}
 
-		return sources, nil
+	if fr.rollbackEnabled {
+		rollback, err := newConfigRollback(ctx, configRollbackOptions{
+			Logger:          log.With(l, "component", "config_rollback", "config", rollbackConfigFile),
+			StoragePath:     fr.storagePath,
+			Metrics:         prometheus.WrapRegistererWith(prometheus.Labels{"config": rollbackConfigFile}, reg),
+			GracePeriod:     fr.rollbackGracePeriod,
+			MaxNewUnhealthy: fr.rollbackMaxNewUnhealthy,
+			Load:            loadSources,
+			Components: func() []*component.Info {
+				return component.GetAllComponents(f, component.InfoOptions{GetHealth: true})
+			},
+		})
+		if err != nil {
+			return err
+		}
+		loadSources = rollback.Load
+		reloadStatus = func() any {
+			statuses := reloadStatuses{File: rollback.Status()}
+			if status := remoteCfgRollback.Status(); status.Outcome != "" {
+				statuses.Remotecfg = &status
+			}
+			return statuses
+		}
+	}
+
+	reload = func() (map[string][]byte, error) {
+		sources, err := loadSourceFiles(configPath, fr.configFormat, fr.configBypassConversionErrors, fr.configExtraArgs)
+		if err != nil {
+			instrumentation.InstrumentConfig(false, [32]byte{}, fr.clusterName)
+			return nil, fmt.Errorf("reading config path %q: %w", configPath, err)
+		}
+		return sources, loadSources(sources)
 	}
 
 	// Alloy controller
@@ -421,6 +604,19 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 		}()
 	}
 
+	// Per-component CPU metrics
+	if fr.cpuMetricsEnabled {
+		sampler, err := componentprof.NewSampler(log.With(l, "component", "component_cpu_sampler"), reg, cpuMetricsOpts)
+		if err != nil {
+			return fmt.Errorf("failed to create component CPU sampler: %w", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sampler.Run(ctx)
+		}()
+	}
+
 	// Report usage of enabled components
 	if !fr.disableReporting {
 		reporter, err := usagestats.NewReporter(l)
@@ -438,7 +634,11 @@ func (fr *alloyRun) Run(cmd *cobra.Command, configPath string) error {
 	// Perform the initial reload. This is done after starting the HTTP server so
 	// that /metric and pprof endpoints are available while the Alloy controller
 	// is loading.
-	if source, err := reload(); err != nil {
+	var rollbackErr *rollbackError
+	if source, err := reload(); errors.As(err, &rollbackErr) {
+		// Keep running if the last-known-good configuration was loaded instead.
+		level.Error(l).Log("msg", "failed to load config, running the last-known-good configuration", "err", err)
+	} else if err != nil {
 		var diags diag.Diagnostics
 		if errors.As(err, &diags) {
 			p := diag.NewPrinter(diag.PrinterConfig{
@@ -633,14 +833,14 @@ func setMutexBlockProfiling(l log.Logger) {
 	if blockRate != "" {
 		rate, err := strconv.Atoi(blockRate)
 		if err == nil && rate > 0 {
-			runtime.SetBlockProfileRate(rate)
+			profilerate.SetBlockProfileRate(rate)
 		} else {
 			level.Error(l).Log("msg", "error setting PPROF_BLOCK_PROFILING_RATE", "err", err, "value", blockRate)
-			runtime.SetBlockProfileRate(10_000)
+			profilerate.SetBlockProfileRate(10_000)
 		}
 	} else {
 		// This should have a negligible impact. This will track anything over 10_000ns, and will randomly sample shorter durations.
 		// Default taken from https://github.com/DataDog/go-profiler-notes/blob/main/block.md
-		runtime.SetBlockProfileRate(10_000)
+		profilerate.SetBlockProfileRate(10_000)
 	}
 }
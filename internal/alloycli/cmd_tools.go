@@ -3,6 +3,7 @@ package alloycli
 import (
 	"fmt"
 
+	"github.com/grafana/alloy/internal/component/otelcol/storage"
 	"github.com/grafana/alloy/internal/component/prometheus/remotewrite"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +16,7 @@ func toolsCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		getTools("otelcol.storage", storage.InstallTools),
 		getTools("prometheus.remote_write", remotewrite.InstallTools),
 	)
 
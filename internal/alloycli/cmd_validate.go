@@ -13,6 +13,7 @@ import (
 	"github.com/grafana/alloy/internal/service/http"
 	"github.com/grafana/alloy/internal/service/labelstore"
 	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/service/opamp"
 	"github.com/grafana/alloy/internal/service/otel"
 	"github.com/grafana/alloy/internal/service/remotecfg"
 	"github.com/grafana/alloy/internal/service/ui"
@@ -27,9 +28,13 @@ func validateCommand() *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:          "validate [flags] file",
-		Short:        "Validate a configuration file",
-		Long:         ``,
+		Use:   "validate [flags] file",
+		Short: "Validate a configuration file",
+		Long: `The validate subcommand checks the configuration file at file for errors.
+
+The --against flag sends the configuration to a running Alloy instance
+instead, which evaluates it without applying it and reports the components
+which would be created, updated or removed.`,
 		Args:         cobra.ExactArgs(1),
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, args []string) error {
@@ -42,6 +47,8 @@ func validateCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&v.configBypassConversionErrors, "config.bypass-conversion-errors", v.configBypassConversionErrors, "Enable bypassing errors when converting")
 	cmd.Flags().StringVar(&v.configExtraArgs, "config.extra-args", v.configExtraArgs, "Extra arguments from the original format used by the converter. Multiple arguments can be passed by separating them with a space.")
 
+	cmd.Flags().StringVar(&v.against, "against", v.against, "Address of a running Alloy instance, such as http://localhost:12345, to evaluate the configuration against. This flag is experimental.")
+
 	// Misc flags
 	cmd.Flags().Var(&v.minStability, "stability.level", fmt.Sprintf("Minimum stability level of features to enable. Supported values: %s", strings.Join(featuregate.AllowedValues(), ", ")))
 	cmd.Flags().BoolVar(&v.enableCommunityComps, "feature.community-components.enabled", v.enableCommunityComps, "Enable community components.")
@@ -54,6 +61,8 @@ type alloyValidate struct {
 	configBypassConversionErrors bool
 	configExtraArgs              string
 
+	against string
+
 	minStability         featuregate.Stability
 	enableCommunityComps bool
 }
@@ -64,6 +73,10 @@ func (v *alloyValidate) Run(configFile string) error {
 		return err
 	}
 
+	if v.against != "" {
+		return v.runAgainst(sources)
+	}
+
 	if err := validator.Validate(
 		validator.Options{
 			Sources: sources,
@@ -72,6 +85,7 @@ func (v *alloyValidate) Run(configFile string) error {
 				&http.Service{},
 				&labelstore.Service{},
 				&livedebugging.Service{},
+				&opamp.Service{},
 				&otel.Service{},
 				&remotecfg.Service{},
 				&ui.Service{},
@@ -87,6 +101,31 @@ func (v *alloyValidate) Run(configFile string) error {
 	return nil
 }
 
+// runAgainst evaluates sources with the dry-run endpoint of the Alloy instance
+// in v.against.
+func (v *alloyValidate) runAgainst(sources map[string][]byte) error {
+	if err := featuregate.CheckAllowed(
+		featuregate.StabilityExperimental,
+		v.minStability,
+		"validating against a running instance"); err != nil {
+		return err
+	}
+
+	res, err := requestDryRun(v.against, sources)
+	if err != nil {
+		return err
+	}
+
+	if diags := res.diagnostics(); len(diags) > 0 {
+		validator.Report(os.Stderr, diags, sources)
+	}
+	if !res.Valid {
+		return errors.New("validation failed")
+	}
+	res.writeChanges(os.Stdout)
+	return nil
+}
+
 func getServiceDefinitions(services ...service.Service) []service.Definition {
 	def := make([]service.Definition, 0, len(services))
 	for _, s := range services {
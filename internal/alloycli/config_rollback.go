@@ -0,0 +1,433 @@
+package alloycli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/exp/maps"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// lastKnownGoodFile is the name of the file in the storage path where the
+// last-known-good configuration is stored.
+const lastKnownGoodFile = "last_known_good.json"
+
+// healthCheckInterval is how often the health of the components is checked
+// during the grace period of a reload.
+const healthCheckInterval = time.Second
+
+// Configurations which are rolled back, used to label the rollback metrics.
+const (
+	rollbackConfigFile      = "file"
+	rollbackConfigRemotecfg = "remotecfg"
+)
+
+// Outcomes of a configuration reload.
+const (
+	reloadPending    = "pending"
+	reloadSucceeded  = "succeeded"
+	reloadRolledBack = "rolled_back"
+	reloadFailed     = "failed"
+)
+
+// configRollbackOptions configures a configRollback.
+type configRollbackOptions struct {
+	Logger      log.Logger
+	StoragePath string
+	Metrics     prometheus.Registerer
+
+	// GracePeriod is how long the health of the components is checked after a
+	// reload.
+	GracePeriod time.Duration
+
+	// MaxNewUnhealthy is the maximum number of components which may become
+	// unhealthy during the grace period of a reload before it's rolled back.
+	MaxNewUnhealthy int
+
+	// Load loads configuration sources into the Alloy controller.
+	Load func(sources map[string][]byte) error
+
+	// Components returns the components currently running in the Alloy
+	// controller, with their health.
+	Components func() []*component.Info
+}
+
+// configRollback keeps a last-known-good copy of the configuration loaded by
+// Alloy, and rolls back reloads which fail to load or which make components
+// unhealthy.
+type configRollback struct {
+	opts    configRollbackOptions
+	path    string
+	metrics *configRollbackMetrics
+
+	mut      sync.Mutex
+	ctx      context.Context
+	lastGood map[string][]byte
+	status   reloadStatus
+	cancel   context.CancelFunc // Cancels the health check of a pending reload.
+}
+
+// reloadStatus is the outcome of the last configuration reload.
+type reloadStatus struct {
+	Outcome             string    `json:"outcome"`
+	Message             string    `json:"message,omitempty"`
+	Time                time.Time `json:"time"`
+	ConfigHash          string    `json:"configHash"`
+	LastKnownGoodHash   string    `json:"lastKnownGoodHash,omitempty"`
+	UnhealthyComponents []string  `json:"unhealthyComponents,omitempty"`
+	Diff                string    `json:"diff,omitempty"`
+}
+
+// reloadStatuses is the outcome of the last reload of each configuration,
+// served by the /-/reload/status endpoint. Remotecfg is nil until remote
+// configuration is loaded.
+type reloadStatuses struct {
+	File      reloadStatus  `json:"file"`
+	Remotecfg *reloadStatus `json:"remotecfg,omitempty"`
+}
+
+// lastKnownGood is the representation of the last-known-good configuration in
+// the storage path.
+type lastKnownGood struct {
+	SavedAt time.Time         `json:"savedAt"`
+	Sources map[string]string `json:"sources"`
+}
+
+// rollbackError is returned when a configuration failed to load and the
+// last-known-good configuration was loaded instead.
+type rollbackError struct {
+	err error
+}
+
+func (e *rollbackError) Error() string {
+	return fmt.Sprintf("%s; rolled back to the last-known-good configuration", e.err)
+}
+
+func (e *rollbackError) Unwrap() error { return e.err }
+
+// newConfigRollback creates a configRollback. The last-known-good
+// configuration of a previous run is read from the storage path. Health checks
+// of pending reloads are stopped when ctx is canceled.
+func newConfigRollback(ctx context.Context, opts configRollbackOptions) (*configRollback, error) {
+	r := &configRollback{
+		opts:    opts,
+		path:    filepath.Join(opts.StoragePath, lastKnownGoodFile),
+		metrics: newConfigRollbackMetrics(opts.Metrics),
+		ctx:     ctx,
+	}
+
+	bb, err := os.ReadFile(r.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return r, nil
+	case err != nil:
+		return nil, fmt.Errorf("reading last-known-good configuration: %w", err)
+	}
+
+	var lkg lastKnownGood
+	if err := json.Unmarshal(bb, &lkg); err != nil {
+		level.Warn(opts.Logger).Log("msg", "ignoring invalid last-known-good configuration", "path", r.path, "err", err)
+		return r, nil
+	}
+	r.lastGood = make(map[string][]byte, len(lkg.Sources))
+	for name, content := range lkg.Sources {
+		r.lastGood[name] = []byte(content)
+	}
+	r.metrics.lastKnownGoodTimestamp.Set(float64(lkg.SavedAt.Unix()))
+	return r, nil
+}
+
+// Load loads sources. If sources fail to load, the last-known-good
+// configuration is loaded instead and a *rollbackError is returned.
+//
+// If sources load successfully, the health of the components is checked
+// during the grace period. Sources are rolled back as soon as more than the
+// allowed number of components became unhealthy, and become the
+// last-known-good configuration once the grace period is over otherwise.
+func (r *configRollback) Load(sources map[string][]byte) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	// A new reload supersedes the health check of a pending one.
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+
+	before := unhealthyComponents(r.opts.Components())
+	err := r.opts.Load(sources)
+
+	status := reloadStatus{
+		Time:       time.Now(),
+		ConfigHash: fmt.Sprintf("%x", hashSourceFiles(sources)),
+		Diff:       r.diff(sources),
+	}
+	if r.lastGood != nil {
+		status.LastKnownGoodHash = fmt.Sprintf("%x", hashSourceFiles(r.lastGood))
+	}
+
+	switch {
+	case err != nil && r.lastGood == nil:
+		status.Outcome = reloadFailed
+		status.Message = err.Error()
+		r.setStatus(status)
+		return err
+
+	case err != nil:
+		status.Message = err.Error()
+		if rollbackErr := r.rollback(&status); rollbackErr != nil {
+			return fmt.Errorf("%w; rolling back to the last-known-good configuration failed: %s", err, rollbackErr)
+		}
+		return &rollbackError{err: err}
+
+	case r.lastGood == nil:
+		// There's no configuration to roll back to, so the first configuration
+		// which loads successfully is the last-known-good one.
+		status.Outcome = reloadSucceeded
+		r.setStatus(status)
+		r.saveLastGood(sources)
+		return nil
+	}
+
+	status.Outcome = reloadPending
+	r.setStatus(status)
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	r.cancel = cancel
+	go r.checkHealth(ctx, r.opts.GracePeriod, sources, before, status)
+	return nil
+}
+
+// checkHealth polls the health of the components until the grace period of a
+// reload is over. The reload is rolled back as soon as more than the allowed
+// number of components were seen unhealthy after it, even if they recovered
+// since.
+func (r *configRollback) checkHealth(ctx context.Context, gracePeriod time.Duration, sources map[string][]byte, before map[string]struct{}, status reloadStatus) {
+	var (
+		deadline     = time.Now().Add(gracePeriod)
+		interval     = min(healthCheckInterval, gracePeriod)
+		newUnhealthy = make(map[string]struct{})
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		if r.observeHealth(ctx, sources, before, newUnhealthy, time.Now().After(deadline), status) {
+			return
+		}
+	}
+}
+
+// observeHealth records the components which became unhealthy after a reload
+// in newUnhealthy, and rolls the reload back if there are too many of them.
+// If the reload isn't rolled back and last is true, sources become the
+// last-known-good configuration.
+//
+// observeHealth returns true once the health check of the reload is over.
+func (r *configRollback) observeHealth(ctx context.Context, sources map[string][]byte, before, newUnhealthy map[string]struct{}, last bool, status reloadStatus) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	if ctx.Err() != nil {
+		// The reload was superseded while waiting for the lock.
+		return true
+	}
+
+	for id := range unhealthyComponents(r.opts.Components()) {
+		if _, ok := before[id]; !ok {
+			newUnhealthy[id] = struct{}{}
+		}
+	}
+	ids := maps.Keys(newUnhealthy)
+	slices.Sort(ids)
+	status.UnhealthyComponents = ids
+
+	switch {
+	case len(ids) > r.opts.MaxNewUnhealthy:
+		r.cancel = nil
+		status.Message = fmt.Sprintf("%d components became unhealthy after the reload: %s", len(ids), strings.Join(ids, ", "))
+		level.Warn(r.opts.Logger).Log("msg", "rolling back configuration reload", "reason", status.Message)
+		if err := r.rollback(&status); err != nil {
+			level.Error(r.opts.Logger).Log("msg", "failed to roll back to the last-known-good configuration", "err", err)
+		}
+		return true
+
+	case last:
+		r.cancel = nil
+		status.Outcome = reloadSucceeded
+		r.setStatus(status)
+		r.saveLastGood(sources)
+		level.Info(r.opts.Logger).Log("msg", "configuration reload passed its health check", "config_hash", status.ConfigHash)
+		return true
+
+	default:
+		return false
+	}
+}
+
+// rollback loads the last-known-good configuration and records the outcome
+// in status. r.mut must be held when calling rollback.
+func (r *configRollback) rollback(status *reloadStatus) error {
+	if err := r.opts.Load(r.lastGood); err != nil {
+		status.Outcome = reloadFailed
+		status.Message = fmt.Sprintf("%s; rolling back failed: %s", status.Message, err)
+		r.setStatus(*status)
+		return err
+	}
+
+	status.Outcome = reloadRolledBack
+	r.setStatus(*status)
+	return nil
+}
+
+// setStatus records the outcome of a reload. r.mut must be held when calling
+// setStatus.
+func (r *configRollback) setStatus(status reloadStatus) {
+	r.status = status
+	if status.Outcome != reloadPending {
+		r.metrics.reloads.WithLabelValues(status.Outcome).Inc()
+	}
+}
+
+// saveLastGood makes sources the last-known-good configuration. r.mut must be
+// held when calling saveLastGood.
+func (r *configRollback) saveLastGood(sources map[string][]byte) {
+	r.lastGood = sources
+
+	lkg := lastKnownGood{
+		SavedAt: time.Now(),
+		Sources: make(map[string]string, len(sources)),
+	}
+	for name, content := range sources {
+		lkg.Sources[name] = string(content)
+	}
+	r.metrics.lastKnownGoodTimestamp.Set(float64(lkg.SavedAt.Unix()))
+
+	bb, err := json.Marshal(lkg)
+	if err == nil {
+		err = writeFileAtomic(r.path, bb)
+	}
+	if err != nil {
+		level.Error(r.opts.Logger).Log("msg", "failed to save the last-known-good configuration", "path", r.path, "err", err)
+	}
+}
+
+// diff returns a unified diff between the last-known-good configuration and
+// sources, and updates the diff metrics.
+func (r *configRollback) diff(sources map[string][]byte) string {
+	names := append(maps.Keys(r.lastGood), maps.Keys(sources)...)
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	var (
+		sb             strings.Builder
+		added, removed int
+	)
+	for _, name := range names {
+		oldName, newName := name, name
+		if _, ok := r.lastGood[name]; !ok {
+			oldName = "/dev/null"
+		}
+		if _, ok := sources[name]; !ok {
+			newName = "/dev/null"
+		}
+
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(r.lastGood[name])),
+			B:        difflib.SplitLines(string(sources[name])),
+			FromFile: oldName,
+			ToFile:   newName,
+			Context:  3,
+		})
+		for _, line := range strings.Split(diff, "\n") {
+			switch {
+			case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			case strings.HasPrefix(line, "+"):
+				added++
+			case strings.HasPrefix(line, "-"):
+				removed++
+			}
+		}
+		sb.WriteString(diff)
+	}
+
+	r.metrics.diffLines.WithLabelValues("added").Set(float64(added))
+	r.metrics.diffLines.WithLabelValues("removed").Set(float64(removed))
+	return sb.String()
+}
+
+// Status returns the outcome of the last reload.
+func (r *configRollback) Status() reloadStatus {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	return r.status
+}
+
+// unhealthyComponents returns the IDs of the components which are unhealthy,
+// crash looping, or exited.
+func unhealthyComponents(infos []*component.Info) map[string]struct{} {
+	unhealthy := make(map[string]struct{})
+	for _, info := range infos {
+		switch info.Health.Health {
+		case component.HealthTypeUnhealthy, component.HealthTypeCrashLooping, component.HealthTypeExited:
+			unhealthy[info.ID.String()] = struct{}{}
+		}
+	}
+	return unhealthy
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path, so
+// that path is never partially written.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+type configRollbackMetrics struct {
+	reloads                *prometheus.CounterVec
+	diffLines              *prometheus.GaugeVec
+	lastKnownGoodTimestamp prometheus.Gauge
+}
+
+func newConfigRollbackMetrics(reg prometheus.Registerer) *configRollbackMetrics {
+	m := &configRollbackMetrics{
+		reloads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alloy_config_reloads_total",
+			Help: "Configuration reloads by outcome.",
+		}, []string{"outcome"}),
+		diffLines: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "alloy_config_reload_diff_lines",
+			Help: "Lines added and removed by the last configuration reload compared to the last-known-good configuration.",
+		}, []string{"change"}),
+		lastKnownGoodTimestamp: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alloy_config_last_known_good_timestamp_seconds",
+			Help: "Timestamp when the last-known-good configuration was saved.",
+		}),
+	}
+
+	if reg != nil {
+		reg.MustRegister(m.reloads, m.diffLines, m.lastKnownGoodTimestamp)
+	}
+	return m
+}
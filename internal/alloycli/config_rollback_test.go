@@ -0,0 +1,237 @@
+package alloycli
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+var (
+	goodConfig = map[string][]byte{"config.alloy": []byte("logging {\n  level = \"info\"\n}\n")}
+	newConfig  = map[string][]byte{"config.alloy": []byte("logging {\n  level = \"debug\"\n}\n")}
+	badConfig  = map[string][]byte{"config.alloy": []byte("logging {\n")}
+)
+
+func TestConfigRollback(t *testing.T) {
+	t.Run("first load becomes last-known-good", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+
+		require.NoError(t, env.rollback.Load(goodConfig))
+		require.Equal(t, reloadSucceeded, env.rollback.Status().Outcome)
+		require.Equal(t, goodConfig, env.readLastGood(t))
+	})
+
+	t.Run("first load fails without last-known-good", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+
+		err := env.rollback.Load(badConfig)
+		require.Error(t, err)
+		require.NotErrorAs(t, err, new(*rollbackError))
+		require.Equal(t, reloadFailed, env.rollback.Status().Outcome)
+		require.NoFileExists(t, filepath.Join(env.storagePath, lastKnownGoodFile))
+	})
+
+	t.Run("failed load is rolled back", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		err := env.rollback.Load(badConfig)
+		require.ErrorAs(t, err, new(*rollbackError))
+		require.Equal(t, goodConfig, env.current())
+
+		status := env.rollback.Status()
+		require.Equal(t, reloadRolledBack, status.Outcome)
+		require.Contains(t, status.Message, "invalid config")
+		require.Equal(t, 1.0, testutil.ToFloat64(env.rollback.metrics.reloads.WithLabelValues(reloadRolledBack)))
+	})
+
+	t.Run("healthy reload becomes last-known-good", func(t *testing.T) {
+		env := newRollbackTestEnv(t, 10*time.Millisecond)
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		require.NoError(t, env.rollback.Load(newConfig))
+		status := env.rollback.Status()
+		require.Equal(t, reloadPending, status.Outcome)
+		require.Contains(t, status.Diff, "-  level = \"info\"")
+		require.Contains(t, status.Diff, "+  level = \"debug\"")
+		require.Equal(t, 1.0, testutil.ToFloat64(env.rollback.metrics.diffLines.WithLabelValues("added")))
+		require.Equal(t, 1.0, testutil.ToFloat64(env.rollback.metrics.diffLines.WithLabelValues("removed")))
+
+		require.Eventually(t, func() bool {
+			return env.rollback.Status().Outcome == reloadSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, newConfig, env.current())
+		require.Equal(t, newConfig, env.readLastGood(t))
+	})
+
+	t.Run("unhealthy reload is rolled back", func(t *testing.T) {
+		env := newRollbackTestEnv(t, 10*time.Millisecond)
+		env.setUnhealthy(goodConfig, "local.file.already_broken")
+		env.setUnhealthy(newConfig, "local.file.already_broken", "loki.source.kafka.default")
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		require.NoError(t, env.rollback.Load(newConfig))
+
+		require.Eventually(t, func() bool {
+			return env.rollback.Status().Outcome == reloadRolledBack
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, goodConfig, env.current())
+		require.Equal(t, goodConfig, env.readLastGood(t))
+		require.Equal(t, []string{"loki.source.kafka.default"}, env.rollback.Status().UnhealthyComponents)
+	})
+
+	t.Run("unhealthy reload is rolled back during the grace period", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+		env.setUnhealthy(newConfig, "loki.source.kafka.default")
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		require.NoError(t, env.rollback.Load(newConfig))
+
+		// The health is polled, so the reload is rolled back long before the
+		// grace period is over.
+		require.Eventually(t, func() bool {
+			return env.rollback.Status().Outcome == reloadRolledBack
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, goodConfig, env.current())
+	})
+
+	t.Run("unhealthy components below the limit", func(t *testing.T) {
+		env := newRollbackTestEnv(t, 10*time.Millisecond)
+		env.rollback.opts.MaxNewUnhealthy = 1
+		env.setUnhealthy(newConfig, "loki.source.kafka.default")
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		require.NoError(t, env.rollback.Load(newConfig))
+
+		require.Eventually(t, func() bool {
+			return env.rollback.Status().Outcome == reloadSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, newConfig, env.current())
+	})
+
+	t.Run("pending reload is superseded", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+		require.NoError(t, env.rollback.Load(goodConfig))
+		require.NoError(t, env.rollback.Load(newConfig))
+
+		env.rollback.opts.GracePeriod = 10 * time.Millisecond
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		require.Eventually(t, func() bool {
+			return env.rollback.Status().Outcome == reloadSucceeded
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, goodConfig, env.current())
+		require.Equal(t, goodConfig, env.readLastGood(t))
+	})
+
+	t.Run("last-known-good is restored on startup", func(t *testing.T) {
+		env := newRollbackTestEnv(t, time.Hour)
+		require.NoError(t, env.rollback.Load(goodConfig))
+
+		restarted, err := newConfigRollback(t.Context(), configRollbackOptions{
+			Logger:      log.NewNopLogger(),
+			StoragePath: env.storagePath,
+			GracePeriod: time.Hour,
+			Load:        env.load,
+			Components:  env.components,
+		})
+		require.NoError(t, err)
+
+		err = restarted.Load(badConfig)
+		require.ErrorAs(t, err, new(*rollbackError))
+		require.Equal(t, goodConfig, env.current())
+	})
+}
+
+type rollbackTestEnv struct {
+	storagePath string
+	rollback    *configRollback
+
+	mut               sync.Mutex
+	loaded            map[string][]byte
+	unhealthy         []string            // Components unhealthy with the loaded configuration.
+	unhealthyByConfig map[string][]string // Components which become unhealthy with a configuration.
+}
+
+func newRollbackTestEnv(t *testing.T, gracePeriod time.Duration) *rollbackTestEnv {
+	env := &rollbackTestEnv{
+		storagePath:       t.TempDir(),
+		unhealthyByConfig: make(map[string][]string),
+	}
+
+	var err error
+	env.rollback, err = newConfigRollback(t.Context(), configRollbackOptions{
+		Logger:      log.NewNopLogger(),
+		StoragePath: env.storagePath,
+		Metrics:     prometheus.NewRegistry(),
+		GracePeriod: gracePeriod,
+		Load:        env.load,
+		Components:  env.components,
+	})
+	require.NoError(t, err)
+	return env
+}
+
+// load fails to load badConfig and records any other configuration as the
+// running one.
+func (env *rollbackTestEnv) load(sources map[string][]byte) error {
+	content := string(sources["config.alloy"])
+	if content == string(badConfig["config.alloy"]) {
+		return errors.New("invalid config")
+	}
+
+	env.mut.Lock()
+	defer env.mut.Unlock()
+	env.loaded = sources
+	env.unhealthy = env.unhealthyByConfig[content]
+	return nil
+}
+
+func (env *rollbackTestEnv) current() map[string][]byte {
+	env.mut.Lock()
+	defer env.mut.Unlock()
+	return env.loaded
+}
+
+// setUnhealthy sets the components which become unhealthy once sources are
+// loaded.
+func (env *rollbackTestEnv) setUnhealthy(sources map[string][]byte, ids ...string) {
+	env.mut.Lock()
+	defer env.mut.Unlock()
+	env.unhealthyByConfig[string(sources["config.alloy"])] = ids
+}
+
+func (env *rollbackTestEnv) components() []*component.Info {
+	env.mut.Lock()
+	defer env.mut.Unlock()
+
+	infos := []*component.Info{{
+		ID:     component.ID{LocalID: "prometheus.remote_write.default"},
+		Health: component.Health{Health: component.HealthTypeHealthy},
+	}}
+	for _, id := range env.unhealthy {
+		infos = append(infos, &component.Info{
+			ID:     component.ID{LocalID: id},
+			Health: component.Health{Health: component.HealthTypeUnhealthy},
+		})
+	}
+	return infos
+}
+
+func (env *rollbackTestEnv) readLastGood(t *testing.T) map[string][]byte {
+	r, err := newConfigRollback(t.Context(), configRollbackOptions{
+		Logger:      log.NewNopLogger(),
+		StoragePath: env.storagePath,
+	})
+	require.NoError(t, err)
+	return r.lastGood
+}
@@ -0,0 +1,158 @@
+package alloycli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	httpservice "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/syntax/diag"
+	"github.com/grafana/alloy/syntax/token"
+)
+
+// dryRunResult is the result of a dry run served by the /-/dry-run endpoint.
+type dryRunResult struct {
+	// Valid is true if the configuration has no errors.
+	Valid       bool               `json:"valid"`
+	Diagnostics []dryRunDiagnostic `json:"diagnostics"`
+
+	// The component changes are omitted if the configuration couldn't be
+	// evaluated.
+	Created []string `json:"created,omitempty"`
+	Updated []string `json:"updated,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+type dryRunDiagnostic struct {
+	Severity string         `json:"severity"` // Either "error" or "warning".
+	Message  string         `json:"message"`
+	Value    string         `json:"value,omitempty"`
+	Start    dryRunPosition `json:"start"`
+	End      dryRunPosition `json:"end"`
+}
+
+type dryRunPosition struct {
+	Filename string `json:"filename,omitempty"`
+	Offset   int    `json:"offset"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+func newDryRunPosition(p token.Position) dryRunPosition {
+	return dryRunPosition{Filename: p.Filename, Offset: p.Offset, Line: p.Line, Column: p.Column}
+}
+
+func (p dryRunPosition) toToken() token.Position {
+	return token.Position{Filename: p.Filename, Offset: p.Offset, Line: p.Line, Column: p.Column}
+}
+
+func newDryRunResult(res *alloy_runtime.DryRunResult) dryRunResult {
+	out := dryRunResult{
+		Valid:       !res.Diagnostics.HasErrors(),
+		Diagnostics: make([]dryRunDiagnostic, 0, len(res.Diagnostics)),
+	}
+	for _, d := range res.Diagnostics {
+		severity := "error"
+		if d.Severity == diag.SeverityLevelWarn {
+			severity = "warning"
+		}
+		out.Diagnostics = append(out.Diagnostics, dryRunDiagnostic{
+			Severity: severity,
+			Message:  d.Message,
+			Value:    d.Value,
+			Start:    newDryRunPosition(d.StartPos),
+			End:      newDryRunPosition(d.EndPos),
+		})
+	}
+	if res.Evaluated {
+		out.Created, out.Updated, out.Removed = res.Created, res.Updated, res.Removed
+	}
+	return out
+}
+
+// diagnostics converts the diagnostics of the result back so that they can be
+// reported like local diagnostics.
+func (r dryRunResult) diagnostics() diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0, len(r.Diagnostics))
+	for _, d := range r.Diagnostics {
+		severity := diag.SeverityLevelError
+		if d.Severity == "warning" {
+			severity = diag.SeverityLevelWarn
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Message:  d.Message,
+			Value:    d.Value,
+			StartPos: d.Start.toToken(),
+			EndPos:   d.End.toToken(),
+		})
+	}
+	return diags
+}
+
+// writeChanges writes the components which would be created, updated, or
+// removed by the configuration.
+func (r dryRunResult) writeChanges(w io.Writer) {
+	changes := []struct {
+		prefix string
+		ids    []string
+	}{
+		{"+", r.Created},
+		{"~", r.Updated},
+		{"-", r.Removed},
+	}
+	for _, c := range changes {
+		for _, id := range c.ids {
+			fmt.Fprintf(w, "%s %s\n", c.prefix, id)
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d to remove.\n", len(r.Created), len(r.Updated), len(r.Removed))
+}
+
+// requestDryRun sends sources to the /-/dry-run endpoint of the Alloy instance
+// listening at addr.
+func requestDryRun(addr string, sources map[string][]byte) (*dryRunResult, error) {
+	u, err := url.Parse(strings.TrimSuffix(addr, "/") + "/-/dry-run")
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid address %q: the scheme must be http or https", addr)
+	}
+
+	req := httpservice.DryRunRequest{Sources: make(map[string]string, len(sources))}
+	for name, bb := range sources {
+		req.Sources[name] = string(bb)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cli := http.Client{Timeout: time.Minute}
+	resp, err := cli.Post(u.String(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s doesn't serve dry runs, it must run with the --feature.config-dry-run.enabled flag", addr)
+	case resp.StatusCode != http.StatusOK:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("dry run failed with status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var res dryRunResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("decoding dry run result: %w", err)
+	}
+	return &res, nil
+}
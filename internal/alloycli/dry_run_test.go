@@ -0,0 +1,63 @@
+package alloycli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	httpservice "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/syntax/diag"
+	"github.com/grafana/alloy/syntax/token"
+)
+
+func TestRequestDryRun(t *testing.T) {
+	diags := diag.Diagnostics{{
+		Severity: diag.SeverityLevelError,
+		Message:  "missing required attribute \"url\"",
+		StartPos: token.Position{Filename: "config.alloy", Offset: 0, Line: 1, Column: 1},
+		EndPos:   token.Position{Filename: "config.alloy", Offset: 30, Line: 1, Column: 31},
+	}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/-/dry-run", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var req httpservice.DryRunRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, map[string]string{"config.alloy": "example {}"}, req.Sources)
+
+		_ = json.NewEncoder(w).Encode(newDryRunResult(&alloy_runtime.DryRunResult{
+			Diagnostics: diags,
+			Evaluated:   true,
+			Created:     []string{"prometheus.scrape.new"},
+			Updated:     []string{},
+			Removed:     []string{"prometheus.scrape.old"},
+		}))
+	}))
+	defer srv.Close()
+
+	res, err := requestDryRun(srv.URL+"/", map[string][]byte{"config.alloy": []byte("example {}")})
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Equal(t, diags, res.diagnostics())
+
+	var sb strings.Builder
+	res.writeChanges(&sb)
+	require.Equal(t, "+ prometheus.scrape.new\n- prometheus.scrape.old\n1 to create, 0 to update, 1 to remove.\n", sb.String())
+}
+
+func TestRequestDryRun_NotEnabled(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := requestDryRun(srv.URL, map[string][]byte{"config.alloy": nil})
+	require.ErrorContains(t, err, "it must run with the --feature.config-dry-run.enabled flag")
+
+	_, err = requestDryRun("localhost:12345", map[string][]byte{"config.alloy": nil})
+	require.ErrorContains(t, err, "the scheme must be http or https")
+}
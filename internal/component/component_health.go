@@ -59,6 +59,10 @@ const (
 
 	// HealthTypeExited represents a component which has stopped running.
 	HealthTypeExited
+
+	// HealthTypeCrashLooping represents a component which repeatedly stopped
+	// running with an error and is waiting to be restarted.
+	HealthTypeCrashLooping
 )
 
 // String returns the string representation of ht.
@@ -70,6 +74,8 @@ func (ht HealthType) String() string {
 		return "unhealthy"
 	case HealthTypeExited:
 		return "exited"
+	case HealthTypeCrashLooping:
+		return "crashlooping"
 	default:
 		return "unknown"
 	}
@@ -91,6 +97,8 @@ func (ht *HealthType) UnmarshalText(text []byte) error {
 		*ht = HealthTypeUnknown
 	case "exited":
 		*ht = HealthTypeExited
+	case "crashlooping":
+		*ht = HealthTypeCrashLooping
 	default:
 		return fmt.Errorf("invalid health type %q", string(text))
 	}
@@ -101,7 +109,7 @@ func (ht *HealthType) UnmarshalText(text []byte) error {
 // considered to be the least healthy.
 //
 // Health types are first prioritized by [HealthTypeExited], followed by
-// [HealthTypeUnhealthy], [HealthTypeUnknown], and [HealthTypeHealthy].
+// [HealthTypeCrashLooping], [HealthTypeUnhealthy], [HealthTypeUnknown], and [HealthTypeHealthy].
 //
 // If multiple arguments have the same Health type, the Health with the most
 // recent timestamp is returned.
@@ -134,8 +142,9 @@ func LeastHealthy(h Health, hh ...Health) Health {
 // healthPriority maps a HealthType to its priority; higher numbers means "less
 // healthy."
 var healthPriority = [...]int{
-	HealthTypeHealthy:   0,
-	HealthTypeUnknown:   1,
-	HealthTypeUnhealthy: 2,
-	HealthTypeExited:    3,
+	HealthTypeHealthy:      0,
+	HealthTypeUnknown:      1,
+	HealthTypeUnhealthy:    2,
+	HealthTypeCrashLooping: 3,
+	HealthTypeExited:       4,
 }
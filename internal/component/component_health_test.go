@@ -40,6 +40,28 @@ func TestMergeHealth(t *testing.T) {
 			}},
 			expectIndex: 1,
 		},
+		{
+			name: "exited > crashlooping",
+			healths: []component.Health{{
+				Health:     component.HealthTypeCrashLooping,
+				UpdateTime: jan1,
+			}, {
+				Health:     component.HealthTypeExited,
+				UpdateTime: jan1,
+			}},
+			expectIndex: 1,
+		},
+		{
+			name: "crashlooping > unhealthy",
+			healths: []component.Health{{
+				Health:     component.HealthTypeUnhealthy,
+				UpdateTime: jan1,
+			}, {
+				Health:     component.HealthTypeCrashLooping,
+				UpdateTime: jan1,
+			}},
+			expectIndex: 1,
+		},
 		{
 			name: "unhealthy > healthy",
 			healths: []component.Health{{
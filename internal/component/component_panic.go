@@ -0,0 +1,59 @@
+package component
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is returned when a panic of a component is recovered.
+type PanicError struct {
+	Value any    // Value passed to panic.
+	Stack []byte // Stack trace of the goroutine which panicked.
+}
+
+// Error returns the panic value and the stack trace of the panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// panicHandlers holds the *panicHandler of running components by global ID.
+var panicHandlers sync.Map
+
+type panicHandler struct {
+	handle func(*PanicError)
+}
+
+// RegisterPanicHandler registers handle to be called with the panics that
+// RecoverPanic recovers for the component with the given global ID. It
+// replaces the handler registered before for the same ID, if any.
+//
+// The returned function unregisters handle. It's a no-op if another handler
+// has been registered for the ID since then.
+func RegisterPanicHandler(id string, handle func(*PanicError)) (unregister func()) {
+	h := &panicHandler{handle: handle}
+	panicHandlers.Store(id, h)
+	return func() { panicHandlers.CompareAndDelete(id, h) }
+}
+
+// RecoverPanic recovers a panic in an entry point of a component, like an
+// appender or a consumer, which runs in the goroutine of the component that
+// sent the data. The panic is stored as a *PanicError in err, and reported to
+// the handler registered for the component with the given global ID, if
+// there is one.
+//
+// RecoverPanic must be deferred directly by the entry point:
+//
+//	defer component.RecoverPanic(id, &err)
+func RecoverPanic(id string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	pe := &PanicError{Value: r, Stack: debug.Stack()}
+	if h, ok := panicHandlers.Load(id); ok {
+		h.(*panicHandler).handle(pe)
+	}
+	*err = pe
+}
@@ -125,10 +125,12 @@ type Info struct {
 
 	ComponentName string // Name of the component.
 	Health        Health // Current component health.
+	Restarts      int    // Number of times the component was restarted after exiting with an error.
 
 	Arguments            Arguments   // Current arguments value of the component.
 	Exports              Exports     // Current exports value of the component.
 	DebugInfo            interface{} // Current debug info of the component.
+	LastPanic            *PanicInfo  // Last panic recovered from the component, if any.
 	LiveDebuggingEnabled bool
 }
 
@@ -152,6 +154,7 @@ func (info *Info) MarshalJSON() ([]byte, error) {
 			ReferencedBy         []string             `json:"referencedBy"`
 			DataFlowEdgesTo      []string             `json:"dataFlowEdgesTo"`
 			Health               *componentHealthJSON `json:"health"`
+			Restarts             int                  `json:"restarts"`
 			Original             string               `json:"original"`
 			Arguments            json.RawMessage      `json:"arguments,omitempty"`
 			Exports              json.RawMessage      `json:"exports,omitempty"`
@@ -192,6 +195,12 @@ func (info *Info) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if info.LastPanic != nil {
+		debugInfo, err = appendPanicInfo(debugInfo, info.LastPanic)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return json.Marshal(&componentDetailJSON{
 		Name:            info.ComponentName,
@@ -207,6 +216,7 @@ func (info *Info) MarshalJSON() ([]byte, error) {
 			Message:     info.Health.Message,
 			UpdatedTime: info.Health.UpdateTime,
 		},
+		Restarts:             info.Restarts,
 		Arguments:            arguments,
 		Exports:              exports,
 		DebugInfo:            debugInfo,
@@ -215,6 +225,34 @@ func (info *Info) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// PanicInfo describes a panic recovered from a component.
+type PanicInfo struct {
+	Value string    `alloy:"value,attr"` // Value passed to panic.
+	Stack string    `alloy:"stack,attr"` // Stack trace of the goroutine which panicked.
+	Time  time.Time `alloy:"time,attr"`  // Time of the panic.
+}
+
+// appendPanicInfo appends p as a last_panic block to the JSON representation
+// of a debug info body, so that it's displayed alongside the debug info of the
+// component.
+func appendPanicInfo(body json.RawMessage, p *PanicInfo) (json.RawMessage, error) {
+	panicBody, err := alloyjson.MarshalBody(struct {
+		LastPanic *PanicInfo `alloy:"last_panic,block"`
+	}{p})
+	if err != nil {
+		return nil, err
+	}
+
+	var stmts, panicStmts []json.RawMessage
+	if err := json.Unmarshal(body, &stmts); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(panicBody, &panicStmts); err != nil {
+		return nil, err
+	}
+	return json.Marshal(append(stmts, panicStmts...))
+}
+
 // GetAllComponents enumerates over all of the modules in p and returns the set
 // of all components.
 func GetAllComponents(p Provider, opts InfoOptions) []*Info {
@@ -0,0 +1,105 @@
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// dotenvKey matches the names of variables in dotenv files.
+var dotenvKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// parseDotenv parses the variables of a dotenv file.
+//
+// Each line holds a KEY=VALUE pair, optionally prefixed by "export". Blank
+// lines and lines starting with # are ignored. Unquoted values end at the end
+// of the line or at an inline comment, and are trimmed. Values in single
+// quotes are taken literally, and values in double quotes support the \n, \r,
+// \t, \", \\ and \$ escape sequences. Quoted values may span several lines.
+// Variables aren't expanded. If a variable is defined several times, the last
+// definition is used.
+func parseDotenv(bb []byte) (map[string]string, error) {
+	var (
+		res   = make(map[string]string)
+		lines = strings.Split(strings.ReplaceAll(string(bb), "\r\n", "\n"), "\n")
+	)
+
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "export"); ok && (strings.HasPrefix(rest, " ") || strings.HasPrefix(rest, "\t")) {
+			line = strings.TrimSpace(rest)
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", lineNo)
+		}
+		key = strings.TrimSpace(key)
+		if !dotenvKey.MatchString(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNo, key)
+		}
+		value = strings.TrimSpace(value)
+
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = strings.TrimSpace(value[:idx])
+			}
+			res[key] = value
+			continue
+		}
+
+		// Append the following lines until the closing quote is found.
+		quote, raw := value[0], value[1:]
+		for {
+			if end := closingQuote(raw, quote); end >= 0 {
+				if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+					return nil, fmt.Errorf("line %d: unexpected %q after quoted value", i+1, rest)
+				}
+				raw = raw[:end]
+				break
+			}
+			i++
+			if i == len(lines) {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", lineNo)
+			}
+			raw += "\n" + lines[i]
+		}
+		if quote == '"' {
+			raw = unescapeDotenv(raw)
+		}
+		res[key] = raw
+	}
+	return res, nil
+}
+
+// closingQuote returns the index of the quote which closes s, or -1 if s
+// isn't closed. Double quotes can be escaped with a backslash.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++ // Skip the escaped character.
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+var dotenvEscapes = strings.NewReplacer(
+	`\n`, "\n",
+	`\r`, "\r",
+	`\t`, "\t",
+	`\"`, `"`,
+	`\\`, `\`,
+	`\$`, `$`,
+)
+
+func unescapeDotenv(s string) string {
+	return dotenvEscapes.Replace(s)
+}
@@ -0,0 +1,343 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/featuregate"
+	filedetector "github.com/grafana/alloy/internal/filedetector"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+// waitReadPeriod holds the time to wait before reading the secrets after a
+// change was detected.
+//
+// This prevents local.secrets from updating too frequently and exporting
+// partial writes.
+const waitReadPeriod time.Duration = 30 * time.Millisecond
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "local.secrets",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments holds values which are used to configure the local.secrets
+// component.
+type Arguments struct {
+	// Directories holds the directories to load a secret from each file.
+	Directories []string `alloy:"directories,attr,optional"`
+	// DotenvFiles holds the dotenv files to load a secret from each variable.
+	DotenvFiles []string `alloy:"dotenv_files,attr,optional"`
+	// TrimWhitespace removes leading and trailing whitespace from the secrets
+	// loaded from directories.
+	TrimWhitespace bool `alloy:"trim_whitespace,attr,optional"`
+	// Type indicates how to detect changes to the files.
+	Type filedetector.Detector `alloy:"detector,attr,optional"`
+	// PollFrequency determines the frequency to check for changes when Type is
+	// Poll.
+	PollFrequency time.Duration `alloy:"poll_frequency,attr,optional"`
+}
+
+// DefaultArguments provides the default arguments for the local.secrets
+// component.
+var DefaultArguments = Arguments{
+	Type:          filedetector.DetectorFSNotify,
+	PollFrequency: time.Minute,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (a *Arguments) SetToDefault() {
+	*a = DefaultArguments
+}
+
+// Validate implements syntax.Validator.
+func (a *Arguments) Validate() error {
+	if len(a.Directories) == 0 && len(a.DotenvFiles) == 0 {
+		return fmt.Errorf("at least one of directories and dotenv_files must be set")
+	}
+	if a.PollFrequency <= 0 {
+		return fmt.Errorf("poll_frequency must be greater than 0")
+	}
+	return nil
+}
+
+// Exports holds values which are exported by the local.secrets component.
+type Exports struct {
+	// Data holds the secrets by name.
+	Data map[string]alloytypes.Secret `alloy:"data,attr"`
+}
+
+// Component implements the local.secrets component.
+type Component struct {
+	opts component.Options
+
+	mut       sync.Mutex
+	args      Arguments
+	detectors []io.Closer
+
+	healthMut sync.RWMutex
+	health    component.Health
+
+	// reloadCh is a buffered channel which is written to when the secrets
+	// should be reloaded by the component.
+	reloadCh     chan struct{}
+	lastAccessed prometheus.Gauge
+}
+
+var (
+	_ component.Component       = (*Component)(nil)
+	_ component.HealthComponent = (*Component)(nil)
+)
+
+// New creates a new local.secrets component.
+func New(o component.Options, args Arguments) (*Component, error) {
+	c := &Component{
+		opts: o,
+
+		reloadCh: make(chan struct{}, 1),
+		lastAccessed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "local_secrets_timestamp_last_accessed_unix_seconds",
+			Help: "The last successful load of the secrets in unix seconds",
+		}),
+	}
+
+	if err := o.Registerer.Register(c.lastAccessed); err != nil {
+		return nil, err
+	}
+	// Perform an update which will immediately set our exports to the initial
+	// secrets.
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	defer func() {
+		c.mut.Lock()
+		defer c.mut.Unlock()
+		c.closeDetectors()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.reloadCh:
+			time.Sleep(waitReadPeriod)
+
+			// We ignore the error here from readSecrets since readSecrets will log
+			// errors and also report the error as the health of the component.
+			c.mut.Lock()
+			_ = c.readSecrets()
+			c.mut.Unlock()
+		}
+	}
+}
+
+// readSecrets reads all secrets and exports them. mut must be held when
+// called.
+func (c *Component) readSecrets() error {
+	data, err := loadSecrets(c.args)
+	if err != nil {
+		c.setHealth(component.Health{
+			Health:     component.HealthTypeUnhealthy,
+			Message:    fmt.Sprintf("failed to load secrets: %s", err),
+			UpdateTime: time.Now(),
+		})
+		level.Error(c.opts.Logger).Log("msg", "failed to load secrets", "err", err)
+		return err
+	}
+	c.lastAccessed.SetToCurrentTime()
+
+	c.opts.OnStateChange(Exports{Data: data})
+
+	c.setHealth(component.Health{
+		Health:     component.HealthTypeHealthy,
+		Message:    fmt.Sprintf("loaded %d secrets", len(data)),
+		UpdateTime: time.Now(),
+	})
+	return nil
+}
+
+// loadSecrets reads the secrets of all the directories and dotenv files in
+// args. Two sources can't define the same secret.
+func loadSecrets(args Arguments) (map[string]alloytypes.Secret, error) {
+	var (
+		data    = make(map[string]alloytypes.Secret)
+		sources = make(map[string]string) // Path which defined each secret.
+	)
+	add := func(path string, secrets map[string]string) error {
+		for name, value := range secrets {
+			if other, ok := sources[name]; ok {
+				return fmt.Errorf("secret %q is defined in both %s and %s", name, other, path)
+			}
+			sources[name] = path
+			data[name] = alloytypes.Secret(value)
+		}
+		return nil
+	}
+
+	for _, dir := range args.Directories {
+		secrets, err := readDirectory(dir, args.TrimWhitespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(dir, secrets); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range args.DotenvFiles {
+		bb, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		secrets, err := parseDotenv(bb)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		if err := add(path, secrets); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// readDirectory reads a secret from each regular file in dir, named after the
+// file. Hidden files, such as the ..data directory of Kubernetes secret
+// volumes, and subdirectories are ignored. Symbolic links are followed.
+func readDirectory(dir string, trimWhitespace bool) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+
+		bb, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		value := string(bb)
+		if trimWhitespace {
+			value = strings.TrimSpace(value)
+		}
+		secrets[e.Name()] = value
+	}
+	return secrets, nil
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.args = newArgs
+
+	// Force an immediate read of the secrets to report any potential errors
+	// early.
+	if err := c.readSecrets(); err != nil {
+		return fmt.Errorf("failed to load secrets: %w", err)
+	}
+
+	// The paths may have changed, so recreate the detectors.
+	c.closeDetectors()
+	return c.configureDetectors()
+}
+
+// configureDetectors configures a detector for each directory and dotenv file
+// if there are none. mut must be held when called.
+func (c *Component) configureDetectors() error {
+	if len(c.detectors) > 0 {
+		// Already have detectors; don't do anything.
+		return nil
+	}
+
+	reload := func() {
+		select {
+		case c.reloadCh <- struct{}{}:
+		default:
+			// no-op: a reload is already queued so we don't need to queue a second
+			// one.
+		}
+	}
+
+	for _, path := range slices.Concat(c.args.Directories, c.args.DotenvFiles) {
+		switch c.args.Type {
+		case filedetector.DetectorPoll:
+			c.detectors = append(c.detectors, filedetector.NewPoller(filedetector.PollerOptions{
+				Filename:      path,
+				ReloadFile:    reload,
+				PollFrequency: c.args.PollFrequency,
+			}))
+		case filedetector.DetectorFSNotify:
+			d, err := filedetector.NewFSNotify(filedetector.FSNotifyOptions{
+				Logger:        c.opts.Logger,
+				Filename:      path,
+				ReloadFile:    reload,
+				PollFrequency: c.args.PollFrequency,
+			})
+			if err != nil {
+				return err
+			}
+			c.detectors = append(c.detectors, d)
+		}
+	}
+	return nil
+}
+
+// closeDetectors shuts down all detectors. mut must be held when called.
+func (c *Component) closeDetectors() {
+	for _, d := range c.detectors {
+		if err := d.Close(); err != nil {
+			level.Error(c.opts.Logger).Log("msg", "failed to shut down detector", "err", err)
+		}
+	}
+	c.detectors = nil
+}
+
+// CurrentHealth implements component.HealthComponent.
+func (c *Component) CurrentHealth() component.Health {
+	c.healthMut.RLock()
+	defer c.healthMut.RUnlock()
+	return c.health
+}
+
+func (c *Component) setHealth(h component.Health) {
+	c.healthMut.Lock()
+	defer c.healthMut.Unlock()
+	c.health = h
+}
@@ -0,0 +1,177 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/filedetector"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func TestParseDotenv(t *testing.T) {
+	tt := []struct {
+		name   string
+		input  string
+		expect map[string]string
+		err    string
+	}{
+		{
+			name: "basic",
+			input: `
+# A comment.
+USER=admin
+export PASSWORD = hunter2
+EMPTY=
+db.host=localhost # An inline comment.
+`,
+			expect: map[string]string{
+				"USER":     "admin",
+				"PASSWORD": "hunter2",
+				"EMPTY":    "",
+				"db.host":  "localhost",
+			},
+		},
+		{
+			name:   "single quotes",
+			input:  `KEY='a \n #literal' # A comment.`,
+			expect: map[string]string{"KEY": `a \n #literal`},
+		},
+		{
+			name:   "double quotes",
+			input:  `KEY="a\tb \"c\" \\ \$d\ne"`,
+			expect: map[string]string{"KEY": "a\tb \"c\" \\ $d\ne"},
+		},
+		{
+			name:   "multiline",
+			input:  "CERT=\"-----BEGIN-----\nabc\n-----END-----\"\r\nNEXT=1",
+			expect: map[string]string{"CERT": "-----BEGIN-----\nabc\n-----END-----", "NEXT": "1"},
+		},
+		{
+			name:   "redefinition",
+			input:  "KEY=a\nKEY=b",
+			expect: map[string]string{"KEY": "b"},
+		},
+		{
+			name:  "missing separator",
+			input: "KEY=a\nKEY",
+			err:   "line 2: expected KEY=VALUE",
+		},
+		{
+			name:  "invalid name",
+			input: "1KEY=a",
+			err:   `line 1: invalid variable name "1KEY"`,
+		},
+		{
+			name:  "unterminated quote",
+			input: "\nKEY=\"a\nb",
+			err:   "line 2: unterminated quoted value",
+		},
+		{
+			name:  "trailing characters",
+			input: `KEY="a" b`,
+			err:   `line 1: unexpected "b" after quoted value`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := parseDotenv([]byte(tc.input))
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, actual)
+		})
+	}
+}
+
+func TestLoadSecrets(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "password"), "hunter2\n")
+	writeFile(t, filepath.Join(dir, ".hidden"), "ignored")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0755))
+	writeFile(t, filepath.Join(dir, "..data", "password"), "ignored")
+	require.NoError(t, os.Symlink(filepath.Join(dir, "..data", "password"), filepath.Join(dir, "token")))
+
+	dotenv := filepath.Join(t.TempDir(), ".env")
+	writeFile(t, dotenv, "API_KEY=abc\n")
+
+	t.Run("directories and dotenv files", func(t *testing.T) {
+		data, err := loadSecrets(Arguments{Directories: []string{dir}, DotenvFiles: []string{dotenv}})
+		require.NoError(t, err)
+		require.Equal(t, map[string]alloytypes.Secret{
+			"password": "hunter2\n",
+			"token":    "ignored",
+			"API_KEY":  "abc",
+		}, data)
+	})
+
+	t.Run("trim whitespace", func(t *testing.T) {
+		data, err := loadSecrets(Arguments{Directories: []string{dir}, TrimWhitespace: true})
+		require.NoError(t, err)
+		require.Equal(t, alloytypes.Secret("hunter2"), data["password"])
+	})
+
+	t.Run("duplicate secrets", func(t *testing.T) {
+		other := filepath.Join(t.TempDir(), ".env")
+		writeFile(t, other, "password=other\n")
+
+		_, err := loadSecrets(Arguments{Directories: []string{dir}, DotenvFiles: []string{other}})
+		require.EqualError(t, err, `secret "password" is defined in both `+dir+" and "+other)
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		_, err := loadSecrets(Arguments{Directories: []string{filepath.Join(dir, "missing")}})
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestSecrets_Update(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "password"), "hunter2")
+
+	tc, err := componenttest.NewControllerFromID(nil, "local.secrets")
+	require.NoError(t, err)
+	go func() {
+		err := tc.Run(componenttest.TestContext(t), Arguments{
+			Directories:   []string{dir},
+			Type:          filedetector.DetectorPoll,
+			PollFrequency: 50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, tc.WaitExports(time.Second))
+	require.Equal(t, Exports{
+		Data: map[string]alloytypes.Secret{"password": "hunter2"},
+	}, tc.Exports())
+
+	writeFile(t, filepath.Join(dir, "password"), "new password")
+
+	require.NoError(t, tc.WaitExports(time.Second))
+	require.Equal(t, Exports{
+		Data: map[string]alloytypes.Secret{"password": "new password"},
+	}, tc.Exports())
+}
+
+func TestArguments_Validate(t *testing.T) {
+	args := DefaultArguments
+	require.EqualError(t, args.Validate(), "at least one of directories and dotenv_files must be set")
+
+	args.DotenvFiles = []string{".env"}
+	require.NoError(t, args.Validate())
+
+	args.PollFrequency = 0
+	require.EqualError(t, args.Validate(), "poll_frequency must be greater than 0")
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
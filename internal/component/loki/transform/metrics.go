@@ -0,0 +1,45 @@
+package transform
+
+import (
+	"github.com/grafana/alloy/internal/util"
+	prometheus_client "github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	entriesProcessed prometheus_client.Counter
+	entriesOutgoing  prometheus_client.Counter
+	entriesDropped   prometheus_client.Counter
+	errors           prometheus_client.Counter
+}
+
+// newMetrics creates a new set of metrics. If reg is non-nil, the metrics
+// will also be registered.
+func newMetrics(reg prometheus_client.Registerer) *metrics {
+	var m metrics
+
+	m.entriesProcessed = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "loki_transform_entries_processed",
+		Help: "Total number of log entries processed",
+	})
+	m.entriesOutgoing = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "loki_transform_entries_written",
+		Help: "Total number of log entries forwarded",
+	})
+	m.entriesDropped = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "loki_transform_entries_dropped",
+		Help: "Total number of log entries dropped by a drop condition",
+	})
+	m.errors = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "loki_transform_errors",
+		Help: "Total number of log entries dropped because of an error while running a statement or a condition",
+	})
+
+	if reg != nil {
+		m.entriesProcessed = util.MustRegisterOrGet(reg, m.entriesProcessed).(prometheus_client.Counter)
+		m.entriesOutgoing = util.MustRegisterOrGet(reg, m.entriesOutgoing).(prometheus_client.Counter)
+		m.entriesDropped = util.MustRegisterOrGet(reg, m.entriesDropped).(prometheus_client.Counter)
+		m.errors = util.MustRegisterOrGet(reg, m.errors).(prometheus_client.Counter)
+	}
+
+	return &m
+}
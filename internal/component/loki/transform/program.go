@@ -0,0 +1,126 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component/common/loki"
+	"github.com/grafana/loki/pkg/push"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/prometheus/common/model"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// program runs OTTL statements and drop conditions against log entries, using
+// the log context of the OpenTelemetry transform processor.
+//
+// A log entry is converted to an OTLP log record:
+//   - The labels are the resource attributes.
+//   - The structured metadata are the log record attributes.
+//   - The line is the log record body.
+//   - The timestamp is the log record time.
+type program struct {
+	statements ottl.StatementSequence[ottllog.TransformContext]
+	drop       *ottl.ConditionSequence[ottllog.TransformContext]
+}
+
+func newProgram(args Arguments, settings otelcomponent.TelemetrySettings) (*program, error) {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), settings, ottllog.EnablePathContextNames())
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := parser.ParseStatements(args.Statements)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statements: %w", err)
+	}
+
+	p := &program{
+		statements: ottllog.NewStatementSequence(statements, settings, ottllog.WithStatementSequenceErrorMode(args.ErrorMode)),
+	}
+
+	if len(args.DropConditions) > 0 {
+		conditions, err := parser.ParseConditions(args.DropConditions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop conditions: %w", err)
+		}
+		drop := ottllog.NewConditionSequence(conditions, settings, ottllog.WithConditionSequenceErrorMode(args.ErrorMode))
+		p.drop = &drop
+	}
+
+	return p, nil
+}
+
+// process runs the statements against entry and returns the transformed
+// entry. It returns false if the entry matches a drop condition.
+func (p *program) process(ctx context.Context, entry loki.Entry) (loki.Entry, bool, error) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	sl := rl.ScopeLogs().AppendEmpty()
+	lr := sl.LogRecords().AppendEmpty()
+
+	for name, value := range entry.Labels {
+		rl.Resource().Attributes().PutStr(string(name), string(value))
+	}
+	for _, md := range entry.StructuredMetadata {
+		lr.Attributes().PutStr(md.Name, md.Value)
+	}
+	lr.Body().SetStr(entry.Line)
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(entry.Timestamp))
+
+	tCtx := ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource(), sl, rl)
+	if err := p.statements.Execute(ctx, tCtx); err != nil {
+		return loki.Entry{}, false, err
+	}
+	if p.drop != nil {
+		drop, err := p.drop.Eval(ctx, tCtx)
+		if err != nil {
+			return loki.Entry{}, false, err
+		}
+		if drop {
+			return loki.Entry{}, false, nil
+		}
+	}
+
+	return toEntry(entry, rl.Resource(), lr), true, nil
+}
+
+// toEntry converts a log record back to a log entry. Attributes which aren't
+// valid label names, or which have an empty value, are dropped from the
+// labels. The timestamp of the original entry is kept if the time of the log
+// record has been removed.
+func toEntry(original loki.Entry, resource pcommon.Resource, lr plog.LogRecord) loki.Entry {
+	labels := make(model.LabelSet, resource.Attributes().Len())
+	resource.Attributes().Range(func(k string, v pcommon.Value) bool {
+		name, value := model.LabelName(k), model.LabelValue(v.AsString())
+		if name.IsValid() && value != "" {
+			labels[name] = value
+		}
+		return true
+	})
+
+	var metadata push.LabelsAdapter
+	lr.Attributes().Range(func(k string, v pcommon.Value) bool {
+		metadata = append(metadata, push.LabelAdapter{Name: k, Value: v.AsString()})
+		return true
+	})
+
+	timestamp := original.Timestamp
+	if lr.Timestamp() != 0 {
+		timestamp = lr.Timestamp().AsTime()
+	}
+
+	return loki.Entry{
+		Labels: labels,
+		Entry: push.Entry{
+			Timestamp:          timestamp,
+			Line:               lr.Body().AsString(),
+			StructuredMetadata: metadata,
+			Parsed:             original.Parsed,
+		},
+	}
+}
@@ -0,0 +1,189 @@
+// Package transform provides the loki.transform component.
+package transform
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/common/loki"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/util/zapadapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "loki.transform",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments holds values which are used to configure the loki.transform
+// component.
+type Arguments struct {
+	// Where the transformed log entries should be forwarded to.
+	ForwardTo []loki.LogsReceiver `alloy:"forward_to,attr"`
+
+	// How to react to errors raised by statements and conditions.
+	ErrorMode ottl.ErrorMode `alloy:"error_mode,attr,optional"`
+
+	// The OTTL statements to run against each log entry.
+	Statements []string `alloy:"statements,attr,optional"`
+
+	// The OTTL conditions which drop a log entry when any of them matches.
+	DropConditions []string `alloy:"drop_conditions,attr,optional"`
+}
+
+// DefaultArguments provides the default arguments for the loki.transform
+// component.
+var DefaultArguments = Arguments{
+	ErrorMode: ottl.PropagateError,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (a *Arguments) SetToDefault() {
+	*a = DefaultArguments
+}
+
+// Validate implements syntax.Validator.
+func (a *Arguments) Validate() error {
+	_, err := newProgram(*a, otelcomponent.TelemetrySettings{Logger: zap.NewNop()})
+	return err
+}
+
+// Exports holds values which are exported by the loki.transform component.
+type Exports struct {
+	Receiver loki.LogsReceiver `alloy:"receiver,attr"`
+}
+
+// Component implements the loki.transform component.
+type Component struct {
+	opts    component.Options
+	metrics *metrics
+
+	mut      sync.RWMutex
+	program  *program
+	receiver loki.LogsReceiver
+	fanout   []loki.LogsReceiver
+
+	debugDataPublisher livedebugging.DebugDataPublisher
+}
+
+var (
+	_ component.Component     = (*Component)(nil)
+	_ component.LiveDebugging = (*Component)(nil)
+)
+
+// New creates a new loki.transform component.
+func New(o component.Options, args Arguments) (*Component, error) {
+	debugDataPublisher, err := o.GetServiceData(livedebugging.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Component{
+		opts:               o,
+		metrics:            newMetrics(o.Registerer),
+		debugDataPublisher: debugDataPublisher.(livedebugging.DebugDataPublisher),
+	}
+
+	// Create and immediately export the receiver which remains the same for
+	// the component's lifetime.
+	c.receiver = loki.NewLogsReceiver(loki.WithComponentID(o.ID))
+	o.OnStateChange(Exports{Receiver: c.receiver})
+
+	// Call to Update() to set the statements once at the start.
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	componentID := livedebugging.ComponentID(c.opts.ID)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry := <-c.receiver.Chan():
+			c.metrics.entriesProcessed.Inc()
+
+			c.mut.RLock()
+			program, fanout := c.program, c.fanout
+			c.mut.RUnlock()
+
+			transformed, keep, err := program.process(ctx, entry)
+
+			count := uint64(1)
+			if !keep || err != nil {
+				count = 0 // the count is not incremented because the log will be filtered out
+			}
+			c.debugDataPublisher.PublishIfActive(livedebugging.NewData(
+				componentID,
+				livedebugging.LokiLog,
+				count,
+				func() string {
+					if count == 0 {
+						return fmt.Sprintf("entry: %s, labels: %s => dropped", entry.Line, entry.Labels.String())
+					}
+					return fmt.Sprintf("entry: %s, labels: %s => entry: %s, labels: %s", entry.Line, entry.Labels.String(), transformed.Line, transformed.Labels.String())
+				},
+			))
+
+			if err != nil {
+				c.metrics.errors.Inc()
+				level.Warn(c.opts.Logger).Log("msg", "failed to transform log entry, dropping it", "labels", entry.Labels.String(), "err", err)
+				continue
+			}
+			if !keep {
+				c.metrics.entriesDropped.Inc()
+				level.Debug(c.opts.Logger).Log("msg", "dropping entry matching a drop condition", "labels", entry.Labels.String())
+				continue
+			}
+
+			c.metrics.entriesOutgoing.Inc()
+			for _, f := range fanout {
+				select {
+				case <-ctx.Done():
+					return nil
+				case f.Chan() <- transformed:
+				}
+			}
+		}
+	}
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	program, err := newProgram(newArgs, otelcomponent.TelemetrySettings{
+		Logger: zapadapter.New(c.opts.Logger),
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.program = program
+	c.fanout = newArgs.ForwardTo
+
+	return nil
+}
+
+// LiveDebugging implements component.LiveDebugging.
+func (c *Component) LiveDebugging() {}
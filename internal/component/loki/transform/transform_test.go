@@ -0,0 +1,116 @@
+package transform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/common/loki"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/loki/pkg/push"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+func TestTransform(t *testing.T) {
+	cfg := `
+		statements = [
+			` + "`" + `set(resource.attributes["env"], "prod")` + "`" + `,
+			` + "`" + `delete_key(resource.attributes, "pod")` + "`" + `,
+			` + "`" + `set(log.attributes["trace_id"], resource.attributes["trace"])` + "`" + `,
+			` + "`" + `delete_key(resource.attributes, "trace")` + "`" + `,
+			` + "`" + `replace_pattern(log.body, "password=\\S+", "password=***")` + "`" + `,
+		]
+		drop_conditions = [` + "`" + `IsMatch(log.body, "healthz")` + "`" + `]
+		forward_to = []
+	`
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	receiver := loki.NewLogsReceiver()
+	args.ForwardTo = []loki.LogsReceiver{receiver}
+
+	ctrl, err := componenttest.NewControllerFromID(util.TestLogger(t), "loki.transform")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+	require.NoError(t, ctrl.WaitExports(time.Second))
+	input := ctrl.Exports().(Exports).Receiver
+
+	now := time.Now()
+	input.Chan() <- loki.Entry{
+		Labels: model.LabelSet{"job": "app", "pod": "app-1", "trace": "abc"},
+		Entry:  push.Entry{Timestamp: now, Line: "GET /healthz"},
+	}
+	input.Chan() <- loki.Entry{
+		Labels: model.LabelSet{"job": "app", "pod": "app-1", "trace": "abc"},
+		Entry: push.Entry{
+			Timestamp:          now,
+			Line:               "login user=foo password=bar",
+			StructuredMetadata: push.LabelsAdapter{{Name: "level", Value: "info"}},
+		},
+	}
+
+	select {
+	case entry := <-receiver.Chan():
+		require.Equal(t, model.LabelSet{"job": "app", "env": "prod"}, entry.Labels)
+		require.Equal(t, "login user=foo password=***", entry.Line)
+		require.Equal(t, push.LabelsAdapter{{Name: "level", Value: "info"}, {Name: "trace_id", Value: "abc"}}, entry.StructuredMetadata)
+		require.True(t, now.Equal(entry.Timestamp))
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "failed waiting for log entry")
+	}
+
+	select {
+	case entry := <-receiver.Chan():
+		require.FailNow(t, "unexpected log entry", "line: %s", entry.Line)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestErrorMode(t *testing.T) {
+	entry := loki.Entry{
+		Labels: model.LabelSet{"job": "app"},
+		Entry:  push.Entry{Timestamp: time.Now(), Line: "not json"},
+	}
+
+	args := DefaultArguments
+	args.Statements = []string{`merge_maps(log.attributes, ParseJSON(log.body), "upsert")`}
+
+	p, err := newProgram(args, otelcomponent.TelemetrySettings{Logger: zap.NewNop()})
+	require.NoError(t, err)
+	_, _, err = p.process(t.Context(), entry)
+	require.Error(t, err)
+
+	args.ErrorMode = "ignore"
+	p, err = newProgram(args, otelcomponent.TelemetrySettings{Logger: zap.NewNop()})
+	require.NoError(t, err)
+	transformed, keep, err := p.process(t.Context(), entry)
+	require.NoError(t, err)
+	require.True(t, keep)
+	require.Equal(t, entry.Line, transformed.Line)
+	require.Equal(t, entry.Labels, transformed.Labels)
+}
+
+func TestValidate(t *testing.T) {
+	var args Arguments
+	err := syntax.Unmarshal([]byte(`
+		statements = ["set(body, \"x\")"]
+		forward_to = []
+	`), &args)
+	require.ErrorContains(t, err, "invalid statements")
+
+	err = syntax.Unmarshal([]byte(`
+		drop_conditions = ["Nope(log.body)"]
+		forward_to = []
+	`), &args)
+	require.ErrorContains(t, err, "invalid drop conditions")
+}
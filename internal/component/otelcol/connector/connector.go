@@ -10,7 +10,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	otelcomponent "go.opentelemetry.io/collector/component"
 	otelconnector "go.opentelemetry.io/collector/connector"
+	otelconsumer "go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pipeline"
 	sdkprometheus "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/sdk/metric"
@@ -39,6 +42,14 @@ const (
 	ConnectorLogsToTraces
 	ConnectorLogsToMetrics
 	ConnectorLogsToLogs
+
+	// ConnectorAnyToMetrics connectors accept traces, metrics and logs, and
+	// output metrics.
+	ConnectorAnyToMetrics
+	// ConnectorRouter connectors accept traces, metrics and logs, and route
+	// them to the consumers returned by RouterArguments.Routes. Their
+	// Arguments must implement RouterArguments.
+	ConnectorRouter
 )
 
 // Arguments is an extension of component.Arguments which contains necessary
@@ -67,6 +78,21 @@ type Arguments interface {
 	DebugMetricsConfig() otelcolCfg.DebugMetricsArguments
 }
 
+// RouterArguments is an extension of Arguments for connectors of the
+// ConnectorRouter type, which send data to different sets of consumers.
+type RouterArguments interface {
+	Arguments
+
+	// Routes returns the consumers to route data to, keyed by the name of the
+	// pipeline which the connector configuration refers to them with.
+	Routes() map[string]*otelcol.ConsumerArguments
+
+	// ConvertForSignal converts the Arguments into the configuration of the
+	// connector which routes the given signal. The configuration must refer to
+	// the pipelines returned by Routes with pipeline IDs of that signal.
+	ConvertForSignal(signal pipeline.Signal) (otelcomponent.Config, error)
+}
+
 // Connector is an Alloy component shim which manages an OpenTelemetry
 // Collector connector component.
 type Connector struct {
@@ -202,20 +228,87 @@ func (p *Connector) Update(args component.Arguments) error {
 		}
 
 		if len(next.Metrics) > 0 {
-			fanout := fanoutconsumer.Metrics(next.Metrics)
-			metricsInterceptor := interceptconsumer.Metrics(fanout,
-				func(ctx context.Context, md pmetric.Metrics) error {
-					livedebuggingpublisher.PublishMetricsIfActive(p.debugDataPublisher, p.opts.ID, md, otelcol.GetComponentMetadata(next.Metrics))
-					return fanout.ConsumeMetrics(ctx, md)
-				},
-			)
-			tracesConnector, err = p.factory.CreateTracesToMetrics(p.ctx, settings, connectorConfig, metricsInterceptor)
+			tracesConnector, err = p.factory.CreateTracesToMetrics(p.ctx, settings, connectorConfig, p.metricsConsumer(next.Metrics))
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if tracesConnector != nil {
+				components = append(components, tracesConnector)
+			}
+		}
+	case ConnectorAnyToMetrics:
+		if len(next.Traces) > 0 || len(next.Logs) > 0 {
+			return errors.New("this connector can only output metrics")
+		}
+
+		if len(next.Metrics) > 0 {
+			metricsConsumer := p.metricsConsumer(next.Metrics)
+
+			tracesConnector, err = p.factory.CreateTracesToMetrics(p.ctx, settings, connectorConfig, metricsConsumer)
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if tracesConnector != nil {
+				components = append(components, tracesConnector)
+			}
+
+			metricsConnector, err = p.factory.CreateMetricsToMetrics(p.ctx, settings, connectorConfig, metricsConsumer)
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if metricsConnector != nil {
+				components = append(components, metricsConnector)
+			}
+
+			logsConnector, err = p.factory.CreateLogsToMetrics(p.ctx, settings, connectorConfig, metricsConsumer)
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if logsConnector != nil {
+				components = append(components, logsConnector)
+			}
+		}
+	case ConnectorRouter:
+		routerArgs, ok := p.args.(RouterArguments)
+		if !ok {
+			return errors.New("router connectors must implement connector.RouterArguments")
+		}
+		routes := routerArgs.Routes()
+
+		if hasRouteConsumers(routes, func(c *otelcol.ConsumerArguments) []otelcol.Consumer { return c.Traces }) {
+			cfg, err := routerArgs.ConvertForSignal(pipeline.SignalTraces)
+			if err != nil {
+				return err
+			}
+			tracesConnector, err = p.factory.CreateTracesToTraces(p.ctx, settings, cfg, p.tracesRouter(routes))
 			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
 				return err
 			} else if tracesConnector != nil {
 				components = append(components, tracesConnector)
 			}
 		}
+
+		if hasRouteConsumers(routes, func(c *otelcol.ConsumerArguments) []otelcol.Consumer { return c.Metrics }) {
+			cfg, err := routerArgs.ConvertForSignal(pipeline.SignalMetrics)
+			if err != nil {
+				return err
+			}
+			metricsConnector, err = p.factory.CreateMetricsToMetrics(p.ctx, settings, cfg, p.metricsRouter(routes))
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if metricsConnector != nil {
+				components = append(components, metricsConnector)
+			}
+		}
+
+		if hasRouteConsumers(routes, func(c *otelcol.ConsumerArguments) []otelcol.Consumer { return c.Logs }) {
+			cfg, err := routerArgs.ConvertForSignal(pipeline.SignalLogs)
+			if err != nil {
+				return err
+			}
+			logsConnector, err = p.factory.CreateLogsToLogs(p.ctx, settings, cfg, p.logsRouter(routes))
+			if err != nil && !errors.Is(err, pipeline.ErrSignalNotSupported) {
+				return err
+			} else if logsConnector != nil {
+				components = append(components, logsConnector)
+			}
+		}
 	default:
 		return errors.New("unsupported connector type")
 	}
@@ -229,6 +322,71 @@ func (p *Connector) Update(args component.Arguments) error {
 	return nil
 }
 
+// metricsConsumer returns a consumer which sends metrics to next and publishes
+// them for live debugging.
+func (p *Connector) metricsConsumer(next []otelcol.Consumer) otelconsumer.Metrics {
+	fanout := fanoutconsumer.Metrics(next)
+	return interceptconsumer.Metrics(fanout,
+		func(ctx context.Context, md pmetric.Metrics) error {
+			livedebuggingpublisher.PublishMetricsIfActive(p.debugDataPublisher, p.opts.ID, md, otelcol.GetComponentMetadata(next))
+			return fanout.ConsumeMetrics(ctx, md)
+		},
+	)
+}
+
+// tracesRouter returns a router which sends traces to the traces consumers of
+// routes and publishes them for live debugging.
+func (p *Connector) tracesRouter(routes map[string]*otelcol.ConsumerArguments) otelconnector.TracesRouterAndConsumer {
+	consumers := make(map[pipeline.ID]otelconsumer.Traces, len(routes))
+	for name, route := range routes {
+		fanout := fanoutconsumer.Traces(route.Traces)
+		consumers[pipeline.NewIDWithName(pipeline.SignalTraces, name)] = interceptconsumer.Traces(fanout,
+			func(ctx context.Context, td ptrace.Traces) error {
+				livedebuggingpublisher.PublishTracesIfActive(p.debugDataPublisher, p.opts.ID, td, otelcol.GetComponentMetadata(route.Traces))
+				return fanout.ConsumeTraces(ctx, td)
+			},
+		)
+	}
+	return otelconnector.NewTracesRouter(consumers)
+}
+
+// metricsRouter returns a router which sends metrics to the metrics consumers
+// of routes and publishes them for live debugging.
+func (p *Connector) metricsRouter(routes map[string]*otelcol.ConsumerArguments) otelconnector.MetricsRouterAndConsumer {
+	consumers := make(map[pipeline.ID]otelconsumer.Metrics, len(routes))
+	for name, route := range routes {
+		consumers[pipeline.NewIDWithName(pipeline.SignalMetrics, name)] = p.metricsConsumer(route.Metrics)
+	}
+	return otelconnector.NewMetricsRouter(consumers)
+}
+
+// logsRouter returns a router which sends logs to the logs consumers of routes
+// and publishes them for live debugging.
+func (p *Connector) logsRouter(routes map[string]*otelcol.ConsumerArguments) otelconnector.LogsRouterAndConsumer {
+	consumers := make(map[pipeline.ID]otelconsumer.Logs, len(routes))
+	for name, route := range routes {
+		fanout := fanoutconsumer.Logs(route.Logs)
+		consumers[pipeline.NewIDWithName(pipeline.SignalLogs, name)] = interceptconsumer.Logs(fanout,
+			func(ctx context.Context, ld plog.Logs) error {
+				livedebuggingpublisher.PublishLogsIfActive(p.debugDataPublisher, p.opts.ID, ld, otelcol.GetComponentMetadata(route.Logs))
+				return fanout.ConsumeLogs(ctx, ld)
+			},
+		)
+	}
+	return otelconnector.NewLogsRouter(consumers)
+}
+
+// hasRouteConsumers returns true if any of the routes has consumers for the
+// signal selected by consumers.
+func hasRouteConsumers(routes map[string]*otelcol.ConsumerArguments, consumers func(*otelcol.ConsumerArguments) []otelcol.Consumer) bool {
+	for _, route := range routes {
+		if len(consumers(route)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // CurrentHealth implements component.HealthComponent.
 func (p *Connector) CurrentHealth() component.Health {
 	return p.sched.CurrentHealth()
@@ -0,0 +1,208 @@
+// Package count provides an otelcol.connector.count component.
+package count
+
+import (
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/connector"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.connector.count",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   otelcol.ConsumerExports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := countconnector.NewFactory()
+			return connector.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// The metrics which are emitted for a telemetry type when no metrics are
+// configured for it. They match the defaults of the upstream connector.
+var (
+	DefaultSpans = []MetricInfo{{
+		Name:        "trace.span.count",
+		Description: "The number of spans observed.",
+	}}
+	DefaultSpanEvents = []MetricInfo{{
+		Name:        "trace.span.event.count",
+		Description: "The number of span events observed.",
+	}}
+	DefaultMetrics = []MetricInfo{{
+		Name:        "metric.count",
+		Description: "The number of metrics observed.",
+	}}
+	DefaultDataPoints = []MetricInfo{{
+		Name:        "metric.datapoint.count",
+		Description: "The number of data points observed.",
+	}}
+	DefaultLogs = []MetricInfo{{
+		Name:        "log.record.count",
+		Description: "The number of log records observed.",
+	}}
+)
+
+// Arguments configures the otelcol.connector.count component.
+type Arguments struct {
+	// Metrics to emit for spans, span events, metrics, data points and log
+	// records. The default metric for a telemetry type is emitted when no
+	// metric is configured for it.
+	Spans      []MetricInfo `alloy:"span,block,optional"`
+	SpanEvents []MetricInfo `alloy:"span_event,block,optional"`
+	Metrics    []MetricInfo `alloy:"metric,block,optional"`
+	DataPoints []MetricInfo `alloy:"datapoint,block,optional"`
+	Logs       []MetricInfo `alloy:"log,block,optional"`
+
+	// Output configures where to send processed data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+// MetricInfo configures a single count metric.
+type MetricInfo struct {
+	// Name of the metric.
+	Name string `alloy:"name,attr"`
+	// Description of the metric.
+	Description string `alloy:"description,attr,optional"`
+	// Conditions is a list of OTTL conditions. A record is counted if any of
+	// the conditions match. All records are counted if there are no conditions.
+	Conditions []string `alloy:"conditions,attr,optional"`
+	// Attributes to group the count by.
+	Attributes []AttributeConfig `alloy:"attribute,block,optional"`
+}
+
+// AttributeConfig configures an attribute to group the count by.
+type AttributeConfig struct {
+	// Key of the attribute.
+	Key string `alloy:"key,attr"`
+	// DefaultValue is used for records which don't have the attribute. Records
+	// without the attribute aren't counted if it's not set.
+	DefaultValue any `alloy:"default_value,attr,optional"`
+}
+
+var (
+	_ syntax.Validator = (*Arguments)(nil)
+	_ syntax.Defaulter = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{}
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	cfg, err := args.convertImpl()
+	if err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+// Convert implements connector.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	return args.convertImpl()
+}
+
+// convertImpl is a helper function which returns the real type of the config,
+// instead of the otelcomponent.Config interface.
+func (args Arguments) convertImpl() (*countconnector.Config, error) {
+	spans, err := convertMetricInfos("span", args.Spans, DefaultSpans)
+	if err != nil {
+		return nil, err
+	}
+	spanEvents, err := convertMetricInfos("span_event", args.SpanEvents, DefaultSpanEvents)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := convertMetricInfos("metric", args.Metrics, DefaultMetrics)
+	if err != nil {
+		return nil, err
+	}
+	dataPoints, err := convertMetricInfos("datapoint", args.DataPoints, DefaultDataPoints)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := convertMetricInfos("log", args.Logs, DefaultLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &countconnector.Config{
+		Spans:      spans,
+		SpanEvents: spanEvents,
+		Metrics:    metrics,
+		DataPoints: dataPoints,
+		Logs:       logs,
+	}, nil
+}
+
+// convertMetricInfos converts the metrics configured in the blocks named
+// blockName into the upstream format, using defaults if none are configured.
+func convertMetricInfos(blockName string, infos []MetricInfo, defaults []MetricInfo) (map[string]countconnector.MetricInfo, error) {
+	if len(infos) == 0 {
+		infos = defaults
+	}
+
+	res := make(map[string]countconnector.MetricInfo, len(infos))
+	for _, info := range infos {
+		if _, ok := res[info.Name]; ok {
+			return nil, fmt.Errorf("%s: duplicate metric name %q", blockName, info.Name)
+		}
+
+		var attrs []countconnector.AttributeConfig
+		for _, attr := range info.Attributes {
+			attrs = append(attrs, countconnector.AttributeConfig{
+				Key:          attr.Key,
+				DefaultValue: attr.DefaultValue,
+			})
+		}
+
+		res[info.Name] = countconnector.MetricInfo{
+			Description: info.Description,
+			Conditions:  info.Conditions,
+			Attributes:  attrs,
+		}
+	}
+	return res, nil
+}
+
+// Extensions implements connector.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements connector.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements connector.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// ConnectorType() int implements connector.Arguments.
+func (Arguments) ConnectorType() int {
+	return connector.ConnectorAnyToMetrics
+}
+
+// DebugMetricsConfig implements connector.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
@@ -0,0 +1,242 @@
+package count_test
+
+import (
+	"testing"
+
+	"github.com/grafana/alloy/internal/component/otelcol/connector/count"
+	"github.com/grafana/alloy/internal/component/otelcol/processor/processortest"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName string
+		cfg      string
+		expected *countconnector.Config
+		errorMsg string
+	}{
+		{
+			testName: "Defaults",
+			cfg: `
+				output {}
+			`,
+			expected: &countconnector.Config{
+				Spans: map[string]countconnector.MetricInfo{
+					"trace.span.count": {Description: "The number of spans observed."},
+				},
+				SpanEvents: map[string]countconnector.MetricInfo{
+					"trace.span.event.count": {Description: "The number of span events observed."},
+				},
+				Metrics: map[string]countconnector.MetricInfo{
+					"metric.count": {Description: "The number of metrics observed."},
+				},
+				DataPoints: map[string]countconnector.MetricInfo{
+					"metric.datapoint.count": {Description: "The number of data points observed."},
+				},
+				Logs: map[string]countconnector.MetricInfo{
+					"log.record.count": {Description: "The number of log records observed."},
+				},
+			},
+		},
+		{
+			testName: "ExplicitValues",
+			cfg: `
+				span {
+					name        = "my.prod.span.count"
+					description = "The number of spans from prod."
+					conditions  = ["resource.attributes[\"env\"] == \"prod\""]
+				}
+				log {
+					name        = "my.log.count"
+					conditions  = ["severity_number >= SEVERITY_NUMBER_ERROR", "IsMatch(body, \".*error.*\")"]
+
+					attribute {
+						key = "service.name"
+					}
+					attribute {
+						key           = "env"
+						default_value = "unknown"
+					}
+				}
+				output {}
+			`,
+			expected: &countconnector.Config{
+				Spans: map[string]countconnector.MetricInfo{
+					"my.prod.span.count": {
+						Description: "The number of spans from prod.",
+						Conditions:  []string{`resource.attributes["env"] == "prod"`},
+					},
+				},
+				SpanEvents: map[string]countconnector.MetricInfo{
+					"trace.span.event.count": {Description: "The number of span events observed."},
+				},
+				Metrics: map[string]countconnector.MetricInfo{
+					"metric.count": {Description: "The number of metrics observed."},
+				},
+				DataPoints: map[string]countconnector.MetricInfo{
+					"metric.datapoint.count": {Description: "The number of data points observed."},
+				},
+				Logs: map[string]countconnector.MetricInfo{
+					"my.log.count": {
+						Conditions: []string{"severity_number >= SEVERITY_NUMBER_ERROR", `IsMatch(body, ".*error.*")`},
+						Attributes: []countconnector.AttributeConfig{
+							{Key: "service.name"},
+							{Key: "env", DefaultValue: "unknown"},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "DuplicateName",
+			cfg: `
+				log {
+					name = "my.log.count"
+				}
+				log {
+					name = "my.log.count"
+				}
+				output {}
+			`,
+			errorMsg: `log: duplicate metric name "my.log.count"`,
+		},
+		{
+			testName: "InvalidCondition",
+			cfg: `
+				span {
+					name       = "my.span.count"
+					conditions = ["invalid condition"]
+				}
+				output {}
+			`,
+			errorMsg: `spans condition: metric "my.span.count"`,
+		},
+		{
+			testName: "MetricAttributes",
+			cfg: `
+				metric {
+					name = "my.metric.count"
+					attribute {
+						key = "env"
+					}
+				}
+				output {}
+			`,
+			errorMsg: `metrics attributes not supported: metric "my.metric.count"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args count.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			actual, err := args.Convert()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ComponentIO(t *testing.T) {
+	const inputTrace = `{
+		"resourceSpans": [{
+			"resource": {
+				"attributes": [{
+					"key": "service.name",
+					"value": { "stringValue": "TestSvcName" }
+				}]
+			},
+			"scopeSpans": [{
+				"spans": [{
+					"trace_id": "7bba9f33312b3dbb8b2c2c62bb7abe2d",
+					"span_id": "086e83747d0e381e",
+					"name": "TestSpan",
+					"attributes": [{
+						"key": "http.method",
+						"value": { "stringValue": "GET" }
+					}]
+				},
+				{
+					"trace_id": "7bba9f33312b3dbb8b2c2c62bb7abe2d",
+					"span_id": "086e83747d0e381f",
+					"name": "TestSpan",
+					"attributes": [{
+						"key": "http.method",
+						"value": { "stringValue": "POST" }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	const expectedOutputMetrics = `{
+		"resourceMetrics": [{
+			"resource": {
+				"attributes": [{
+					"key": "service.name",
+					"value": { "stringValue": "TestSvcName" }
+				}]
+			},
+			"scopeMetrics": [{
+				"scope": {
+					"name": "github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+				},
+				"metrics": [{
+					"name": "span.get.count",
+					"sum": {
+						"dataPoints": [{
+							"asInt": "1"
+						}],
+						"aggregationTemporality": 1,
+						"isMonotonic": true
+					}
+				}]
+			}]
+		}]
+	}`
+
+	cfg := `
+		span {
+			name       = "span.get.count"
+			conditions = ["attributes[\"http.method\"] == \"GET\""]
+		}
+		span_event {
+			name       = "span.event.count"
+			conditions = ["false"]
+		}
+		output {
+			// no-op: will be overridden by test code.
+		}
+	`
+
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.connector.count")
+	require.NoError(t, err)
+
+	var args count.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	testSignal := processortest.NewTraceToMetricSignal(inputTrace, expectedOutputMetrics)
+	args.Output = testSignal.MakeOutput()
+
+	processortest.TestRunProcessor(processortest.ProcessorRunConfig{
+		Ctx:        ctx,
+		T:          t,
+		Args:       args,
+		TestSignal: testSignal,
+		Ctrl:       ctrl,
+		L:          l,
+	})
+}
@@ -0,0 +1,178 @@
+// Package routing provides an otelcol.connector.routing component.
+package routing
+
+import (
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/connector"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.connector.routing",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   otelcol.ConsumerExports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := routingconnector.NewFactory()
+			return connector.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// defaultRouteName is the name of the pipeline which data that doesn't match
+// any route is sent to.
+const defaultRouteName = "default"
+
+// Arguments configures the otelcol.connector.routing component.
+type Arguments struct {
+	// ErrorMode determines how the connector reacts to errors that occur while
+	// evaluating the condition of a route.
+	ErrorMode ottl.ErrorMode `alloy:"error_mode,attr,optional"`
+
+	// Table is the routing table. Routes are evaluated in order.
+	Table []Route `alloy:"route,block"`
+
+	// DefaultOutput configures where to send data which doesn't match any
+	// route. Optional.
+	DefaultOutput *otelcol.ConsumerArguments `alloy:"default_output,block,optional"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+// Route configures a single entry of the routing table.
+type Route struct {
+	// Context is the OTTL context in which the statement or condition is
+	// evaluated.
+	Context string `alloy:"context,attr,optional"`
+
+	// Statement is an OTTL statement used for making a routing decision.
+	Statement string `alloy:"statement,attr,optional"`
+
+	// Condition is an OTTL condition used for making a routing decision.
+	Condition string `alloy:"condition,attr,optional"`
+
+	// Output configures where to send data which matches the route. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+}
+
+var (
+	_ syntax.Validator          = (*Arguments)(nil)
+	_ syntax.Defaulter          = (*Arguments)(nil)
+	_ syntax.Defaulter          = (*Route)(nil)
+	_ connector.RouterArguments = Arguments{}
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		ErrorMode: ottl.PropagateError,
+	}
+	args.DebugMetrics.SetToDefault()
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (r *Route) SetToDefault() {
+	*r = Route{
+		Context: "resource",
+	}
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	cfg, err := args.convertImpl(pipeline.SignalTraces)
+	if err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+// Convert implements connector.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	return args.convertImpl(pipeline.SignalTraces)
+}
+
+// ConvertForSignal implements connector.RouterArguments.
+func (args Arguments) ConvertForSignal(signal pipeline.Signal) (otelcomponent.Config, error) {
+	return args.convertImpl(signal)
+}
+
+// convertImpl is a helper function which returns the real type of the config,
+// instead of the otelcomponent.Config interface.
+func (args Arguments) convertImpl(signal pipeline.Signal) (*routingconnector.Config, error) {
+	table := make([]routingconnector.RoutingTableItem, 0, len(args.Table))
+	for i, route := range args.Table {
+		table = append(table, routingconnector.RoutingTableItem{
+			Context:   route.Context,
+			Statement: route.Statement,
+			Condition: route.Condition,
+			Pipelines: []pipeline.ID{pipeline.NewIDWithName(signal, routeName(i))},
+		})
+	}
+
+	var defaultPipelines []pipeline.ID
+	if args.DefaultOutput != nil {
+		defaultPipelines = []pipeline.ID{pipeline.NewIDWithName(signal, defaultRouteName)}
+	}
+
+	return &routingconnector.Config{
+		ErrorMode:        args.ErrorMode,
+		DefaultPipelines: defaultPipelines,
+		Table:            table,
+	}, nil
+}
+
+// Routes implements connector.RouterArguments.
+func (args Arguments) Routes() map[string]*otelcol.ConsumerArguments {
+	routes := make(map[string]*otelcol.ConsumerArguments, len(args.Table)+1)
+	for i, route := range args.Table {
+		if route.Output != nil {
+			routes[routeName(i)] = route.Output
+		}
+	}
+	if args.DefaultOutput != nil {
+		routes[defaultRouteName] = args.DefaultOutput
+	}
+	return routes
+}
+
+// routeName returns the name of the pipeline of the route at index i.
+func routeName(i int) string {
+	return fmt.Sprintf("route_%d", i)
+}
+
+// Extensions implements connector.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements connector.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements connector.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.DefaultOutput
+}
+
+// ConnectorType() int implements connector.Arguments.
+func (Arguments) ConnectorType() int {
+	return connector.ConnectorRouter
+}
+
+// DebugMetricsConfig implements connector.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
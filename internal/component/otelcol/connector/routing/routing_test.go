@@ -0,0 +1,221 @@
+package routing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/connector/routing"
+	"github.com/grafana/alloy/internal/component/otelcol/internal/fakeconsumer"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName string
+		cfg      string
+		expected *routingconnector.Config
+		errorMsg string
+	}{
+		{
+			testName: "Defaults",
+			cfg: `
+				route {
+					condition = "attributes[\"tenant\"] == \"acme\""
+					output {}
+				}
+			`,
+			expected: &routingconnector.Config{
+				ErrorMode: ottl.PropagateError,
+				Table: []routingconnector.RoutingTableItem{{
+					Context:   "resource",
+					Condition: `attributes["tenant"] == "acme"`,
+					Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalLogs, "route_0")},
+				}},
+			},
+		},
+		{
+			testName: "ExplicitValues",
+			cfg: `
+				error_mode = "ignore"
+				route {
+					context   = "log"
+					condition = "severity_number < SEVERITY_NUMBER_ERROR"
+					output {}
+				}
+				route {
+					context   = "request"
+					condition = "request[\"X-Tenant\"] == \"acme\""
+					output {}
+				}
+				default_output {}
+			`,
+			expected: &routingconnector.Config{
+				ErrorMode:        ottl.IgnoreError,
+				DefaultPipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalLogs, "default")},
+				Table: []routingconnector.RoutingTableItem{
+					{
+						Context:   "log",
+						Condition: "severity_number < SEVERITY_NUMBER_ERROR",
+						Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalLogs, "route_0")},
+					},
+					{
+						Context:   "request",
+						Condition: `request["X-Tenant"] == "acme"`,
+						Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalLogs, "route_1")},
+					},
+				},
+			},
+		},
+		{
+			testName: "NoRoutes",
+			cfg: `
+				default_output {}
+			`,
+			errorMsg: `missing required block "route"`,
+		},
+		{
+			testName: "ConditionAndStatement",
+			cfg: `
+				route {
+					condition = "true"
+					statement = "route() where true"
+					output {}
+				}
+			`,
+			errorMsg: "both condition and statement provided",
+		},
+		{
+			testName: "InvalidContext",
+			cfg: `
+				route {
+					context   = "scope"
+					condition = "true"
+					output {}
+				}
+			`,
+			errorMsg: "invalid context: scope",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args routing.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			actual, err := args.ConvertForSignal(pipeline.SignalLogs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_Routing(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.connector.routing")
+	require.NoError(t, err)
+
+	var args routing.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`
+		route {
+			context   = "log"
+			condition = "attributes[\"region\"] == \"east\""
+			output {}
+		}
+		route {
+			condition = "attributes[\"tenant\"] == \"acme\""
+			output {}
+		}
+		default_output {}
+	`), &args))
+
+	eastCh := make(chan plog.Logs, 10)
+	acmeCh := make(chan plog.Logs, 10)
+	defaultCh := make(chan plog.Logs, 10)
+	args.Table[0].Output = makeLogsOutput(eastCh)
+	args.Table[1].Output = makeLogsOutput(acmeCh)
+	args.DefaultOutput = makeLogsOutput(defaultCh)
+
+	go func() {
+		err := ctrl.Run(ctx, args)
+		require.NoError(t, err)
+	}()
+	require.NoError(t, ctrl.WaitRunning(time.Second), "component never started")
+	require.NoError(t, ctrl.WaitExports(time.Second), "component never exported anything")
+
+	input := plog.NewLogs()
+	for _, tenant := range []string{"acme", "ecorp"} {
+		rl := input.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		records := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for _, region := range []string{"east", "west"} {
+			lr := records.AppendEmpty()
+			lr.Attributes().PutStr("region", region)
+			lr.Body().SetStr(tenant + "-" + region)
+		}
+	}
+
+	// Wait for the component to be ready to accept data.
+	exports := ctrl.Exports().(otelcol.ConsumerExports)
+	require.Eventually(t, func() bool {
+		return exports.Input.ConsumeLogs(ctx, input) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.ElementsMatch(t, []string{"acme-east", "ecorp-east"}, logBodies(t, eastCh))
+	require.ElementsMatch(t, []string{"acme-west"}, logBodies(t, acmeCh))
+	require.ElementsMatch(t, []string{"ecorp-west"}, logBodies(t, defaultCh))
+}
+
+// makeLogsOutput returns ConsumerArguments which will forward logs to the
+// provided channel.
+func makeLogsOutput(ch chan plog.Logs) *otelcol.ConsumerArguments {
+	return &otelcol.ConsumerArguments{
+		Logs: []otelcol.Consumer{&fakeconsumer.Consumer{
+			ConsumeLogsFunc: func(ctx context.Context, ld plog.Logs) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ch <- ld:
+					return nil
+				}
+			},
+		}},
+	}
+}
+
+// logBodies returns the bodies of all log records received on ch.
+func logBodies(t *testing.T, ch chan plog.Logs) []string {
+	t.Helper()
+
+	var ld plog.Logs
+	select {
+	case ld = <-ch:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no logs received")
+	}
+
+	var bodies []string
+	for _, rl := range ld.ResourceLogs().All() {
+		for _, sl := range rl.ScopeLogs().All() {
+			for _, lr := range sl.LogRecords().All() {
+				bodies = append(bodies, lr.Body().Str())
+			}
+		}
+	}
+	return bodies
+}
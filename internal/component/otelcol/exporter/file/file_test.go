@@ -0,0 +1,190 @@
+package file_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/exporter/file"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName string
+		cfg      string
+		expected fileexporter.Config
+		errorMsg string
+	}{
+		{
+			testName: "Defaults",
+			cfg: `
+				path = "/tmp/otlp.json"
+			`,
+			expected: fileexporter.Config{
+				Path:          "/tmp/otlp.json",
+				FormatType:    "json",
+				FlushInterval: time.Second,
+			},
+		},
+		{
+			testName: "Rotation",
+			cfg: `
+				path           = "/tmp/otlp.pb"
+				format         = "proto"
+				compression    = "zstd"
+				flush_interval = "5s"
+
+				rotation {
+					max_days  = 3
+					localtime = true
+				}
+			`,
+			expected: fileexporter.Config{
+				Path:          "/tmp/otlp.pb",
+				FormatType:    "proto",
+				Compression:   "zstd",
+				FlushInterval: 5 * time.Second,
+				Rotation: &fileexporter.Rotation{
+					MaxMegabytes: 100,
+					MaxDays:      3,
+					MaxBackups:   100,
+					LocalTime:    true,
+				},
+			},
+		},
+		{
+			testName: "GroupBy",
+			cfg: `
+				path = "/tmp/otlp/*.json"
+
+				group_by {
+					resource_attribute = "service.name"
+				}
+			`,
+			expected: fileexporter.Config{
+				Path:          "/tmp/otlp/*.json",
+				FormatType:    "json",
+				FlushInterval: time.Second,
+				GroupBy: &fileexporter.GroupBy{
+					Enabled:           true,
+					ResourceAttribute: "service.name",
+					MaxOpenFiles:      100,
+				},
+			},
+		},
+		{
+			testName: "InvalidFormat",
+			cfg: `
+				path   = "/tmp/otlp.json"
+				format = "yaml"
+			`,
+			errorMsg: "format type is not supported",
+		},
+		{
+			testName: "AppendAndCompression",
+			cfg: `
+				path        = "/tmp/otlp.json"
+				append      = true
+				compression = "zstd"
+			`,
+			errorMsg: "append and compression enabled at the same time is not supported",
+		},
+		{
+			testName: "GroupByWithoutWildcard",
+			cfg: `
+				path = "/tmp/otlp.json"
+
+				group_by {}
+			`,
+			errorMsg: "path must contain exactly one * when group_by is enabled",
+		},
+		{
+			testName: "GroupByAndRotation",
+			cfg: `
+				path = "/tmp/otlp/*.json"
+
+				rotation {}
+				group_by {}
+			`,
+			errorMsg: "rotation can't be used together with group_by",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args file.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.errorMsg != "" {
+				require.EqualError(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			actualPtr, err := args.Convert()
+			require.NoError(t, err)
+
+			actual := actualPtr.(*fileexporter.Config)
+			require.Equal(t, tc.expected, *actual)
+		})
+	}
+}
+
+// Test performs a basic integration test which runs the otelcol.exporter.file
+// component and ensures that it writes the data it receives to a file.
+func Test(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.exporter.file")
+	require.NoError(t, err)
+
+	var args file.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`path = "`+filepath.ToSlash(path)+`"`), &args))
+	args.FlushInterval = 10 * time.Millisecond
+
+	go func() {
+		err := ctrl.Run(ctx, args)
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Second), "component never started")
+	require.NoError(t, ctrl.WaitExports(time.Second), "component never exported anything")
+
+	exports := ctrl.Exports().(otelcol.ConsumerExports)
+	require.NoError(t, exports.Input.ConsumeTraces(ctx, createTestTraces()))
+
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(path)
+		return err == nil && strings.Contains(string(b), `"name":"TestSpan"`)
+	}, 5*time.Second, 10*time.Millisecond, "traces were never written to the file")
+}
+
+func createTestTraces() ptrace.Traces {
+	data := `{
+		"resourceSpans": [{
+			"scopeSpans": [{
+				"spans": [{
+					"name": "TestSpan"
+				}]
+			}]
+		}]
+	}`
+
+	decoder := &ptrace.JSONUnmarshaler{}
+	traces, err := decoder.UnmarshalTraces([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+	return traces
+}
@@ -0,0 +1,228 @@
+// Package health_check provides an otelcol.extension.health_check component.
+package health_check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol/extension/internal/standalone"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	http_service "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/syntax"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.extension.health_check",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.extension.health_check component.
+type Arguments struct {
+	Components   []string               `alloy:"components,attr"`
+	Endpoint     string                 `alloy:"endpoint,attr,optional"`
+	Path         string                 `alloy:"path,attr,optional"`
+	ResponseBody *ResponseBodyArguments `alloy:"response_body,block,optional"`
+}
+
+// ResponseBodyArguments overrides the body of health check responses.
+type ResponseBodyArguments struct {
+	Healthy   string `alloy:"healthy,attr,optional"`
+	Unhealthy string `alloy:"unhealthy,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*Arguments)(nil)
+	_ syntax.Validator = (*Arguments)(nil)
+)
+
+// DefaultArguments holds default settings for Arguments.
+var DefaultArguments = Arguments{
+	Path: "/",
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = DefaultArguments
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	if !strings.HasPrefix(args.Path, "/") {
+		return fmt.Errorf("path must start with /")
+	}
+	if len(args.Components) == 0 {
+		return fmt.Errorf("components must not be empty")
+	}
+	seen := make(map[string]struct{}, len(args.Components))
+	for _, id := range args.Components {
+		if id == "" {
+			return fmt.Errorf("components must not contain empty IDs")
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("component %q is listed more than once", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// Component implements the otelcol.extension.health_check component.
+type Component struct {
+	opts            component.Options
+	id              component.ID
+	componentHealth func(id component.ID) (component.Health, error)
+	server          *standalone.Server
+
+	mut     sync.RWMutex
+	args    Arguments
+	upSince time.Time
+}
+
+var (
+	_ component.Component    = (*Component)(nil)
+	_ http_service.Component = (*Component)(nil)
+)
+
+// New creates a new otelcol.extension.health_check component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	data, err := opts.GetServiceData(http_service.ServiceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HTTP information: %w", err)
+	}
+
+	c := &Component{
+		opts:            opts,
+		id:              component.ParseID(opts.ID),
+		componentHealth: data.(http_service.Data).ComponentHealth,
+		upSince:         time.Now(),
+	}
+	c.server = standalone.New(opts.Logger, c.Handler())
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	defer c.server.Close()
+
+	<-ctx.Done()
+	return nil
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	c.mut.Lock()
+	c.args = newArgs
+	c.mut.Unlock()
+
+	return c.server.SetEndpoint(newArgs.Endpoint)
+}
+
+// Handler implements http_service.Component. The health check is served at
+// the configured path.
+func (c *Component) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mut.RLock()
+		args := c.args
+		c.mut.RUnlock()
+
+		if r.URL.Path != args.Path {
+			http.NotFound(w, r)
+			return
+		}
+
+		healthy := c.checkHealth(args.Components)
+
+		if args.ResponseBody != nil {
+			if healthy {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(args.ResponseBody.Healthy))
+			} else {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(args.ResponseBody.Unhealthy))
+			}
+			return
+		}
+
+		// The response mirrors the one of the upstream health_check extension,
+		// so that existing probes keep working.
+		var resp struct {
+			Status  string    `json:"status"`
+			UpSince time.Time `json:"upSince"`
+			Uptime  string    `json:"uptime"`
+		}
+		statusCode := http.StatusServiceUnavailable
+		resp.Status = "Server not available"
+		if healthy {
+			c.mut.RLock()
+			resp.UpSince = c.upSince
+			c.mut.RUnlock()
+
+			statusCode = http.StatusOK
+			resp.Status = "Server available"
+			resp.Uptime = time.Since(resp.UpSince).String()
+		}
+
+		body, _ := json.Marshal(resp)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write(body)
+	})
+}
+
+// checkHealth reports whether none of the given components, which are in the
+// same module as the component, is unhealthy. Components which can't be found
+// are reported as unhealthy.
+func (c *Component) checkHealth(ids []string) bool {
+	var unhealthy []string
+	for _, id := range ids {
+		health, err := c.componentHealth(component.ID{ModuleID: c.id.ModuleID, LocalID: id})
+		if err != nil {
+			level.Warn(c.opts.Logger).Log("msg", "failed to get component health", "component", id, "err", err)
+			unhealthy = append(unhealthy, id)
+			continue
+		}
+		if health.Health == component.HealthTypeUnhealthy || health.Health == component.HealthTypeCrashLooping {
+			unhealthy = append(unhealthy, id)
+		}
+	}
+	if len(unhealthy) > 0 {
+		level.Debug(c.opts.Logger).Log("msg", "reporting unhealthy status", "unhealthy_components", strings.Join(unhealthy, ", "))
+	}
+
+	c.setHealthy(len(unhealthy) == 0)
+	return len(unhealthy) == 0
+}
+
+// setHealthy tracks when the components became healthy for the uptime
+// reported in responses.
+func (c *Component) setHealthy(healthy bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	switch {
+	case !healthy:
+		c.upSince = time.Time{}
+	case c.upSince.IsZero():
+		c.upSince = time.Now()
+	}
+}
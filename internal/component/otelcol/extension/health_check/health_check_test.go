@@ -0,0 +1,220 @@
+package health_check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	http_service "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName    string
+		cfg         string
+		expected    Arguments
+		expectedErr string
+	}{
+		{
+			testName: "Defaults",
+			cfg:      `components = ["otelcol.receiver.otlp.default"]`,
+			expected: Arguments{Components: []string{"otelcol.receiver.otlp.default"}, Path: "/"},
+		},
+		{
+			testName: "Explicit values",
+			cfg: `
+				components = ["otelcol.receiver.otlp.default", "otelcol.exporter.otlp.default"]
+				endpoint   = "0.0.0.0:13133"
+				path     = "/health/status"
+
+				response_body {
+					healthy   = "I'm OK"
+					unhealthy = "I'm not well"
+				}
+			`,
+			expected: Arguments{
+				Components: []string{"otelcol.receiver.otlp.default", "otelcol.exporter.otlp.default"},
+				Endpoint:   "0.0.0.0:13133",
+				Path:       "/health/status",
+				ResponseBody: &ResponseBodyArguments{
+					Healthy:   "I'm OK",
+					Unhealthy: "I'm not well",
+				},
+			},
+		},
+		{
+			testName: "Invalid path",
+			cfg: `
+				components = ["otelcol.receiver.otlp.default"]
+				path       = "health"
+			`,
+			expectedErr: "path must start with /",
+		},
+		{
+			testName:    "Missing components",
+			cfg:         `components = []`,
+			expectedErr: "components must not be empty",
+		},
+		{
+			testName:    "Duplicate components",
+			cfg:         `components = ["otelcol.receiver.otlp.default", "otelcol.receiver.otlp.default"]`,
+			expectedErr: `component "otelcol.receiver.otlp.default" is listed more than once`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, args)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	health := map[component.ID]component.HealthType{
+		{ModuleID: "mod", LocalID: "otelcol.receiver.otlp.default"}: component.HealthTypeHealthy,
+		{ModuleID: "mod", LocalID: "otelcol.exporter.otlp.default"}: component.HealthTypeHealthy,
+		// Components which aren't listed don't affect the health check.
+		{ModuleID: "mod", LocalID: "prometheus.scrape.default"}: component.HealthTypeUnhealthy,
+	}
+
+	c := newTestComponent(t, "mod/otelcol.extension.health_check.default", health, Arguments{
+		Components: []string{"otelcol.receiver.otlp.default", "otelcol.exporter.otlp.default"},
+		Path:       "/",
+	})
+
+	resp := serve(c, "/")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Status  string    `json:"status"`
+		UpSince time.Time `json:"upSince"`
+		Uptime  string    `json:"uptime"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, "Server available", body.Status)
+	require.False(t, body.UpSince.IsZero())
+
+	health[component.ID{ModuleID: "mod", LocalID: "otelcol.exporter.otlp.default"}] = component.HealthTypeUnhealthy
+
+	resp = serve(c, "/")
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, "Server not available", body.Status)
+	require.True(t, body.UpSince.IsZero())
+}
+
+func TestHandler_MissingComponent(t *testing.T) {
+	c := newTestComponent(t, "otelcol.extension.health_check.default", nil, Arguments{
+		Components: []string{"otelcol.receiver.otlp.default"},
+		Path:       "/",
+	})
+
+	resp := serve(c, "/")
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestHandler_ResponseBody(t *testing.T) {
+	id := component.ID{LocalID: "otelcol.receiver.otlp.default"}
+	health := map[component.ID]component.HealthType{id: component.HealthTypeHealthy}
+
+	c := newTestComponent(t, "otelcol.extension.health_check.default", health, Arguments{
+		Components: []string{id.LocalID},
+		Path:       "/health/status",
+		ResponseBody: &ResponseBodyArguments{
+			Healthy:   "I'm OK",
+			Unhealthy: "I'm not well",
+		},
+	})
+
+	resp := serve(c, "/")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = serve(c, "/health/status")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	require.Equal(t, "I'm OK", string(body))
+
+	health[id] = component.HealthTypeUnhealthy
+
+	resp = serve(c, "/health/status")
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	body, _ = io.ReadAll(resp.Body)
+	require.Equal(t, "I'm not well", string(body))
+}
+
+func TestEndpoint(t *testing.T) {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	endpoint := fmt.Sprintf("127.0.0.1:%d", port)
+
+	id := component.ID{LocalID: "otelcol.receiver.otlp.default"}
+	c := newTestComponent(t, "otelcol.extension.health_check.default", map[component.ID]component.HealthType{
+		id: component.HealthTypeHealthy,
+	}, Arguments{Components: []string{id.LocalID}, Endpoint: endpoint, Path: "/"})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, c.Run(ctx))
+	}()
+
+	resp, err := http.Get("http://" + endpoint + "/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cancel()
+	<-done
+
+	_, err = http.Get("http://" + endpoint + "/")
+	require.Error(t, err)
+}
+
+// newTestComponent creates a component which looks up the health of
+// components in health. Components missing from health aren't found.
+func newTestComponent(t *testing.T, id string, health map[component.ID]component.HealthType, args Arguments) *Component {
+	componentHealth := func(id component.ID) (component.Health, error) {
+		h, ok := health[id]
+		if !ok {
+			return component.Health{}, component.ErrComponentNotFound
+		}
+		return component.Health{Health: h}, nil
+	}
+
+	opts := component.Options{
+		ID:     id,
+		Logger: util.TestAlloyLogger(t),
+		GetServiceData: func(name string) (interface{}, error) {
+			require.Equal(t, http_service.ServiceName, name)
+			return http_service.Data{ComponentHealth: componentHealth}, nil
+		},
+	}
+
+	c, err := New(opts, args)
+	require.NoError(t, err)
+	return c
+}
+
+func serve(c *Component, path string) *http.Response {
+	rec := httptest.NewRecorder()
+	c.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Result()
+}
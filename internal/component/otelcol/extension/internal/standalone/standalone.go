@@ -0,0 +1,88 @@
+// Package standalone serves the HTTP handler of an otelcol.extension
+// component on a dedicated endpoint, in addition to the Alloy HTTP server.
+package standalone
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// Server serves an HTTP handler on an endpoint which can change at runtime.
+// The zero value isn't usable; use [New] instead.
+type Server struct {
+	logger  log.Logger
+	handler http.Handler
+
+	mut      sync.Mutex
+	endpoint string
+	srv      *http.Server
+	done     chan struct{}
+}
+
+// New creates a new Server for the provided handler. The Server doesn't
+// listen for traffic until [Server.SetEndpoint] is called.
+func New(logger log.Logger, handler http.Handler) *Server {
+	return &Server{
+		logger:  logger,
+		handler: handler,
+	}
+}
+
+// SetEndpoint starts serving traffic on endpoint, stopping the server of the
+// previous endpoint. An empty endpoint stops serving traffic. SetEndpoint is
+// a no-op if endpoint didn't change.
+func (s *Server) SetEndpoint(endpoint string) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if endpoint == s.endpoint {
+		return nil
+	}
+	s.stop()
+
+	if endpoint == "" {
+		return nil
+	}
+
+	lis, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", endpoint, err)
+	}
+
+	srv := &http.Server{Handler: s.handler}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		level.Info(s.logger).Log("msg", "now listening for http traffic", "addr", lis.Addr())
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			level.Error(s.logger).Log("msg", "http server closed", "addr", lis.Addr(), "err", err)
+		}
+	}()
+
+	s.endpoint, s.srv, s.done = endpoint, srv, done
+	return nil
+}
+
+// Close stops serving traffic.
+func (s *Server) Close() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.stop()
+}
+
+func (s *Server) stop() {
+	if s.srv == nil {
+		return
+	}
+	_ = s.srv.Close()
+	<-s.done
+	s.endpoint, s.srv, s.done = "", nil, nil
+}
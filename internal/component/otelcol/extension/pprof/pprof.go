@@ -0,0 +1,143 @@
+// Package pprof provides an otelcol.extension.pprof component.
+package pprof
+
+import (
+	"context"
+	"net/http"
+	httppprof "net/http/pprof"
+	"runtime"
+	"sync"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol/extension/internal/standalone"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/componentprof"
+	http_service "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/internal/util/profilerate"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.extension.pprof",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.extension.pprof component.
+type Arguments struct {
+	Endpoint string `alloy:"endpoint,attr,optional"`
+
+	// BlockProfileFraction and MutexProfileFraction override the rates of the
+	// block and mutex profiles of the process when set. A negative value
+	// disables the profile. The previous rates are restored when they're unset
+	// or the component stops.
+	BlockProfileFraction int `alloy:"block_profile_fraction,attr,optional"`
+	MutexProfileFraction int `alloy:"mutex_profile_fraction,attr,optional"`
+}
+
+// Component implements the otelcol.extension.pprof component.
+type Component struct {
+	handler http.Handler
+	server  *standalone.Server
+
+	ratesMut      sync.Mutex
+	blockRate     rateOverride
+	mutexFraction rateOverride
+}
+
+var (
+	_ component.Component    = (*Component)(nil)
+	_ http_service.Component = (*Component)(nil)
+)
+
+// New creates a new otelcol.extension.pprof component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	// The profiles are served at /debug/pprof/, like the upstream pprof
+	// extension does. httppprof.Index relies on that prefix to look up
+	// profiles by name.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.Handle("/debug/pprof/profile", componentprof.CPUProfileHandler(http.HandlerFunc(httppprof.Profile)))
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+
+	c := &Component{
+		handler:       mux,
+		server:        standalone.New(opts.Logger, mux),
+		blockRate:     rateOverride{set: profilerate.SetBlockProfileRate},
+		mutexFraction: rateOverride{set: runtime.SetMutexProfileFraction},
+	}
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	defer c.server.Close()
+	defer c.restoreRates()
+
+	<-ctx.Done()
+	return nil
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	c.ratesMut.Lock()
+	c.blockRate.apply(newArgs.BlockProfileFraction)
+	c.mutexFraction.apply(newArgs.MutexProfileFraction)
+	c.ratesMut.Unlock()
+
+	return c.server.SetEndpoint(newArgs.Endpoint)
+}
+
+// restoreRates restores the profile rates which the component overrode.
+func (c *Component) restoreRates() {
+	c.ratesMut.Lock()
+	defer c.ratesMut.Unlock()
+
+	c.blockRate.restore()
+	c.mutexFraction.restore()
+}
+
+// rateOverride overrides a process-wide profile rate and restores the rate
+// which was in effect before once it's no longer overridden.
+type rateOverride struct {
+	set  func(rate int) (prev int)
+	prev *int // Rate before the override, nil when the rate isn't overridden.
+}
+
+// apply overrides the rate with fraction. A negative fraction disables the
+// profile, and 0 restores the previous rate.
+func (o *rateOverride) apply(fraction int) {
+	if fraction == 0 {
+		o.restore()
+		return
+	}
+	prev := o.set(max(fraction, 0))
+	if o.prev == nil {
+		o.prev = &prev
+	}
+}
+
+func (o *rateOverride) restore() {
+	if o.prev == nil {
+		return
+	}
+	o.set(*o.prev)
+	o.prev = nil
+}
+
+// Handler implements http_service.Component.
+func (c *Component) Handler() http.Handler {
+	return c.handler
+}
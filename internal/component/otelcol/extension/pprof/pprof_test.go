@@ -0,0 +1,144 @@
+package pprof
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/internal/util/profilerate"
+	"github.com/grafana/alloy/syntax"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	cfg := `
+		endpoint               = "localhost:1777"
+		block_profile_fraction = 3
+		mutex_profile_fraction = -1
+	`
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+	require.Equal(t, Arguments{
+		Endpoint:             "localhost:1777",
+		BlockProfileFraction: 3,
+		MutexProfileFraction: -1,
+	}, args)
+}
+
+func TestHandler(t *testing.T) {
+	c, err := New(component.Options{Logger: util.TestAlloyLogger(t)}, Arguments{})
+	require.NoError(t, err)
+
+	// The index lists the available profiles.
+	rec := serve(c, "/debug/pprof/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "goroutine")
+	require.Contains(t, rec.Body.String(), "heap")
+
+	// Profiles are served in the pprof format by default.
+	for _, path := range []string{"/debug/pprof/heap", "/debug/pprof/goroutine"} {
+		rec = serve(c, path)
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		p, err := profile.Parse(rec.Body)
+		require.NoError(t, err, path)
+		require.NotEmpty(t, p.Sample, path)
+	}
+
+	// The goroutine profile includes the stack of the test.
+	rec = serve(c, "/debug/pprof/goroutine?debug=1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "TestHandler")
+
+	rec = serve(c, "/debug/pprof/cmdline")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, strings.Join(os.Args, "\x00"), rec.Body.String())
+
+	rec = serve(c, "/debug/pprof/unknown")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestProfileFractions(t *testing.T) {
+	prevMutex := runtime.SetMutexProfileFraction(7)
+	prevBlock := profilerate.SetBlockProfileRate(10_000)
+	t.Cleanup(func() {
+		runtime.SetMutexProfileFraction(prevMutex)
+		profilerate.SetBlockProfileRate(prevBlock)
+	})
+
+	c, err := New(component.Options{Logger: util.TestAlloyLogger(t)}, Arguments{MutexProfileFraction: 5})
+	require.NoError(t, err)
+	require.Equal(t, 5, runtime.SetMutexProfileFraction(-1))
+	require.Equal(t, 10_000, profilerate.BlockProfileRate())
+
+	// Unset fractions restore the rates of the process.
+	require.NoError(t, c.Update(Arguments{}))
+	require.Equal(t, 7, runtime.SetMutexProfileFraction(-1))
+
+	require.NoError(t, c.Update(Arguments{MutexProfileFraction: -1}))
+	require.Equal(t, 0, runtime.SetMutexProfileFraction(-1))
+	require.NoError(t, c.Update(Arguments{MutexProfileFraction: 3}))
+	require.Equal(t, 3, runtime.SetMutexProfileFraction(-1))
+	require.NoError(t, c.Update(Arguments{}))
+	require.Equal(t, 7, runtime.SetMutexProfileFraction(-1))
+
+	// Check that blocking events are recorded once the block profile is
+	// enabled.
+	require.NoError(t, c.Update(Arguments{BlockProfileFraction: 1}))
+	require.Equal(t, 1, profilerate.BlockProfileRate())
+	ch := make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(ch)
+	}()
+	<-ch
+
+	rec := serve(c, "/debug/pprof/block")
+	require.Equal(t, http.StatusOK, rec.Code)
+	p, err := profile.Parse(rec.Body)
+	require.NoError(t, err)
+	require.NotEmpty(t, p.Sample)
+}
+
+func TestProfileFractions_RestoredOnStop(t *testing.T) {
+	prevMutex := runtime.SetMutexProfileFraction(7)
+	prevBlock := profilerate.SetBlockProfileRate(10_000)
+	t.Cleanup(func() {
+		runtime.SetMutexProfileFraction(prevMutex)
+		profilerate.SetBlockProfileRate(prevBlock)
+	})
+
+	c, err := New(component.Options{Logger: util.TestAlloyLogger(t)}, Arguments{
+		BlockProfileFraction: 1,
+		MutexProfileFraction: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, profilerate.BlockProfileRate())
+	require.Equal(t, 5, runtime.SetMutexProfileFraction(-1))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, c.Run(ctx))
+	}()
+	cancel()
+	<-done
+
+	require.Equal(t, 10_000, profilerate.BlockProfileRate())
+	require.Equal(t, 7, runtime.SetMutexProfileFraction(-1))
+}
+
+func serve(c *Component, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	c.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
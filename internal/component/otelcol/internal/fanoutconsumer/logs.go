@@ -77,10 +77,10 @@ func (f *logsFanout) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	for _, f := range f.clone {
 		newLogs := plog.NewLogs()
 		ld.CopyTo(newLogs)
-		errs = multierr.Append(errs, f.ConsumeLogs(ctx, newLogs))
+		errs = multierr.Append(errs, consumeLogs(ctx, f, newLogs))
 	}
 	for _, f := range f.passthrough {
-		errs = multierr.Append(errs, f.ConsumeLogs(ctx, ld))
+		errs = multierr.Append(errs, consumeLogs(ctx, f, ld))
 	}
 
 	return errs
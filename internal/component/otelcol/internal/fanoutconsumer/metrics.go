@@ -74,10 +74,10 @@ func (f *metricsFanout) ConsumeMetrics(ctx context.Context, md pmetric.Metrics)
 	for _, f := range f.clone {
 		newMetrics := pmetric.NewMetrics()
 		md.CopyTo(newMetrics)
-		errs = multierr.Append(errs, f.ConsumeMetrics(ctx, newMetrics))
+		errs = multierr.Append(errs, consumeMetrics(ctx, f, newMetrics))
 	}
 	for _, f := range f.passthrough {
-		errs = multierr.Append(errs, f.ConsumeMetrics(ctx, md))
+		errs = multierr.Append(errs, consumeMetrics(ctx, f, md))
 	}
 
 	return errs
@@ -0,0 +1,39 @@
+package fanoutconsumer
+
+import (
+	"context"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelconsumer "go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The functions below pass data to a single consumer of a fanout. A panic of
+// the consumer is recovered and returned as an error, so that the other
+// consumers still get the data. It's reported on the component which exports
+// the consumer, if the consumer implements otelcol.ComponentMetadata.
+
+func consumeTraces(ctx context.Context, c otelconsumer.Traces, td ptrace.Traces) (err error) {
+	defer component.RecoverPanic(componentID(c), &err)
+	return c.ConsumeTraces(ctx, td)
+}
+
+func consumeMetrics(ctx context.Context, c otelconsumer.Metrics, md pmetric.Metrics) (err error) {
+	defer component.RecoverPanic(componentID(c), &err)
+	return c.ConsumeMetrics(ctx, md)
+}
+
+func consumeLogs(ctx context.Context, c otelconsumer.Logs, ld plog.Logs) (err error) {
+	defer component.RecoverPanic(componentID(c), &err)
+	return c.ConsumeLogs(ctx, ld)
+}
+
+func componentID(c any) string {
+	if m, ok := c.(otelcol.ComponentMetadata); ok {
+		return m.ComponentID()
+	}
+	return ""
+}
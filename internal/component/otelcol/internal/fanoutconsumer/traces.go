@@ -74,10 +74,10 @@ func (f *tracesFanout) ConsumeTraces(ctx context.Context, td ptrace.Traces) erro
 	for _, f := range f.clone {
 		newTraces := ptrace.NewTraces()
 		td.CopyTo(newTraces)
-		errs = multierr.Append(errs, f.ConsumeTraces(ctx, newTraces))
+		errs = multierr.Append(errs, consumeTraces(ctx, f, newTraces))
 	}
 	for _, f := range f.passthrough {
-		errs = multierr.Append(errs, f.ConsumeTraces(ctx, td))
+		errs = multierr.Append(errs, consumeTraces(ctx, f, td))
 	}
 
 	return errs
@@ -6,6 +6,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/grafana/alloy/internal/component"
 	"github.com/grafana/alloy/internal/component/otelcol"
 	otelconsumer "go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -68,7 +69,11 @@ func (c *Consumer) Capabilities() otelconsumer.Capabilities {
 }
 
 // ConsumeTraces implements otelconsumer.Traces.
-func (c *Consumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+func (c *Consumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) (err error) {
+	// The consumer runs in the goroutine of the sending component, so panics of
+	// the downstream component are recovered and reported on it here.
+	defer component.RecoverPanic(c.componentID, &err)
+
 	if c.ctx.Err() != nil {
 		return c.ctx.Err()
 	}
@@ -91,7 +96,11 @@ func (c *Consumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 }
 
 // ConsumeMetrics implements otelconsumer.Metrics.
-func (c *Consumer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+func (c *Consumer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) (err error) {
+	// The consumer runs in the goroutine of the sending component, so panics of
+	// the downstream component are recovered and reported on it here.
+	defer component.RecoverPanic(c.componentID, &err)
+
 	if c.ctx.Err() != nil {
 		return c.ctx.Err()
 	}
@@ -114,7 +123,11 @@ func (c *Consumer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error
 }
 
 // ConsumeLogs implements otelconsumer.Logs.
-func (c *Consumer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+func (c *Consumer) ConsumeLogs(ctx context.Context, ld plog.Logs) (err error) {
+	// The consumer runs in the goroutine of the sending component, so panics of
+	// the downstream component are recovered and reported on it here.
+	defer component.RecoverPanic(c.componentID, &err)
+
 	if c.ctx.Err() != nil {
 		return c.ctx.Err()
 	}
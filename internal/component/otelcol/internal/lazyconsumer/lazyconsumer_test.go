@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	otelconsumer "go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/goleak"
 
+	"github.com/grafana/alloy/internal/component"
 	"github.com/grafana/alloy/internal/runtime/componenttest"
 )
 
@@ -158,3 +160,22 @@ func Test_PauseResume_Multithreaded(t *testing.T) {
 	// Should not be paused as last call will always be c.Resume()
 	require.False(t, c.IsPaused())
 }
+
+func Test_RecoversPanics(t *testing.T) {
+	var panics int
+	defer component.RegisterPanicHandler("test_component", func(*component.PanicError) { panics++ })()
+
+	panicking, err := otelconsumer.NewTraces(func(context.Context, ptrace.Traces) error {
+		panic("oops")
+	})
+	require.NoError(t, err)
+
+	c := New(componenttest.TestContext(t), "test_component")
+	c.SetConsumers(panicking, nil, nil)
+
+	err = c.ConsumeTraces(t.Context(), ptrace.NewTraces())
+	var pe *component.PanicError
+	require.ErrorAs(t, err, &pe)
+	require.Equal(t, "oops", pe.Value)
+	require.Equal(t, 1, panics)
+}
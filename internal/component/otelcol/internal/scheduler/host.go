@@ -47,3 +47,9 @@ var _ otelcomponent.Host = (*Host)(nil)
 func (h *Host) GetExtensions() map[otelcomponent.ID]otelcomponent.Component {
 	return h.extensions
 }
+
+// GetExporters returns the exporters of the Host. Receivers which forward
+// metadata to exporters, like the k8s_cluster receiver, require it.
+func (h *Host) GetExporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return h.exporters
+}
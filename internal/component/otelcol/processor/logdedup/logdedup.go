@@ -0,0 +1,107 @@
+// Package logdedup provides an otelcol.processor.logdedup component.
+package logdedup
+
+import (
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/processor"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.processor.logdedup",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   otelcol.ConsumerExports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := logdedupprocessor.NewFactory()
+			return processor.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.processor.logdedup component.
+type Arguments struct {
+	LogCountAttribute string        `alloy:"log_count_attribute,attr,optional"`
+	Interval          time.Duration `alloy:"interval,attr,optional"`
+	Timezone          string        `alloy:"timezone,attr,optional"`
+	ExcludeFields     []string      `alloy:"exclude_fields,attr,optional"`
+	IncludeFields     []string      `alloy:"include_fields,attr,optional"`
+	Conditions        []string      `alloy:"conditions,attr,optional"`
+
+	// Output configures where to send processed data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+var (
+	_ processor.Arguments = Arguments{}
+	_ syntax.Validator    = (*Arguments)(nil)
+	_ syntax.Defaulter    = (*Arguments)(nil)
+)
+
+// DefaultArguments holds default settings for Arguments.
+var DefaultArguments = Arguments{
+	LogCountAttribute: "log_count",
+	Interval:          10 * time.Second,
+	Timezone:          "UTC",
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = DefaultArguments
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	cfg, err := args.Convert()
+	if err != nil {
+		return err
+	}
+
+	return cfg.(*logdedupprocessor.Config).Validate()
+}
+
+// Convert implements processor.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	return &logdedupprocessor.Config{
+		LogCountAttribute: args.LogCountAttribute,
+		Interval:          args.Interval,
+		Timezone:          args.Timezone,
+		ExcludeFields:     append([]string{}, args.ExcludeFields...),
+		IncludeFields:     append([]string{}, args.IncludeFields...),
+		Conditions:        append([]string{}, args.Conditions...),
+	}, nil
+}
+
+// Extensions implements processor.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements processor.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements processor.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements processor.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
@@ -0,0 +1,156 @@
+package logdedup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/processor/logdedup"
+	"github.com/grafana/alloy/internal/component/otelcol/processor/processortest"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName string
+		cfg      string
+		expected logdedupprocessor.Config
+		errorMsg string
+	}{
+		{
+			testName: "Defaults",
+			cfg: `
+				output {}
+			`,
+			expected: logdedupprocessor.Config{
+				LogCountAttribute: "log_count",
+				Interval:          10 * time.Second,
+				Timezone:          "UTC",
+				ExcludeFields:     []string{},
+				IncludeFields:     []string{},
+				Conditions:        []string{},
+			},
+		},
+		{
+			testName: "ExplicitValues",
+			cfg: `
+				log_count_attribute = "dedup_count"
+				interval            = "1m"
+				timezone            = "America/Los_Angeles"
+				exclude_fields      = ["body.timestamp", "attributes.host\\.name"]
+				conditions          = ["attributes[\"ID\"] == 1"]
+				output {}
+			`,
+			expected: logdedupprocessor.Config{
+				LogCountAttribute: "dedup_count",
+				Interval:          time.Minute,
+				Timezone:          "America/Los_Angeles",
+				ExcludeFields:     []string{"body.timestamp", `attributes.host\.name`},
+				IncludeFields:     []string{},
+				Conditions:        []string{`attributes["ID"] == 1`},
+			},
+		},
+		{
+			testName: "InvalidInterval",
+			cfg: `
+				interval = "0s"
+				output {}
+			`,
+			errorMsg: "interval must be greater than 0",
+		},
+		{
+			testName: "InvalidTimezone",
+			cfg: `
+				timezone = "Mars/Olympus_Mons"
+				output {}
+			`,
+			errorMsg: "timezone is invalid: unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			testName: "ExcludeBody",
+			cfg: `
+				exclude_fields = ["body"]
+				output {}
+			`,
+			errorMsg: "cannot exclude the entire body",
+		},
+		{
+			testName: "ExcludeAndIncludeFields",
+			cfg: `
+				exclude_fields = ["body.timestamp"]
+				include_fields = ["attributes.id"]
+				output {}
+			`,
+			errorMsg: "cannot define both exclude_fields and include_fields",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args logdedup.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.errorMsg != "" {
+				require.EqualError(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			actualPtr, err := args.Convert()
+			require.NoError(t, err)
+
+			actual := actualPtr.(*logdedupprocessor.Config)
+			require.Equal(t, tc.expected, *actual)
+		})
+	}
+}
+
+// TestLogProcessing checks that logs which don't match the conditions are
+// forwarded without being deduplicated.
+func TestLogProcessing(t *testing.T) {
+	cfg := `
+		conditions = ["attributes[\"dedup\"] == true"]
+		output {
+			// no-op: will be overridden by test code.
+		}
+	`
+
+	var inputLogs = `{
+		"resourceLogs": [{
+			"scopeLogs": [{
+				"logRecords": [{
+					"body": { "stringValue": "hello" },
+					"attributes": [{
+						"key": "dedup",
+						"value": { "boolValue": false }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.processor.logdedup")
+	require.NoError(t, err)
+
+	var args logdedup.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	testSignal := processortest.NewLogSignal(inputLogs, inputLogs)
+
+	// Override the arguments so signals get forwarded to the test channel.
+	args.Output = testSignal.MakeOutput()
+
+	processortest.TestRunProcessor(processortest.ProcessorRunConfig{
+		Ctx:        ctx,
+		T:          t,
+		Args:       args,
+		TestSignal: testSignal,
+		Ctrl:       ctrl,
+		L:          l,
+	})
+}
@@ -0,0 +1,146 @@
+// Package redaction provides an otelcol.processor.redaction component.
+package redaction
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/processor"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.processor.redaction",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   otelcol.ConsumerExports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := redactionprocessor.NewFactory()
+			return processor.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Supported values for the summary argument.
+const (
+	SummaryDebug  = "debug"
+	SummaryInfo   = "info"
+	SummarySilent = "silent"
+)
+
+// Arguments configures the otelcol.processor.redaction component.
+type Arguments struct {
+	AllowAllKeys       bool     `alloy:"allow_all_keys,attr,optional"`
+	AllowedKeys        []string `alloy:"allowed_keys,attr,optional"`
+	IgnoredKeys        []string `alloy:"ignored_keys,attr,optional"`
+	BlockedKeyPatterns []string `alloy:"blocked_key_patterns,attr,optional"`
+	BlockedValues      []string `alloy:"blocked_values,attr,optional"`
+	AllowedValues      []string `alloy:"allowed_values,attr,optional"`
+	RedactAllTypes     bool     `alloy:"redact_all_types,attr,optional"`
+	HashFunction       string   `alloy:"hash_function,attr,optional"`
+	Summary            string   `alloy:"summary,attr,optional"`
+
+	// Output configures where to send processed data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+var (
+	_ processor.Arguments = Arguments{}
+	_ syntax.Validator    = (*Arguments)(nil)
+	_ syntax.Defaulter    = (*Arguments)(nil)
+)
+
+// DefaultArguments holds default settings for Arguments.
+var DefaultArguments = Arguments{
+	Summary: SummarySilent,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = DefaultArguments
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+
+	switch args.Summary {
+	case SummaryDebug, SummaryInfo, SummarySilent:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("invalid summary %q, must be one of %q, %q or %q", args.Summary, SummaryDebug, SummaryInfo, SummarySilent))
+	}
+
+	errs = errors.Join(errs, validateRegexps("blocked_key_patterns", args.BlockedKeyPatterns))
+	errs = errors.Join(errs, validateRegexps("blocked_values", args.BlockedValues))
+	errs = errors.Join(errs, validateRegexps("allowed_values", args.AllowedValues))
+
+	if _, err := args.Convert(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	return errs
+}
+
+func validateRegexps(name string, patterns []string) error {
+	var errs error
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid regular expression %q in %s: %w", pattern, name, err))
+		}
+	}
+	return errs
+}
+
+// Convert implements processor.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	var hashFunction redactionprocessor.HashFunction
+	if err := hashFunction.UnmarshalText([]byte(args.HashFunction)); err != nil {
+		return nil, err
+	}
+
+	return &redactionprocessor.Config{
+		AllowAllKeys:       args.AllowAllKeys,
+		AllowedKeys:        args.AllowedKeys,
+		IgnoredKeys:        args.IgnoredKeys,
+		BlockedKeyPatterns: args.BlockedKeyPatterns,
+		BlockedValues:      args.BlockedValues,
+		AllowedValues:      args.AllowedValues,
+		RedactAllTypes:     args.RedactAllTypes,
+		HashFunction:       hashFunction,
+		Summary:            args.Summary,
+	}, nil
+}
+
+// Extensions implements processor.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements processor.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements processor.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements processor.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
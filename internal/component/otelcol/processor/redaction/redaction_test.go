@@ -0,0 +1,239 @@
+package redaction_test
+
+import (
+	"testing"
+
+	"github.com/grafana/alloy/internal/component/otelcol/processor/processortest"
+	"github.com/grafana/alloy/internal/component/otelcol/processor/redaction"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	tests := []struct {
+		testName string
+		cfg      string
+		expected redactionprocessor.Config
+		errorMsg string
+	}{
+		{
+			testName: "Defaults",
+			cfg: `
+				output {}
+			`,
+			expected: redactionprocessor.Config{
+				Summary: "silent",
+			},
+		},
+		{
+			testName: "ExplicitValues",
+			cfg: `
+				allow_all_keys       = true
+				allowed_keys         = ["id", "name"]
+				ignored_keys         = ["safe_attribute"]
+				blocked_key_patterns = [".*token.*"]
+				blocked_values       = ["4[0-9]{12}(?:[0-9]{3})?"]
+				allowed_values       = [".+@example.com"]
+				redact_all_types     = true
+				hash_function        = "sha3"
+				summary              = "debug"
+				output {}
+			`,
+			expected: redactionprocessor.Config{
+				AllowAllKeys:       true,
+				AllowedKeys:        []string{"id", "name"},
+				IgnoredKeys:        []string{"safe_attribute"},
+				BlockedKeyPatterns: []string{".*token.*"},
+				BlockedValues:      []string{"4[0-9]{12}(?:[0-9]{3})?"},
+				AllowedValues:      []string{".+@example.com"},
+				RedactAllTypes:     true,
+				HashFunction:       redactionprocessor.SHA3,
+				Summary:            "debug",
+			},
+		},
+		{
+			testName: "InvalidSummary",
+			cfg: `
+				summary = "verbose"
+				output {}
+			`,
+			errorMsg: `invalid summary "verbose", must be one of "debug", "info" or "silent"`,
+		},
+		{
+			testName: "InvalidHashFunction",
+			cfg: `
+				hash_function = "sha256"
+				output {}
+			`,
+			errorMsg: "unknown HashFunction sha256, allowed functions are sha1, sha3 and md5",
+		},
+		{
+			testName: "InvalidBlockedValue",
+			cfg: `
+				blocked_values = ["("]
+				output {}
+			`,
+			errorMsg: "invalid regular expression \"(\" in blocked_values: error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			var args redaction.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.errorMsg != "" {
+				require.EqualError(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			actualPtr, err := args.Convert()
+			require.NoError(t, err)
+
+			actual := actualPtr.(*redactionprocessor.Config)
+			require.Equal(t, tc.expected, *actual)
+		})
+	}
+}
+
+func testRunProcessor(t *testing.T, processorConfig string, testSignal processortest.Signal) {
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.processor.redaction")
+	require.NoError(t, err)
+
+	var args redaction.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(processorConfig), &args))
+
+	// Override the arguments so signals get forwarded to the test channel.
+	args.Output = testSignal.MakeOutput()
+
+	prc := processortest.ProcessorRunConfig{
+		Ctx:        ctx,
+		T:          t,
+		Args:       args,
+		TestSignal: testSignal,
+		Ctrl:       ctrl,
+		L:          l,
+	}
+	processortest.TestRunProcessor(prc)
+}
+
+func TestTraceProcessing(t *testing.T) {
+	cfg := `
+		allowed_keys   = ["id", "card"]
+		blocked_values = ["4[0-9]{12}(?:[0-9]{3})?"]
+		summary        = "debug"
+		output {
+			// no-op: will be overridden by test code.
+		}
+	`
+
+	var inputTraces = `{
+		"resourceSpans": [{
+			"scopeSpans": [{
+				"spans": [{
+					"name": "TestSpan",
+					"attributes": [{
+						"key": "id",
+						"value": { "stringValue": "123" }
+					},
+					{
+						"key": "card",
+						"value": { "stringValue": "4111111111111111" }
+					},
+					{
+						"key": "password",
+						"value": { "stringValue": "hunter2" }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	var expectedOutputTraces = `{
+		"resourceSpans": [{
+			"scopeSpans": [{
+				"spans": [{
+					"name": "TestSpan",
+					"attributes": [{
+						"key": "id",
+						"value": { "stringValue": "123" }
+					},
+					{
+						"key": "card",
+						"value": { "stringValue": "****" }
+					},
+					{
+						"key": "redaction.redacted.keys",
+						"value": { "stringValue": "password" }
+					},
+					{
+						"key": "redaction.redacted.count",
+						"value": { "intValue": "1" }
+					},
+					{
+						"key": "redaction.masked.keys",
+						"value": { "stringValue": "card" }
+					},
+					{
+						"key": "redaction.masked.count",
+						"value": { "intValue": "1" }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	testRunProcessor(t, cfg, processortest.NewTraceSignal(inputTraces, expectedOutputTraces))
+}
+
+func TestLogProcessing(t *testing.T) {
+	cfg := `
+		allow_all_keys       = true
+		blocked_key_patterns = [".*token.*"]
+		output {
+			// no-op: will be overridden by test code.
+		}
+	`
+
+	var inputLogs = `{
+		"resourceLogs": [{
+			"scopeLogs": [{
+				"logRecords": [{
+					"attributes": [{
+						"key": "user",
+						"value": { "stringValue": "alice" }
+					},
+					{
+						"key": "access_token",
+						"value": { "stringValue": "s3cr3t" }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	var expectedOutputLogs = `{
+		"resourceLogs": [{
+			"scopeLogs": [{
+				"logRecords": [{
+					"attributes": [{
+						"key": "user",
+						"value": { "stringValue": "alice" }
+					},
+					{
+						"key": "access_token",
+						"value": { "stringValue": "****" }
+					}]
+				}]
+			}]
+		}]
+	}`
+
+	testRunProcessor(t, cfg, processortest.NewLogSignal(inputLogs, expectedOutputLogs))
+}
@@ -0,0 +1,528 @@
+package tail_sampling
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/processor"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service/cluster"
+	http_service "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/ckit/peer"
+	"github.com/grafana/ckit/shard"
+	tsp "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+	client_prometheus "github.com/prometheus/client_golang/prometheus"
+	otelconsumer "go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// forwardPath is the path of the HTTP endpoint which receives spans forwarded
+// by other cluster peers, relative to the component's HTTP path.
+const forwardPath = "forward"
+
+// handOffGracePeriod is how long, in addition to decision_wait, the spans of a
+// handed off trace are dropped from the output. It covers the delay between
+// the end of decision_wait and the evaluation of the trace by the processor.
+const handOffGracePeriod = 10 * time.Second
+
+// forwardTimeout is the maximum time to wait for a peer to accept forwarded
+// spans.
+const forwardTimeout = 10 * time.Second
+
+// maxForwardSize is the maximum size of a request with forwarded spans. Peers
+// forward spans from the batches they receive, so it's larger than the
+// default maximum request size of the OTLP receivers.
+const maxForwardSize = 64 << 20
+
+// Component is the otelcol.processor.tail_sampling component.
+//
+// When clustering is enabled, spans are routed to the cluster peer which owns
+// their trace ID so that all spans of a trace are sampled by the same peer.
+type Component struct {
+	opts      component.Options
+	processor *processor.Processor
+
+	// local is the input of the wrapped processor.
+	local otelcol.Consumer
+
+	cluster  cluster.Cluster
+	httpData *http_service.Data
+
+	mut  sync.RWMutex
+	args Arguments
+
+	buffer    *traceBuffer
+	rebalance chan struct{}
+
+	forwardedSpans  client_prometheus.Counter
+	forwardFailures client_prometheus.Counter
+	movedTraces     client_prometheus.Counter
+}
+
+var (
+	_ component.Component       = (*Component)(nil)
+	_ component.HealthComponent = (*Component)(nil)
+	_ component.LiveDebugging   = (*Component)(nil)
+	_ http_service.Component    = (*Component)(nil)
+	_ otelcol.Consumer          = (*Component)(nil)
+)
+
+// New creates a new otelcol.processor.tail_sampling component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	c := &Component{
+		opts:      opts,
+		buffer:    newTraceBuffer(),
+		rebalance: make(chan struct{}, 1),
+	}
+
+	// The cluster and HTTP services are only required when clustering is
+	// enabled, which is checked by validateClustering.
+	if data, err := opts.GetServiceData(cluster.ServiceName); err == nil {
+		c.cluster = data.(cluster.Cluster)
+	}
+	if data, err := opts.GetServiceData(http_service.ServiceName); err == nil {
+		httpData := data.(http_service.Data)
+		c.httpData = &httpData
+	}
+
+	c.forwardedSpans = client_prometheus.NewCounter(client_prometheus.CounterOpts{
+		Name: "otelcol_processor_tail_sampling_forwarded_spans_total",
+		Help: "Number of spans forwarded to the cluster peer which owns their trace"})
+	c.forwardFailures = client_prometheus.NewCounter(client_prometheus.CounterOpts{
+		Name: "otelcol_processor_tail_sampling_forward_failures_total",
+		Help: "Number of spans which couldn't be forwarded to another cluster peer and were sampled locally instead"})
+	c.movedTraces = client_prometheus.NewCounter(client_prometheus.CounterOpts{
+		Name: "otelcol_processor_tail_sampling_traces_moved_total",
+		Help: "Number of in-flight traces that have moved from this cluster node to another one"})
+	for _, m := range []client_prometheus.Collector{c.forwardedSpans, c.forwardFailures, c.movedTraces} {
+		if err := opts.Registerer.Register(m); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.validateClustering(args); err != nil {
+		return nil, err
+	}
+	c.args = args
+
+	// Capture the input of the wrapped processor and export the component
+	// itself instead, so that spans can be routed before being sampled.
+	innerOpts := opts
+	innerOpts.OnStateChange = func(e component.Exports) {
+		c.local = e.(otelcol.ConsumerExports).Input
+	}
+	p, err := processor.New(innerOpts, tsp.NewFactory(), c.processorArguments(args))
+	if err != nil {
+		return nil, err
+	}
+	c.processor = p
+
+	opts.OnStateChange(otelcol.ConsumerExports{Input: c})
+	return c, nil
+}
+
+// Run starts the component.
+func (c *Component) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				c.buffer.Prune(now)
+			case <-c.rebalance:
+				c.moveTraces(ctx)
+			}
+		}
+	}()
+
+	return c.processor.Run(ctx)
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+	if err := c.validateClustering(newArgs); err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	c.args = newArgs
+	c.mut.Unlock()
+
+	if err := c.processor.Update(c.processorArguments(newArgs)); err != nil {
+		return err
+	}
+
+	// Traces which were received while clustering was disabled may no longer
+	// belong to this node.
+	c.NotifyClusterChange()
+	return nil
+}
+
+func (c *Component) validateClustering(args Arguments) error {
+	if !args.Clustering.Enabled {
+		return nil
+	}
+	if c.cluster == nil || c.httpData == nil {
+		return fmt.Errorf("clustering is enabled but the cluster service is not available")
+	}
+	if _, ok := c.cluster.(cluster.PeerClient); !ok {
+		return fmt.Errorf("clustering is enabled but the cluster does not support sending data to peers")
+	}
+	return nil
+}
+
+// processorArguments returns the arguments of the wrapped processor. When
+// clustering is enabled, spans of traces which have been handed off to another
+// peer are dropped from its output.
+func (c *Component) processorArguments(args Arguments) processor.Arguments {
+	if !args.Clustering.Enabled || args.Output == nil {
+		return args
+	}
+
+	output := &otelcol.ConsumerArguments{
+		Metrics: args.Output.Metrics,
+		Logs:    args.Output.Logs,
+	}
+	for _, next := range args.Output.Traces {
+		output.Traces = append(output.Traces, &handedOffFilter{next: next, buffer: c.buffer})
+	}
+	return wrappedArguments{Arguments: args, output: output}
+}
+
+// wrappedArguments overrides the consumers the wrapped processor sends its
+// output to.
+type wrappedArguments struct {
+	Arguments
+	output *otelcol.ConsumerArguments
+}
+
+// NextConsumers implements processor.Arguments.
+func (args wrappedArguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.output
+}
+
+// NotifyClusterChange implements component.ClusterComponent.
+func (c *Component) NotifyClusterChange() {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	if !c.args.Clustering.Enabled {
+		return // no-op
+	}
+
+	// Schedule a rebalance so in-flight traces move to their new owner.
+	select {
+	case c.rebalance <- struct{}{}:
+	default:
+	}
+}
+
+// CurrentHealth implements component.HealthComponent.
+func (c *Component) CurrentHealth() component.Health {
+	return c.processor.CurrentHealth()
+}
+
+// LiveDebugging implements component.LiveDebugging.
+func (c *Component) LiveDebugging() {}
+
+// ComponentID returns the ID of the component. It is used for the graph and
+// the live debugging.
+func (c *Component) ComponentID() string {
+	return c.opts.ID
+}
+
+// Capabilities implements otelconsumer.baseConsumer.
+func (c *Component) Capabilities() otelconsumer.Capabilities {
+	// Spans are copied before being routed, so the input is never mutated.
+	return otelconsumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces implements otelconsumer.Traces.
+func (c *Component) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	c.mut.RLock()
+	args := c.args
+	c.mut.RUnlock()
+
+	// A node which is alone in the cluster has no peer to route spans to or
+	// to hand traces off to, so they don't need to be split or buffered.
+	if !args.Clustering.Enabled || c.isAlone() {
+		return c.local.ConsumeTraces(ctx, td)
+	}
+	if c.ownsAll(td) {
+		return c.consumeOwned(ctx, td, args)
+	}
+
+	local := make(map[pcommon.TraceID]ptrace.Traces)
+	remote := make(map[string]*peerTraces)
+
+	for id, trace := range splitByTraceID(td) {
+		owner, ok := c.owner(id)
+		if !ok || owner.Self {
+			local[id] = trace
+			continue
+		}
+		pt, ok := remote[owner.Name]
+		if !ok {
+			pt = &peerTraces{peer: owner, traces: ptrace.NewTraces()}
+			remote[owner.Name] = pt
+		}
+		pt.add(id, trace)
+	}
+
+	for _, pt := range remote {
+		if err := c.forward(ctx, pt.peer, pt.traces); err != nil {
+			level.Warn(c.opts.Logger).Log("msg", "failed to forward spans to cluster peer, sampling them locally", "peer", pt.peer.Name, "err", err)
+			c.forwardFailures.Add(float64(pt.traces.SpanCount()))
+			for _, id := range pt.ids {
+				local[id] = pt.byID[id]
+			}
+			continue
+		}
+		c.forwardedSpans.Add(float64(pt.traces.SpanCount()))
+	}
+
+	return c.consumeLocal(ctx, local, args)
+}
+
+// ConsumeMetrics implements otelconsumer.Metrics.
+func (c *Component) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return c.local.ConsumeMetrics(ctx, md)
+}
+
+// ConsumeLogs implements otelconsumer.Logs.
+func (c *Component) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return c.local.ConsumeLogs(ctx, ld)
+}
+
+// isAlone returns true if no other peer participates in the cluster.
+func (c *Component) isAlone() bool {
+	for _, p := range c.cluster.Peers() {
+		if p.State == peer.StateParticipant && !p.Self {
+			return false
+		}
+	}
+	return true
+}
+
+// ownsAll returns true if all the traces of td are owned by this node.
+func (c *Component) ownsAll(td ptrace.Traces) bool {
+	seen := make(map[pcommon.TraceID]struct{})
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				id := spans.At(k).TraceID()
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+
+				if owner, ok := c.owner(id); ok && !owner.Self {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// owner returns the cluster peer which owns the trace id. It returns false if
+// the owner can't be determined.
+func (c *Component) owner(id pcommon.TraceID) (peer.Peer, bool) {
+	peers, err := c.cluster.Lookup(shard.StringKey(id.String()), 1, shard.OpReadWrite)
+	if err != nil || len(peers) == 0 {
+		return peer.Peer{}, false
+	}
+	return peers[0], true
+}
+
+// consumeLocal sends traces to the wrapped processor, keeping a copy of them
+// so that they can be moved to another peer if the cluster changes.
+func (c *Component) consumeLocal(ctx context.Context, traces map[pcommon.TraceID]ptrace.Traces, args Arguments) error {
+	if len(traces) == 0 {
+		return nil
+	}
+
+	merged := ptrace.NewTraces()
+	for id, trace := range traces {
+		c.buffer.Add(id, trace, args.DecisionWait, int(args.NumTraces))
+		trace.ResourceSpans().MoveAndAppendTo(merged.ResourceSpans())
+	}
+	return c.local.ConsumeTraces(ctx, merged)
+}
+
+// consumeOwned sends td, whose traces are all owned by this node, to the
+// wrapped processor. Unlike consumeLocal, td isn't split by trace ID first.
+func (c *Component) consumeOwned(ctx context.Context, td ptrace.Traces, args Arguments) error {
+	c.buffer.AddTraces(td, args.DecisionWait, int(args.NumTraces))
+	return c.local.ConsumeTraces(ctx, td)
+}
+
+// forward sends td to the tail sampling component with the same ID on p.
+func (c *Component) forward(ctx context.Context, p peer.Peer, td ptrace.Traces) error {
+	body, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+
+	peerClient := c.cluster.(cluster.PeerClient)
+	url := peerClient.PeerURL(p) + c.httpData.HTTPPathForComponent(c.opts.ID) + forwardPath
+
+	ctx, cancel := context.WithTimeout(ctx, forwardTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := peerClient.PeerHTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// moveTraces forwards the buffered traces which are no longer owned by this
+// node to their new owner.
+func (c *Component) moveTraces(ctx context.Context) {
+	c.mut.RLock()
+	args := c.args
+	c.mut.RUnlock()
+
+	if !args.Clustering.Enabled {
+		return
+	}
+
+	// Spans of handed off traces which are still held by the wrapped
+	// processor are dropped until it has made its decision.
+	moved := c.buffer.HandOff(func(id pcommon.TraceID) bool {
+		owner, ok := c.owner(id)
+		return !ok || owner.Self
+	}, args.DecisionWait+handOffGracePeriod)
+	if len(moved) == 0 {
+		return
+	}
+
+	remote := make(map[string]*peerTraces)
+	for id, trace := range moved {
+		owner, ok := c.owner(id)
+		if !ok {
+			continue
+		}
+		pt, ok := remote[owner.Name]
+		if !ok {
+			pt = &peerTraces{peer: owner, traces: ptrace.NewTraces()}
+			remote[owner.Name] = pt
+		}
+		pt.add(id, trace)
+	}
+
+	for _, pt := range remote {
+		if err := c.forward(ctx, pt.peer, pt.traces); err != nil {
+			level.Warn(c.opts.Logger).Log("msg", "failed to move traces to their new cluster peer, sampling them locally", "peer", pt.peer.Name, "err", err)
+			c.forwardFailures.Add(float64(pt.traces.SpanCount()))
+
+			// Take the traces back so that the decisions of the wrapped
+			// processor aren't dropped.
+			for _, id := range pt.ids {
+				c.buffer.Add(id, pt.byID[id], args.DecisionWait, int(args.NumTraces))
+			}
+			continue
+		}
+		c.forwardedSpans.Add(float64(pt.traces.SpanCount()))
+		c.movedTraces.Add(float64(len(pt.ids)))
+	}
+	level.Debug(c.opts.Logger).Log("msg", "moved in-flight traces after cluster change", "count", len(moved))
+}
+
+// Handler implements http_service.Component. It receives spans forwarded by
+// other cluster peers.
+func (c *Component) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /"+forwardPath, c.handleForward)
+	return mux
+}
+
+func (c *Component) handleForward(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxForwardSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	td, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mut.RLock()
+	args := c.args
+	c.mut.RUnlock()
+
+	// Forwarded spans are never forwarded again, even if the peers disagree
+	// on the owner while the cluster changes, to avoid loops.
+	if err := c.consumeOwned(r.Context(), td, args); err != nil {
+		level.Error(c.opts.Logger).Log("msg", "failed to process forwarded spans", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// peerTraces collects the traces which are sent to a single peer.
+type peerTraces struct {
+	peer   peer.Peer
+	traces ptrace.Traces
+	ids    []pcommon.TraceID
+	byID   map[pcommon.TraceID]ptrace.Traces
+}
+
+func (pt *peerTraces) add(id pcommon.TraceID, trace ptrace.Traces) {
+	if pt.byID == nil {
+		pt.byID = make(map[pcommon.TraceID]ptrace.Traces)
+	}
+	pt.ids = append(pt.ids, id)
+	pt.byID[id] = trace
+	for i := 0; i < trace.ResourceSpans().Len(); i++ {
+		trace.ResourceSpans().At(i).CopyTo(pt.traces.ResourceSpans().AppendEmpty())
+	}
+}
@@ -0,0 +1,344 @@
+//go:build !race
+
+package tail_sampling
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/service/cluster"
+	http_service "github.com/grafana/alloy/internal/service/http"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/ckit/peer"
+	"github.com/grafana/ckit/shard"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+var (
+	traceA = pcommon.TraceID{0x0a}
+	traceB = pcommon.TraceID{0x0b}
+)
+
+func TestSplitByTraceID(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "test")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	for _, id := range []pcommon.TraceID{traceA, traceB, traceA} {
+		ss.Spans().AppendEmpty().SetTraceID(id)
+	}
+
+	split := splitByTraceID(td)
+	require.Len(t, split, 2)
+	require.Equal(t, 2, split[traceA].SpanCount())
+	require.Equal(t, 1, split[traceB].SpanCount())
+
+	// Resource and scope are kept, and the input isn't modified.
+	splitRS := split[traceB].ResourceSpans().At(0)
+	name, _ := splitRS.Resource().Attributes().Get("service.name")
+	require.Equal(t, "test", name.Str())
+	require.Equal(t, "scope", splitRS.ScopeSpans().At(0).Scope().Name())
+	require.Equal(t, 3, td.SpanCount())
+}
+
+func TestTraceBuffer(t *testing.T) {
+	b := newTraceBuffer()
+	b.Add(traceA, createTraceWithID(traceA), time.Minute, 1)
+	b.Add(traceA, createTraceWithID(traceA), time.Minute, 1)
+	b.Add(traceB, createTraceWithID(traceB), time.Minute, 1) // Dropped, the buffer is full.
+	require.Equal(t, 1, b.Len())
+
+	moved := b.HandOff(func(pcommon.TraceID) bool { return false }, time.Minute)
+	require.Len(t, moved, 1)
+	require.Equal(t, 2, moved[traceA].SpanCount())
+	require.True(t, b.IsHandedOff(traceA))
+	require.Equal(t, 0, b.Len())
+
+	b.Prune(time.Now().Add(2 * time.Minute))
+	require.False(t, b.IsHandedOff(traceA))
+
+	// Traces are taken back when their spans are added again.
+	td := createTraceWithID(traceA)
+	createTraceWithID(traceB).ResourceSpans().MoveAndAppendTo(td.ResourceSpans())
+	b.HandOff(func(pcommon.TraceID) bool { return false }, time.Minute)
+	b.AddTraces(td, time.Minute, 1)
+	require.Equal(t, 1, b.Len())
+	require.False(t, b.IsHandedOff(traceA))
+	require.Equal(t, 2, td.SpanCount())
+}
+
+func TestClusteringDisabledWithoutService(t *testing.T) {
+	args := testClusterArguments(t, nil)
+	args.Clustering.Enabled = true
+
+	_, err := New(testClusterOptions(t, "otelcol.processor.tail_sampling.test", prometheus.NewRegistry()), args)
+	require.ErrorContains(t, err, "clustering is enabled but the cluster service is not available")
+}
+
+func TestClusteringForwardsSpansToOwner(t *testing.T) {
+	nodes := newTestNodes(t)
+	nodes.setOwner(traceA, "a")
+	nodes.setOwner(traceB, "b")
+
+	td := ptrace.NewTraces()
+	createTraceWithID(traceA).ResourceSpans().MoveAndAppendTo(td.ResourceSpans())
+	createTraceWithID(traceB).ResourceSpans().MoveAndAppendTo(td.ResourceSpans())
+	require.NoError(t, nodes.a.ConsumeTraces(t.Context(), td))
+
+	requireTrace(t, nodes.outputA, traceA)
+	requireTrace(t, nodes.outputB, traceB)
+	require.Equal(t, 1.0, testutil.ToFloat64(nodes.a.forwardedSpans))
+	require.Equal(t, 0.0, testutil.ToFloat64(nodes.b.forwardedSpans))
+}
+
+func TestClusteringSkipsBufferWhenAlone(t *testing.T) {
+	nodes := newTestNodes(t)
+	nodes.setOwner(traceA, "a")
+	nodes.setMembers("a")
+
+	require.NoError(t, nodes.a.ConsumeTraces(t.Context(), createTraceWithID(traceA)))
+	requireTrace(t, nodes.outputA, traceA)
+	require.Equal(t, 0, nodes.a.buffer.Len())
+}
+
+func TestClusteringRejectsLargeForwards(t *testing.T) {
+	nodes := newTestNodes(t)
+
+	body := strings.NewReader(strings.Repeat("x", maxForwardSize+1))
+	rec := httptest.NewRecorder()
+	nodes.a.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/"+forwardPath, body))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestClusteringMovesTracesOnClusterChange(t *testing.T) {
+	nodes := newTestNodes(t)
+	nodes.setOwner(traceA, "a")
+
+	require.NoError(t, nodes.a.ConsumeTraces(t.Context(), createTraceWithID(traceA)))
+	require.Eventually(t, func() bool { return nodes.a.buffer.Len() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// Node b joins and takes ownership of the trace.
+	nodes.setOwner(traceA, "b")
+	nodes.a.NotifyClusterChange()
+
+	requireTrace(t, nodes.outputB, traceA)
+	require.Equal(t, 1.0, testutil.ToFloat64(nodes.a.movedTraces))
+
+	// The partial trace still held by node a is dropped once sampled.
+	select {
+	case td := <-nodes.outputA:
+		require.FailNow(t, "unexpected trace from previous owner", "spans: %d", td.SpanCount())
+	case <-time.After(3 * time.Second):
+	}
+}
+
+type testNodes struct {
+	a, b             *Component
+	outputA, outputB chan ptrace.Traces
+
+	mut     sync.Mutex
+	owners  map[shard.Key]string
+	members []string
+}
+
+func newTestNodes(t *testing.T) *testNodes {
+	t.Helper()
+
+	nodes := &testNodes{
+		outputA: make(chan ptrace.Traces, 10),
+		outputB: make(chan ptrace.Traces, 10),
+		owners:  make(map[shard.Key]string),
+		members: []string{"a", "b"},
+	}
+
+	// Both nodes run a component with the same ID, like peers sharing the same
+	// configuration file.
+	const id = "otelcol.processor.tail_sampling.test"
+	httpData := http_service.Data{BaseHTTPPath: "/api/v0/component"}
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	handlers := map[string]http.Handler{}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// Route requests like the HTTP service, with the path trimmed to the
+		// component's root.
+		node, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		handler, ok := handlers[node]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		r.URL.Path = "/" + strings.TrimPrefix(path, strings.TrimPrefix(httpData.HTTPPathForComponent(id), "/"))
+		handler.ServeHTTP(w, r)
+	})
+
+	newNode := func(name string, output chan ptrace.Traces) *Component {
+		fc := &fakeCluster{self: name, nodes: nodes, url: srv.URL}
+		opts := testClusterOptions(t, id, prometheus.NewRegistry())
+		opts.GetServiceData = withServices(opts.GetServiceData, fc, httpData)
+
+		args := testClusterArguments(t, output)
+		args.Clustering.Enabled = true
+
+		c, err := New(opts, args)
+		require.NoError(t, err)
+		handlers[name] = c.Handler()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			require.NoError(t, c.Run(ctx))
+		}()
+		t.Cleanup(func() {
+			cancel()
+			<-done
+		})
+		return c
+	}
+	nodes.a = newNode("a", nodes.outputA)
+	nodes.b = newNode("b", nodes.outputB)
+	return nodes
+}
+
+func (n *testNodes) setOwner(id pcommon.TraceID, node string) {
+	n.mut.Lock()
+	defer n.mut.Unlock()
+	n.owners[shard.StringKey(id.String())] = node
+}
+
+func (n *testNodes) owner(key shard.Key) string {
+	n.mut.Lock()
+	defer n.mut.Unlock()
+	return n.owners[key]
+}
+
+// setMembers sets the nodes which participate in the cluster.
+func (n *testNodes) setMembers(names ...string) {
+	n.mut.Lock()
+	defer n.mut.Unlock()
+	n.members = names
+}
+
+func (n *testNodes) peers(self string) []peer.Peer {
+	n.mut.Lock()
+	defer n.mut.Unlock()
+
+	var peers []peer.Peer
+	for _, name := range n.members {
+		peers = append(peers, peer.Peer{Name: name, Addr: name, Self: name == self, State: peer.StateParticipant})
+	}
+	return peers
+}
+
+type fakeCluster struct {
+	self  string
+	nodes *testNodes
+	url   string
+}
+
+var (
+	_ cluster.Cluster    = (*fakeCluster)(nil)
+	_ cluster.PeerClient = (*fakeCluster)(nil)
+)
+
+func (f *fakeCluster) Lookup(key shard.Key, _ int, _ shard.Op) ([]peer.Peer, error) {
+	owner := f.nodes.owner(key)
+	if owner == "" {
+		return nil, fmt.Errorf("no owner")
+	}
+	return []peer.Peer{{Name: owner, Addr: owner, Self: owner == f.self, State: peer.StateParticipant}}, nil
+}
+
+func (f *fakeCluster) Peers() []peer.Peer {
+	return f.nodes.peers(f.self)
+}
+
+func (f *fakeCluster) Ready() bool { return true }
+
+func (f *fakeCluster) PeerHTTPClient() *http.Client { return http.DefaultClient }
+
+func (f *fakeCluster) PeerURL(p peer.Peer) string { return f.url + "/" + p.Addr }
+
+func testClusterOptions(t *testing.T, id string, reg prometheus.Registerer) component.Options {
+	return component.Options{
+		ID:            id,
+		Logger:        util.TestAlloyLogger(t),
+		Tracer:        noop.NewTracerProvider(),
+		Registerer:    reg,
+		OnStateChange: func(component.Exports) {},
+		GetServiceData: func(name string) (interface{}, error) {
+			if name == livedebugging.ServiceName {
+				return livedebugging.NewLiveDebugging(), nil
+			}
+			return nil, fmt.Errorf("no service named %s defined", name)
+		},
+	}
+}
+
+func withServices(next func(string) (interface{}, error), c cluster.Cluster, httpData http_service.Data) func(string) (interface{}, error) {
+	return func(name string) (interface{}, error) {
+		switch name {
+		case cluster.ServiceName:
+			return c, nil
+		case http_service.ServiceName:
+			return httpData, nil
+		default:
+			return next(name)
+		}
+	}
+}
+
+func testClusterArguments(t *testing.T, output chan ptrace.Traces) Arguments {
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`
+		decision_wait = "1s"
+		policy {
+			name = "always"
+			type = "always_sample"
+		}
+		output {}
+	`), &args))
+	if output != nil {
+		args.Output = makeTracesOutput(output)
+	}
+	return args
+}
+
+func createTraceWithID(id pcommon.TraceID) ptrace.Traces {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(id)
+	span.SetSpanID(pcommon.SpanID{0x01})
+	span.SetName("span")
+	return td
+}
+
+func requireTrace(t *testing.T, ch chan ptrace.Traces, id pcommon.TraceID) {
+	t.Helper()
+
+	select {
+	case td := <-ch:
+		require.Equal(t, 1, td.SpanCount())
+		require.Equal(t, id, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "failed waiting for traces")
+	}
+}
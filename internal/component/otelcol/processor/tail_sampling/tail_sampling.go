@@ -10,6 +10,7 @@ import (
 	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
 	"github.com/grafana/alloy/internal/component/otelcol/processor"
 	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/service/cluster"
 	tsp "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 	otelcomponent "go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pipeline"
@@ -23,8 +24,7 @@ func init() {
 		Exports:   otelcol.ConsumerExports{},
 
 		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
-			fact := tsp.NewFactory()
-			return processor.New(opts, fact, args.(Arguments))
+			return New(opts, args.(Arguments))
 		},
 	})
 }
@@ -37,6 +37,8 @@ type Arguments struct {
 	BlockOnOverflow         bool                `alloy:"block_on_overflow,attr,optional"`
 	ExpectedNewTracesPerSec uint64              `alloy:"expected_new_traces_per_sec,attr,optional"`
 	DecisionCache           DecisionCacheConfig `alloy:"decision_cache,attr,optional"`
+	// Clustering configures sharing the traces across the cluster. Optional.
+	Clustering cluster.ComponentBlock `alloy:"clustering,block,optional"`
 	// Output configures where to send processed data. Required.
 	Output *otelcol.ConsumerArguments `alloy:"output,block"`
 	// DebugMetrics configures component internal metrics. Optional.
@@ -0,0 +1,243 @@
+package tail_sampling
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelconsumer "go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// splitByTraceID copies the spans of td into one ptrace.Traces per trace ID.
+// Resource and scope information is copied along with the spans.
+func splitByTraceID(td ptrace.Traces) map[pcommon.TraceID]ptrace.Traces {
+	result := make(map[pcommon.TraceID]ptrace.Traces)
+	copyByTraceID(td, func(id pcommon.TraceID) (ptrace.Traces, bool) {
+		trace, ok := result[id]
+		if !ok {
+			trace = ptrace.NewTraces()
+			result[id] = trace
+		}
+		return trace, true
+	})
+	return result
+}
+
+// copyByTraceID copies the spans of td to the ptrace.Traces returned by dest
+// for their trace ID. Spans are skipped when dest returns false.
+func copyByTraceID(td ptrace.Traces, dest func(pcommon.TraceID) (ptrace.Traces, bool)) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+
+			// Spans of the same trace within a scope are kept together.
+			scopes := make(map[pcommon.TraceID]ptrace.ScopeSpans)
+			skipped := make(map[pcommon.TraceID]struct{})
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				id := span.TraceID()
+				if _, skip := skipped[id]; skip {
+					continue
+				}
+
+				scope, ok := scopes[id]
+				if !ok {
+					trace, ok := dest(id)
+					if !ok {
+						skipped[id] = struct{}{}
+						continue
+					}
+					newRS := trace.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(newRS.Resource())
+					newRS.SetSchemaUrl(rs.SchemaUrl())
+
+					scope = newRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(scope.Scope())
+					scope.SetSchemaUrl(ss.SchemaUrl())
+					scopes[id] = scope
+				}
+				span.CopyTo(scope.Spans().AppendEmpty())
+			}
+		}
+	}
+}
+
+// traceBuffer keeps a copy of the spans of the traces which are being
+// sampled by the local node, so that they can be handed off to another peer
+// when the cluster changes before a sampling decision has been made.
+//
+// It also remembers which traces have been handed off, so that the partial
+// decisions the local node still makes for them can be discarded.
+type traceBuffer struct {
+	mut       sync.Mutex
+	traces    map[pcommon.TraceID]*bufferedTrace
+	handedOff map[pcommon.TraceID]time.Time
+}
+
+type bufferedTrace struct {
+	td      ptrace.Traces
+	expires time.Time
+}
+
+func newTraceBuffer() *traceBuffer {
+	return &traceBuffer{
+		traces:    make(map[pcommon.TraceID]*bufferedTrace),
+		handedOff: make(map[pcommon.TraceID]time.Time),
+	}
+}
+
+// Add stores a copy of td for the trace id. A trace which has been handed off
+// before is taken back by the local node. New traces aren't stored once the
+// buffer holds maxTraces traces.
+func (b *traceBuffer) Add(id pcommon.TraceID, td ptrace.Traces, ttl time.Duration, maxTraces int) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	delete(b.handedOff, id)
+
+	entry, ok := b.traces[id]
+	if !ok {
+		if len(b.traces) >= maxTraces {
+			return
+		}
+		entry = &bufferedTrace{
+			td:      ptrace.NewTraces(),
+			expires: time.Now().Add(ttl),
+		}
+		b.traces[id] = entry
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		td.ResourceSpans().At(i).CopyTo(entry.td.ResourceSpans().AppendEmpty())
+	}
+}
+
+// AddTraces stores a copy of the spans of td by trace ID, like Add, without
+// splitting td first.
+func (b *traceBuffer) AddTraces(td ptrace.Traces, ttl time.Duration, maxTraces int) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	copyByTraceID(td, func(id pcommon.TraceID) (ptrace.Traces, bool) {
+		delete(b.handedOff, id)
+
+		entry, ok := b.traces[id]
+		if !ok {
+			if len(b.traces) >= maxTraces {
+				return ptrace.Traces{}, false
+			}
+			entry = &bufferedTrace{
+				td:      ptrace.NewTraces(),
+				expires: time.Now().Add(ttl),
+			}
+			b.traces[id] = entry
+		}
+		return entry.td, true
+	})
+}
+
+// HandOff removes and returns the buffered traces for which owned returns
+// false. The removed traces are marked as handed off for ttl.
+func (b *traceBuffer) HandOff(owned func(pcommon.TraceID) bool, ttl time.Duration) map[pcommon.TraceID]ptrace.Traces {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	result := make(map[pcommon.TraceID]ptrace.Traces)
+	for id, entry := range b.traces {
+		if owned(id) {
+			continue
+		}
+		result[id] = entry.td
+		delete(b.traces, id)
+		b.handedOff[id] = time.Now().Add(ttl)
+	}
+	return result
+}
+
+// IsHandedOff returns true if the trace id has been handed off to another
+// peer.
+func (b *traceBuffer) IsHandedOff(id pcommon.TraceID) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	_, ok := b.handedOff[id]
+	return ok
+}
+
+// Prune removes expired entries from the buffer.
+func (b *traceBuffer) Prune(now time.Time) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	for id, entry := range b.traces {
+		if now.After(entry.expires) {
+			delete(b.traces, id)
+		}
+	}
+	for id, expires := range b.handedOff {
+		if now.After(expires) {
+			delete(b.handedOff, id)
+		}
+	}
+}
+
+// Len returns the number of buffered traces.
+func (b *traceBuffer) Len() int {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return len(b.traces)
+}
+
+// handedOffFilter is a consumer which drops the spans of traces that have
+// been handed off to another peer before forwarding the rest to next.
+type handedOffFilter struct {
+	next   otelcol.Consumer
+	buffer *traceBuffer
+}
+
+var _ otelcol.Consumer = (*handedOffFilter)(nil)
+
+// ComponentID returns the ID of the component next belongs to, if known.
+func (f *handedOffFilter) ComponentID() string {
+	if withID, ok := f.next.(otelcol.ComponentMetadata); ok {
+		return withID.ComponentID()
+	}
+	return ""
+}
+
+// Capabilities implements otelconsumer.baseConsumer.
+func (f *handedOffFilter) Capabilities() otelconsumer.Capabilities {
+	return otelconsumer.Capabilities{MutatesData: true}
+}
+
+// ConsumeTraces implements otelconsumer.Traces.
+func (f *handedOffFilter) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				return f.buffer.IsHandedOff(span.TraceID())
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+	if td.ResourceSpans().Len() == 0 {
+		return nil
+	}
+	return f.next.ConsumeTraces(ctx, td)
+}
+
+// ConsumeMetrics implements otelconsumer.Metrics.
+func (f *handedOffFilter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return f.next.ConsumeMetrics(ctx, md)
+}
+
+// ConsumeLogs implements otelconsumer.Logs.
+func (f *handedOffFilter) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return f.next.ConsumeLogs(ctx, ld)
+}
@@ -0,0 +1,182 @@
+// Package hostmetrics provides an otelcol.receiver.hostmetrics component.
+package hostmetrics
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.receiver.hostmetrics",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := hostmetricsreceiver.NewFactory()
+			return receiver.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.receiver.hostmetrics component.
+type Arguments struct {
+	// RootPath is the path to the root filesystem of the host. Linux only.
+	RootPath string `alloy:"root_path,attr,optional"`
+
+	ScraperControllerArguments otelcol.ScraperControllerArguments `alloy:",squash"`
+
+	CPU        *CPUScraperArguments        `alloy:"cpu,block,optional"`
+	Disk       *DiskScraperArguments       `alloy:"disk,block,optional"`
+	Filesystem *FilesystemScraperArguments `alloy:"filesystem,block,optional"`
+	Load       *LoadScraperArguments       `alloy:"load,block,optional"`
+	Memory     *MemoryScraperArguments     `alloy:"memory,block,optional"`
+	Network    *NetworkScraperArguments    `alloy:"network,block,optional"`
+	Paging     *PagingScraperArguments     `alloy:"paging,block,optional"`
+	Processes  *ProcessesScraperArguments  `alloy:"processes,block,optional"`
+	Process    *ProcessScraperArguments    `alloy:"process,block,optional"`
+	System     *SystemScraperArguments     `alloy:"system,block,optional"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+
+	// Output configures where to send received data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+}
+
+var (
+	_ receiver.Arguments = Arguments{}
+	_ syntax.Defaulter   = (*Arguments)(nil)
+	_ syntax.Validator   = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		ScraperControllerArguments: otelcol.DefaultScraperControllerArguments,
+	}
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+
+	if len(args.scrapers()) == 0 {
+		errs = errors.Join(errs, errors.New("at least one scraper block must be specified"))
+	}
+	if err := args.ScraperControllerArguments.Validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if err := validateRootPath(args.RootPath); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	return errs
+}
+
+func validateRootPath(rootPath string) error {
+	if rootPath == "" || rootPath == "/" {
+		return nil
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("root_path is only supported on linux")
+	}
+	if !filepath.IsAbs(rootPath) {
+		return fmt.Errorf("root_path must be an absolute path")
+	}
+	if _, err := os.Stat(rootPath); err != nil {
+		return fmt.Errorf("invalid root_path: %w", err)
+	}
+	return nil
+}
+
+// scrapers returns the configuration of the enabled scrapers, keyed by the
+// name of the upstream scraper.
+func (args *Arguments) scrapers() map[string]any {
+	res := make(map[string]any)
+	if args.CPU != nil {
+		res["cpu"] = args.CPU.toMap()
+	}
+	if args.Disk != nil {
+		res["disk"] = args.Disk.toMap()
+	}
+	if args.Filesystem != nil {
+		res["filesystem"] = args.Filesystem.toMap()
+	}
+	if args.Load != nil {
+		res["load"] = args.Load.toMap()
+	}
+	if args.Memory != nil {
+		res["memory"] = args.Memory.toMap()
+	}
+	if args.Network != nil {
+		res["network"] = args.Network.toMap()
+	}
+	if args.Paging != nil {
+		res["paging"] = args.Paging.toMap()
+	}
+	if args.Processes != nil {
+		res["processes"] = args.Processes.toMap()
+	}
+	if args.Process != nil {
+		res["process"] = args.Process.toMap()
+	}
+	if args.System != nil {
+		res["system"] = args.System.toMap()
+	}
+	return res
+}
+
+// Convert implements receiver.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	cfg := hostmetricsreceiver.NewFactory().CreateDefaultConfig().(*hostmetricsreceiver.Config)
+
+	// The scraper configurations are internal to the upstream receiver, so
+	// they can only be created by unmarshaling them.
+	err := cfg.Unmarshal(confmap.NewFromStringMap(map[string]any{
+		"root_path": args.RootPath,
+		"scrapers":  args.scrapers(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.ControllerConfig = *args.ScraperControllerArguments.Convert()
+
+	return cfg, nil
+}
+
+// Extensions implements receiver.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements receiver.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements receiver.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements receiver.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
@@ -0,0 +1,93 @@
+//go:build linux
+
+package hostmetrics_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/internal/fakeconsumer"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/hostmetrics"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func Test_Scrape(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	// Expose the host's /proc under a different root, like a container
+	// which mounts the host filesystem at /hostfs.
+	rootPath := t.TempDir()
+	require.NoError(t, os.Symlink("/proc", filepath.Join(rootPath, "proc")))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.receiver.hostmetrics")
+	require.NoError(t, err)
+
+	var args hostmetrics.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`
+		root_path           = "`+rootPath+`"
+		collection_interval = "100ms"
+		initial_delay       = "0s"
+
+		cpu {}
+		memory {}
+		load {}
+
+		output {}
+	`), &args))
+
+	metricsCh := make(chan pmetric.Metrics, 10)
+	args.Output = &otelcol.ConsumerArguments{
+		Metrics: []otelcol.Consumer{&fakeconsumer.Consumer{
+			ConsumeMetricsFunc: func(ctx context.Context, md pmetric.Metrics) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case metricsCh <- md:
+					return nil
+				}
+			},
+		}},
+	}
+
+	go func() {
+		err := ctrl.Run(ctx, args)
+		require.NoError(t, err)
+	}()
+	require.NoError(t, ctrl.WaitRunning(time.Second), "component never started")
+
+	seen := make(map[string]bool)
+	require.Eventually(t, func() bool {
+		select {
+		case md := <-metricsCh:
+			for _, name := range metricNames(md) {
+				seen[name] = true
+			}
+		default:
+		}
+		return seen["system.cpu.time"] && seen["system.memory.usage"] && seen["system.cpu.load_average.1m"]
+	}, 5*time.Second, 10*time.Millisecond, "missing metrics, got %v", seen)
+}
+
+func metricNames(md pmetric.Metrics) []string {
+	var names []string
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				names = append(names, ms.At(k).Name())
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,169 @@
+package hostmetrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/hostmetrics"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	in := `
+		collection_interval = "30s"
+
+		cpu {
+			metrics {
+				system.cpu.utilization {
+					enabled = true
+				}
+			}
+		}
+
+		disk {
+			exclude {
+				devices    = ["^loop[0-9]+$"]
+				match_type = "regexp"
+			}
+		}
+
+		filesystem {
+			include_virtual_filesystems = true
+
+			exclude_fs_types {
+				fs_types = ["tmpfs", "overlay"]
+			}
+		}
+
+		load {
+			cpu_average = true
+		}
+
+		process {
+			include {
+				names = ["alloy"]
+			}
+			mute_process_name_error = true
+			scrape_process_delay    = "10s"
+
+			resource_attributes {
+				process.cgroup {
+					enabled = true
+				}
+			}
+		}
+
+		output {}
+	`
+
+	var args hostmetrics.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(in), &args))
+
+	outAny, err := args.Convert()
+	require.NoError(t, err)
+	out := outAny.(*hostmetricsreceiver.Config)
+
+	require.Equal(t, 30*time.Second, out.CollectionInterval)
+	require.Len(t, out.Scrapers, 5)
+
+	// The upstream scraper configurations are internal, so we compare their
+	// encoded form.
+	cpu := scraperConfig(t, out, "cpu")
+	require.Equal(t, true, cpu["metrics::system.cpu.utilization::enabled"])
+	require.Equal(t, true, cpu["metrics::system.cpu.time::enabled"])
+	require.Equal(t, false, cpu["metrics::system.cpu.frequency::enabled"])
+
+	disk := scraperConfig(t, out, "disk")
+	require.EqualValues(t, "regexp", disk["exclude::match_type"])
+	require.Equal(t, []any{"^loop[0-9]+$"}, disk["exclude::devices"])
+	require.Nil(t, disk["include::devices"])
+
+	filesystem := scraperConfig(t, out, "filesystem")
+	require.Equal(t, true, filesystem["include_virtual_filesystems"])
+	require.EqualValues(t, "strict", filesystem["exclude_fs_types::match_type"])
+	require.Equal(t, []any{"tmpfs", "overlay"}, filesystem["exclude_fs_types::fs_types"])
+
+	load := scraperConfig(t, out, "load")
+	require.Equal(t, true, load["cpu_average"])
+
+	process := scraperConfig(t, out, "process")
+	require.Equal(t, []any{"alloy"}, process["include::names"])
+	require.Equal(t, true, process["mute_process_name_error"])
+	require.Equal(t, 10*time.Second, process["scrape_process_delay"])
+	require.Equal(t, true, process["resource_attributes::process.cgroup::enabled"])
+	require.Equal(t, true, process["resource_attributes::process.pid::enabled"])
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      string
+		errorMsg string
+	}{
+		{
+			name:     "no scrapers",
+			cfg:      `output {}`,
+			errorMsg: "at least one scraper block must be specified",
+		},
+		{
+			name: "invalid match type",
+			cfg: `
+				network {
+					include {
+						interfaces = ["eth0"]
+						match_type = "glob"
+					}
+				}
+				output {}
+			`,
+			errorMsg: `match_type must be "strict" or "regexp", got "glob"`,
+		},
+		{
+			name: "relative root path",
+			cfg: `
+				root_path = "hostfs"
+				memory {}
+				output {}
+			`,
+			errorMsg: "root_path",
+		},
+		{
+			name: "negative scrape process delay",
+			cfg: `
+				process {
+					scrape_process_delay = "-1s"
+				}
+				output {}
+			`,
+			errorMsg: "scrape_process_delay must not be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args hostmetrics.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func scraperConfig(t *testing.T, cfg *hostmetricsreceiver.Config, name string) map[string]any {
+	t.Helper()
+
+	scraperCfg, ok := cfg.Scrapers[component.MustNewType(name)]
+	require.True(t, ok, "scraper %s not configured", name)
+
+	conf := confmap.New()
+	require.NoError(t, conf.Marshal(scraperCfg))
+
+	res := make(map[string]any)
+	for _, key := range conf.AllKeys() {
+		res[key] = conf.Get(key)
+	}
+	return res
+}
@@ -0,0 +1,363 @@
+package hostmetrics
+
+// The types in this file mirror the metadata.yaml files of the upstream
+// scrapers.
+
+// CPUMetricsArguments configures the metrics emitted by the cpu scraper.
+type CPUMetricsArguments struct {
+	SystemCPUFrequency     MetricArguments `alloy:"system.cpu.frequency,block,optional"`
+	SystemCPULogicalCount  MetricArguments `alloy:"system.cpu.logical.count,block,optional"`
+	SystemCPUPhysicalCount MetricArguments `alloy:"system.cpu.physical.count,block,optional"`
+	SystemCPUTime          MetricArguments `alloy:"system.cpu.time,block,optional"`
+	SystemCPUUtilization   MetricArguments `alloy:"system.cpu.utilization,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *CPUMetricsArguments) SetToDefault() {
+	*args = CPUMetricsArguments{
+		SystemCPUFrequency:     MetricArguments{Enabled: false},
+		SystemCPULogicalCount:  MetricArguments{Enabled: false},
+		SystemCPUPhysicalCount: MetricArguments{Enabled: false},
+		SystemCPUTime:          MetricArguments{Enabled: true},
+		SystemCPUUtilization:   MetricArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *CPUMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.cpu.frequency":      args.SystemCPUFrequency.toMap(),
+		"system.cpu.logical.count":  args.SystemCPULogicalCount.toMap(),
+		"system.cpu.physical.count": args.SystemCPUPhysicalCount.toMap(),
+		"system.cpu.time":           args.SystemCPUTime.toMap(),
+		"system.cpu.utilization":    args.SystemCPUUtilization.toMap(),
+	}
+}
+
+// DiskMetricsArguments configures the metrics emitted by the disk scraper.
+type DiskMetricsArguments struct {
+	SystemDiskIO                MetricArguments `alloy:"system.disk.io,block,optional"`
+	SystemDiskIOTime            MetricArguments `alloy:"system.disk.io_time,block,optional"`
+	SystemDiskMerged            MetricArguments `alloy:"system.disk.merged,block,optional"`
+	SystemDiskOperationTime     MetricArguments `alloy:"system.disk.operation_time,block,optional"`
+	SystemDiskOperations        MetricArguments `alloy:"system.disk.operations,block,optional"`
+	SystemDiskPendingOperations MetricArguments `alloy:"system.disk.pending_operations,block,optional"`
+	SystemDiskWeightedIOTime    MetricArguments `alloy:"system.disk.weighted_io_time,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *DiskMetricsArguments) SetToDefault() {
+	*args = DiskMetricsArguments{
+		SystemDiskIO:                MetricArguments{Enabled: true},
+		SystemDiskIOTime:            MetricArguments{Enabled: true},
+		SystemDiskMerged:            MetricArguments{Enabled: true},
+		SystemDiskOperationTime:     MetricArguments{Enabled: true},
+		SystemDiskOperations:        MetricArguments{Enabled: true},
+		SystemDiskPendingOperations: MetricArguments{Enabled: true},
+		SystemDiskWeightedIOTime:    MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *DiskMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.disk.io":                 args.SystemDiskIO.toMap(),
+		"system.disk.io_time":            args.SystemDiskIOTime.toMap(),
+		"system.disk.merged":             args.SystemDiskMerged.toMap(),
+		"system.disk.operation_time":     args.SystemDiskOperationTime.toMap(),
+		"system.disk.operations":         args.SystemDiskOperations.toMap(),
+		"system.disk.pending_operations": args.SystemDiskPendingOperations.toMap(),
+		"system.disk.weighted_io_time":   args.SystemDiskWeightedIOTime.toMap(),
+	}
+}
+
+// FilesystemMetricsArguments configures the metrics emitted by the filesystem scraper.
+type FilesystemMetricsArguments struct {
+	SystemFilesystemInodesUsage MetricArguments `alloy:"system.filesystem.inodes.usage,block,optional"`
+	SystemFilesystemUsage       MetricArguments `alloy:"system.filesystem.usage,block,optional"`
+	SystemFilesystemUtilization MetricArguments `alloy:"system.filesystem.utilization,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *FilesystemMetricsArguments) SetToDefault() {
+	*args = FilesystemMetricsArguments{
+		SystemFilesystemInodesUsage: MetricArguments{Enabled: true},
+		SystemFilesystemUsage:       MetricArguments{Enabled: true},
+		SystemFilesystemUtilization: MetricArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *FilesystemMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.filesystem.inodes.usage": args.SystemFilesystemInodesUsage.toMap(),
+		"system.filesystem.usage":        args.SystemFilesystemUsage.toMap(),
+		"system.filesystem.utilization":  args.SystemFilesystemUtilization.toMap(),
+	}
+}
+
+// LoadMetricsArguments configures the metrics emitted by the load scraper.
+//
+// Alloy identifiers can't start with a digit, so the last dot in the names of
+// the load average metrics is replaced with an underscore.
+type LoadMetricsArguments struct {
+	SystemCPULoadAverage15m MetricArguments `alloy:"system.cpu.load_average_15m,block,optional"`
+	SystemCPULoadAverage1m  MetricArguments `alloy:"system.cpu.load_average_1m,block,optional"`
+	SystemCPULoadAverage5m  MetricArguments `alloy:"system.cpu.load_average_5m,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *LoadMetricsArguments) SetToDefault() {
+	*args = LoadMetricsArguments{
+		SystemCPULoadAverage15m: MetricArguments{Enabled: true},
+		SystemCPULoadAverage1m:  MetricArguments{Enabled: true},
+		SystemCPULoadAverage5m:  MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *LoadMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.cpu.load_average.15m": args.SystemCPULoadAverage15m.toMap(),
+		"system.cpu.load_average.1m":  args.SystemCPULoadAverage1m.toMap(),
+		"system.cpu.load_average.5m":  args.SystemCPULoadAverage5m.toMap(),
+	}
+}
+
+// MemoryMetricsArguments configures the metrics emitted by the memory scraper.
+type MemoryMetricsArguments struct {
+	SystemLinuxMemoryAvailable MetricArguments `alloy:"system.linux.memory.available,block,optional"`
+	SystemLinuxMemoryDirty     MetricArguments `alloy:"system.linux.memory.dirty,block,optional"`
+	SystemMemoryLimit          MetricArguments `alloy:"system.memory.limit,block,optional"`
+	SystemMemoryPageSize       MetricArguments `alloy:"system.memory.page_size,block,optional"`
+	SystemMemoryUsage          MetricArguments `alloy:"system.memory.usage,block,optional"`
+	SystemMemoryUtilization    MetricArguments `alloy:"system.memory.utilization,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *MemoryMetricsArguments) SetToDefault() {
+	*args = MemoryMetricsArguments{
+		SystemLinuxMemoryAvailable: MetricArguments{Enabled: false},
+		SystemLinuxMemoryDirty:     MetricArguments{Enabled: false},
+		SystemMemoryLimit:          MetricArguments{Enabled: false},
+		SystemMemoryPageSize:       MetricArguments{Enabled: false},
+		SystemMemoryUsage:          MetricArguments{Enabled: true},
+		SystemMemoryUtilization:    MetricArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *MemoryMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.linux.memory.available": args.SystemLinuxMemoryAvailable.toMap(),
+		"system.linux.memory.dirty":     args.SystemLinuxMemoryDirty.toMap(),
+		"system.memory.limit":           args.SystemMemoryLimit.toMap(),
+		"system.memory.page_size":       args.SystemMemoryPageSize.toMap(),
+		"system.memory.usage":           args.SystemMemoryUsage.toMap(),
+		"system.memory.utilization":     args.SystemMemoryUtilization.toMap(),
+	}
+}
+
+// NetworkMetricsArguments configures the metrics emitted by the network scraper.
+type NetworkMetricsArguments struct {
+	SystemNetworkConnections    MetricArguments `alloy:"system.network.connections,block,optional"`
+	SystemNetworkConntrackCount MetricArguments `alloy:"system.network.conntrack.count,block,optional"`
+	SystemNetworkConntrackMax   MetricArguments `alloy:"system.network.conntrack.max,block,optional"`
+	SystemNetworkDropped        MetricArguments `alloy:"system.network.dropped,block,optional"`
+	SystemNetworkErrors         MetricArguments `alloy:"system.network.errors,block,optional"`
+	SystemNetworkIO             MetricArguments `alloy:"system.network.io,block,optional"`
+	SystemNetworkPackets        MetricArguments `alloy:"system.network.packets,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *NetworkMetricsArguments) SetToDefault() {
+	*args = NetworkMetricsArguments{
+		SystemNetworkConnections:    MetricArguments{Enabled: true},
+		SystemNetworkConntrackCount: MetricArguments{Enabled: false},
+		SystemNetworkConntrackMax:   MetricArguments{Enabled: false},
+		SystemNetworkDropped:        MetricArguments{Enabled: true},
+		SystemNetworkErrors:         MetricArguments{Enabled: true},
+		SystemNetworkIO:             MetricArguments{Enabled: true},
+		SystemNetworkPackets:        MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *NetworkMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.network.connections":     args.SystemNetworkConnections.toMap(),
+		"system.network.conntrack.count": args.SystemNetworkConntrackCount.toMap(),
+		"system.network.conntrack.max":   args.SystemNetworkConntrackMax.toMap(),
+		"system.network.dropped":         args.SystemNetworkDropped.toMap(),
+		"system.network.errors":          args.SystemNetworkErrors.toMap(),
+		"system.network.io":              args.SystemNetworkIO.toMap(),
+		"system.network.packets":         args.SystemNetworkPackets.toMap(),
+	}
+}
+
+// PagingMetricsArguments configures the metrics emitted by the paging scraper.
+type PagingMetricsArguments struct {
+	SystemPagingFaults      MetricArguments `alloy:"system.paging.faults,block,optional"`
+	SystemPagingOperations  MetricArguments `alloy:"system.paging.operations,block,optional"`
+	SystemPagingUsage       MetricArguments `alloy:"system.paging.usage,block,optional"`
+	SystemPagingUtilization MetricArguments `alloy:"system.paging.utilization,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *PagingMetricsArguments) SetToDefault() {
+	*args = PagingMetricsArguments{
+		SystemPagingFaults:      MetricArguments{Enabled: true},
+		SystemPagingOperations:  MetricArguments{Enabled: true},
+		SystemPagingUsage:       MetricArguments{Enabled: true},
+		SystemPagingUtilization: MetricArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *PagingMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.paging.faults":      args.SystemPagingFaults.toMap(),
+		"system.paging.operations":  args.SystemPagingOperations.toMap(),
+		"system.paging.usage":       args.SystemPagingUsage.toMap(),
+		"system.paging.utilization": args.SystemPagingUtilization.toMap(),
+	}
+}
+
+// ProcessesMetricsArguments configures the metrics emitted by the processes scraper.
+type ProcessesMetricsArguments struct {
+	SystemProcessesCount   MetricArguments `alloy:"system.processes.count,block,optional"`
+	SystemProcessesCreated MetricArguments `alloy:"system.processes.created,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ProcessesMetricsArguments) SetToDefault() {
+	*args = ProcessesMetricsArguments{
+		SystemProcessesCount:   MetricArguments{Enabled: true},
+		SystemProcessesCreated: MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *ProcessesMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.processes.count":   args.SystemProcessesCount.toMap(),
+		"system.processes.created": args.SystemProcessesCreated.toMap(),
+	}
+}
+
+// ProcessMetricsArguments configures the metrics emitted by the process scraper.
+type ProcessMetricsArguments struct {
+	ProcessContextSwitches     MetricArguments `alloy:"process.context_switches,block,optional"`
+	ProcessCPUTime             MetricArguments `alloy:"process.cpu.time,block,optional"`
+	ProcessCPUUtilization      MetricArguments `alloy:"process.cpu.utilization,block,optional"`
+	ProcessDiskIO              MetricArguments `alloy:"process.disk.io,block,optional"`
+	ProcessDiskOperations      MetricArguments `alloy:"process.disk.operations,block,optional"`
+	ProcessHandles             MetricArguments `alloy:"process.handles,block,optional"`
+	ProcessMemoryUsage         MetricArguments `alloy:"process.memory.usage,block,optional"`
+	ProcessMemoryUtilization   MetricArguments `alloy:"process.memory.utilization,block,optional"`
+	ProcessMemoryVirtual       MetricArguments `alloy:"process.memory.virtual,block,optional"`
+	ProcessOpenFileDescriptors MetricArguments `alloy:"process.open_file_descriptors,block,optional"`
+	ProcessPagingFaults        MetricArguments `alloy:"process.paging.faults,block,optional"`
+	ProcessSignalsPending      MetricArguments `alloy:"process.signals_pending,block,optional"`
+	ProcessThreads             MetricArguments `alloy:"process.threads,block,optional"`
+	ProcessUptime              MetricArguments `alloy:"process.uptime,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ProcessMetricsArguments) SetToDefault() {
+	*args = ProcessMetricsArguments{
+		ProcessContextSwitches:     MetricArguments{Enabled: false},
+		ProcessCPUTime:             MetricArguments{Enabled: true},
+		ProcessCPUUtilization:      MetricArguments{Enabled: false},
+		ProcessDiskIO:              MetricArguments{Enabled: true},
+		ProcessDiskOperations:      MetricArguments{Enabled: false},
+		ProcessHandles:             MetricArguments{Enabled: false},
+		ProcessMemoryUsage:         MetricArguments{Enabled: true},
+		ProcessMemoryUtilization:   MetricArguments{Enabled: false},
+		ProcessMemoryVirtual:       MetricArguments{Enabled: true},
+		ProcessOpenFileDescriptors: MetricArguments{Enabled: false},
+		ProcessPagingFaults:        MetricArguments{Enabled: false},
+		ProcessSignalsPending:      MetricArguments{Enabled: false},
+		ProcessThreads:             MetricArguments{Enabled: false},
+		ProcessUptime:              MetricArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *ProcessMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"process.context_switches":      args.ProcessContextSwitches.toMap(),
+		"process.cpu.time":              args.ProcessCPUTime.toMap(),
+		"process.cpu.utilization":       args.ProcessCPUUtilization.toMap(),
+		"process.disk.io":               args.ProcessDiskIO.toMap(),
+		"process.disk.operations":       args.ProcessDiskOperations.toMap(),
+		"process.handles":               args.ProcessHandles.toMap(),
+		"process.memory.usage":          args.ProcessMemoryUsage.toMap(),
+		"process.memory.utilization":    args.ProcessMemoryUtilization.toMap(),
+		"process.memory.virtual":        args.ProcessMemoryVirtual.toMap(),
+		"process.open_file_descriptors": args.ProcessOpenFileDescriptors.toMap(),
+		"process.paging.faults":         args.ProcessPagingFaults.toMap(),
+		"process.signals_pending":       args.ProcessSignalsPending.toMap(),
+		"process.threads":               args.ProcessThreads.toMap(),
+		"process.uptime":                args.ProcessUptime.toMap(),
+	}
+}
+
+// ProcessResourceAttributesArguments configures the resource attributes emitted by the process scraper.
+type ProcessResourceAttributesArguments struct {
+	ProcessCgroup         ResourceAttributeArguments `alloy:"process.cgroup,block,optional"`
+	ProcessCommand        ResourceAttributeArguments `alloy:"process.command,block,optional"`
+	ProcessCommandLine    ResourceAttributeArguments `alloy:"process.command_line,block,optional"`
+	ProcessExecutableName ResourceAttributeArguments `alloy:"process.executable.name,block,optional"`
+	ProcessExecutablePath ResourceAttributeArguments `alloy:"process.executable.path,block,optional"`
+	ProcessOwner          ResourceAttributeArguments `alloy:"process.owner,block,optional"`
+	ProcessParentPID      ResourceAttributeArguments `alloy:"process.parent_pid,block,optional"`
+	ProcessPID            ResourceAttributeArguments `alloy:"process.pid,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ProcessResourceAttributesArguments) SetToDefault() {
+	*args = ProcessResourceAttributesArguments{
+		ProcessCgroup:         ResourceAttributeArguments{Enabled: false},
+		ProcessCommand:        ResourceAttributeArguments{Enabled: true},
+		ProcessCommandLine:    ResourceAttributeArguments{Enabled: true},
+		ProcessExecutableName: ResourceAttributeArguments{Enabled: true},
+		ProcessExecutablePath: ResourceAttributeArguments{Enabled: true},
+		ProcessOwner:          ResourceAttributeArguments{Enabled: true},
+		ProcessParentPID:      ResourceAttributeArguments{Enabled: true},
+		ProcessPID:            ResourceAttributeArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *ProcessResourceAttributesArguments) toMap() map[string]any {
+	return map[string]any{
+		"process.cgroup":          args.ProcessCgroup.toMap(),
+		"process.command":         args.ProcessCommand.toMap(),
+		"process.command_line":    args.ProcessCommandLine.toMap(),
+		"process.executable.name": args.ProcessExecutableName.toMap(),
+		"process.executable.path": args.ProcessExecutablePath.toMap(),
+		"process.owner":           args.ProcessOwner.toMap(),
+		"process.parent_pid":      args.ProcessParentPID.toMap(),
+		"process.pid":             args.ProcessPID.toMap(),
+	}
+}
+
+// SystemMetricsArguments configures the metrics emitted by the system scraper.
+type SystemMetricsArguments struct {
+	SystemUptime MetricArguments `alloy:"system.uptime,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *SystemMetricsArguments) SetToDefault() {
+	*args = SystemMetricsArguments{
+		SystemUptime: MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *SystemMetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"system.uptime": args.SystemUptime.toMap(),
+	}
+}
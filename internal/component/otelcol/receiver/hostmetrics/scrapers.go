@@ -0,0 +1,414 @@
+package hostmetrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafana/alloy/syntax"
+)
+
+// CPUScraperArguments configures the cpu scraper.
+type CPUScraperArguments struct {
+	Metrics CPUMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *CPUScraperArguments) SetToDefault() {
+	args.Metrics.SetToDefault()
+}
+
+func (args *CPUScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// DiskScraperArguments configures the disk scraper.
+type DiskScraperArguments struct {
+	Include *DeviceMatchArguments `alloy:"include,block,optional"`
+	Exclude *DeviceMatchArguments `alloy:"exclude,block,optional"`
+
+	Metrics DiskMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *DiskScraperArguments) SetToDefault() {
+	*args = DiskScraperArguments{}
+	args.Metrics.SetToDefault()
+}
+
+func (args *DiskScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"include": args.Include.toMap(),
+		"exclude": args.Exclude.toMap(),
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// FilesystemScraperArguments configures the filesystem scraper.
+type FilesystemScraperArguments struct {
+	IncludeVirtualFS   bool                      `alloy:"include_virtual_filesystems,attr,optional"`
+	IncludeDevices     *DeviceMatchArguments     `alloy:"include_devices,block,optional"`
+	ExcludeDevices     *DeviceMatchArguments     `alloy:"exclude_devices,block,optional"`
+	IncludeFSTypes     *FSTypeMatchArguments     `alloy:"include_fs_types,block,optional"`
+	ExcludeFSTypes     *FSTypeMatchArguments     `alloy:"exclude_fs_types,block,optional"`
+	IncludeMountPoints *MountPointMatchArguments `alloy:"include_mount_points,block,optional"`
+	ExcludeMountPoints *MountPointMatchArguments `alloy:"exclude_mount_points,block,optional"`
+
+	Metrics FilesystemMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *FilesystemScraperArguments) SetToDefault() {
+	*args = FilesystemScraperArguments{}
+	args.Metrics.SetToDefault()
+}
+
+func (args *FilesystemScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"include_virtual_filesystems": args.IncludeVirtualFS,
+		"include_devices":             args.IncludeDevices.toMap(),
+		"exclude_devices":             args.ExcludeDevices.toMap(),
+		"include_fs_types":            args.IncludeFSTypes.toMap(),
+		"exclude_fs_types":            args.ExcludeFSTypes.toMap(),
+		"include_mount_points":        args.IncludeMountPoints.toMap(),
+		"exclude_mount_points":        args.ExcludeMountPoints.toMap(),
+		"metrics":                     args.Metrics.toMap(),
+	}
+}
+
+// LoadScraperArguments configures the load scraper.
+type LoadScraperArguments struct {
+	CPUAverage bool `alloy:"cpu_average,attr,optional"`
+
+	Metrics LoadMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *LoadScraperArguments) SetToDefault() {
+	*args = LoadScraperArguments{}
+	args.Metrics.SetToDefault()
+}
+
+func (args *LoadScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"cpu_average": args.CPUAverage,
+		"metrics":     args.Metrics.toMap(),
+	}
+}
+
+// MemoryScraperArguments configures the memory scraper.
+type MemoryScraperArguments struct {
+	Metrics MemoryMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *MemoryScraperArguments) SetToDefault() {
+	args.Metrics.SetToDefault()
+}
+
+func (args *MemoryScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// NetworkScraperArguments configures the network scraper.
+type NetworkScraperArguments struct {
+	Include *InterfaceMatchArguments `alloy:"include,block,optional"`
+	Exclude *InterfaceMatchArguments `alloy:"exclude,block,optional"`
+
+	Metrics NetworkMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *NetworkScraperArguments) SetToDefault() {
+	*args = NetworkScraperArguments{}
+	args.Metrics.SetToDefault()
+}
+
+func (args *NetworkScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"include": args.Include.toMap(),
+		"exclude": args.Exclude.toMap(),
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// PagingScraperArguments configures the paging scraper.
+type PagingScraperArguments struct {
+	Metrics PagingMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *PagingScraperArguments) SetToDefault() {
+	args.Metrics.SetToDefault()
+}
+
+func (args *PagingScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// ProcessesScraperArguments configures the processes scraper.
+type ProcessesScraperArguments struct {
+	Metrics ProcessesMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ProcessesScraperArguments) SetToDefault() {
+	args.Metrics.SetToDefault()
+}
+
+func (args *ProcessesScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// ProcessScraperArguments configures the process scraper.
+type ProcessScraperArguments struct {
+	Include *NameMatchArguments `alloy:"include,block,optional"`
+	Exclude *NameMatchArguments `alloy:"exclude,block,optional"`
+
+	MuteProcessAllErrors   bool          `alloy:"mute_process_all_errors,attr,optional"`
+	MuteProcessNameError   bool          `alloy:"mute_process_name_error,attr,optional"`
+	MuteProcessIOError     bool          `alloy:"mute_process_io_error,attr,optional"`
+	MuteProcessCgroupError bool          `alloy:"mute_process_cgroup_error,attr,optional"`
+	MuteProcessExeError    bool          `alloy:"mute_process_exe_error,attr,optional"`
+	MuteProcessUserError   bool          `alloy:"mute_process_user_error,attr,optional"`
+	ScrapeProcessDelay     time.Duration `alloy:"scrape_process_delay,attr,optional"`
+
+	Metrics            ProcessMetricsArguments            `alloy:"metrics,block,optional"`
+	ResourceAttributes ProcessResourceAttributesArguments `alloy:"resource_attributes,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ProcessScraperArguments) SetToDefault() {
+	*args = ProcessScraperArguments{}
+	args.Metrics.SetToDefault()
+	args.ResourceAttributes.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *ProcessScraperArguments) Validate() error {
+	if args.ScrapeProcessDelay < 0 {
+		return fmt.Errorf("scrape_process_delay must not be negative")
+	}
+	return nil
+}
+
+func (args *ProcessScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"include":                   args.Include.toMap(),
+		"exclude":                   args.Exclude.toMap(),
+		"mute_process_all_errors":   args.MuteProcessAllErrors,
+		"mute_process_name_error":   args.MuteProcessNameError,
+		"mute_process_io_error":     args.MuteProcessIOError,
+		"mute_process_cgroup_error": args.MuteProcessCgroupError,
+		"mute_process_exe_error":    args.MuteProcessExeError,
+		"mute_process_user_error":   args.MuteProcessUserError,
+		"scrape_process_delay":      args.ScrapeProcessDelay,
+		"metrics":                   args.Metrics.toMap(),
+		"resource_attributes":       args.ResourceAttributes.toMap(),
+	}
+}
+
+// SystemScraperArguments configures the system scraper.
+type SystemScraperArguments struct {
+	Metrics SystemMetricsArguments `alloy:"metrics,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *SystemScraperArguments) SetToDefault() {
+	args.Metrics.SetToDefault()
+}
+
+func (args *SystemScraperArguments) toMap() map[string]any {
+	return map[string]any{
+		"metrics": args.Metrics.toMap(),
+	}
+}
+
+// MetricArguments configures whether a metric is emitted.
+type MetricArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *MetricArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
+
+// ResourceAttributeArguments configures whether a resource attribute is
+// emitted.
+type ResourceAttributeArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *ResourceAttributeArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
+
+// Supported values for the match_type argument.
+const (
+	MatchTypeStrict = "strict"
+	MatchTypeRegexp = "regexp"
+)
+
+func validateMatchType(matchType string) error {
+	switch matchType {
+	case MatchTypeStrict, MatchTypeRegexp:
+		return nil
+	default:
+		return fmt.Errorf("match_type must be %q or %q, got %q", MatchTypeStrict, MatchTypeRegexp, matchType)
+	}
+}
+
+// matchMap encodes a filter to a map for use with confmap.
+func matchMap(matchType string, key string, values []string) map[string]any {
+	return map[string]any{
+		"match_type": matchType,
+		key:          values,
+	}
+}
+
+// DeviceMatchArguments filters data by device name.
+type DeviceMatchArguments struct {
+	Devices   []string `alloy:"devices,attr"`
+	MatchType string   `alloy:"match_type,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*DeviceMatchArguments)(nil)
+	_ syntax.Validator = (*DeviceMatchArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *DeviceMatchArguments) SetToDefault() {
+	*args = DeviceMatchArguments{MatchType: MatchTypeStrict}
+}
+
+// Validate implements syntax.Validator.
+func (args *DeviceMatchArguments) Validate() error {
+	return validateMatchType(args.MatchType)
+}
+
+func (args *DeviceMatchArguments) toMap() map[string]any {
+	if args == nil {
+		return nil
+	}
+	return matchMap(args.MatchType, "devices", args.Devices)
+}
+
+// FSTypeMatchArguments filters data by filesystem type.
+type FSTypeMatchArguments struct {
+	FSTypes   []string `alloy:"fs_types,attr"`
+	MatchType string   `alloy:"match_type,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*FSTypeMatchArguments)(nil)
+	_ syntax.Validator = (*FSTypeMatchArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *FSTypeMatchArguments) SetToDefault() {
+	*args = FSTypeMatchArguments{MatchType: MatchTypeStrict}
+}
+
+// Validate implements syntax.Validator.
+func (args *FSTypeMatchArguments) Validate() error {
+	return validateMatchType(args.MatchType)
+}
+
+func (args *FSTypeMatchArguments) toMap() map[string]any {
+	if args == nil {
+		return nil
+	}
+	return matchMap(args.MatchType, "fs_types", args.FSTypes)
+}
+
+// MountPointMatchArguments filters data by mount point.
+type MountPointMatchArguments struct {
+	MountPoints []string `alloy:"mount_points,attr"`
+	MatchType   string   `alloy:"match_type,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*MountPointMatchArguments)(nil)
+	_ syntax.Validator = (*MountPointMatchArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *MountPointMatchArguments) SetToDefault() {
+	*args = MountPointMatchArguments{MatchType: MatchTypeStrict}
+}
+
+// Validate implements syntax.Validator.
+func (args *MountPointMatchArguments) Validate() error {
+	return validateMatchType(args.MatchType)
+}
+
+func (args *MountPointMatchArguments) toMap() map[string]any {
+	if args == nil {
+		return nil
+	}
+	return matchMap(args.MatchType, "mount_points", args.MountPoints)
+}
+
+// InterfaceMatchArguments filters data by network interface.
+type InterfaceMatchArguments struct {
+	Interfaces []string `alloy:"interfaces,attr"`
+	MatchType  string   `alloy:"match_type,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*InterfaceMatchArguments)(nil)
+	_ syntax.Validator = (*InterfaceMatchArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *InterfaceMatchArguments) SetToDefault() {
+	*args = InterfaceMatchArguments{MatchType: MatchTypeStrict}
+}
+
+// Validate implements syntax.Validator.
+func (args *InterfaceMatchArguments) Validate() error {
+	return validateMatchType(args.MatchType)
+}
+
+func (args *InterfaceMatchArguments) toMap() map[string]any {
+	if args == nil {
+		return nil
+	}
+	return matchMap(args.MatchType, "interfaces", args.Interfaces)
+}
+
+// NameMatchArguments filters data by process name.
+type NameMatchArguments struct {
+	Names     []string `alloy:"names,attr"`
+	MatchType string   `alloy:"match_type,attr,optional"`
+}
+
+var (
+	_ syntax.Defaulter = (*NameMatchArguments)(nil)
+	_ syntax.Validator = (*NameMatchArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *NameMatchArguments) SetToDefault() {
+	*args = NameMatchArguments{MatchType: MatchTypeStrict}
+}
+
+// Validate implements syntax.Validator.
+func (args *NameMatchArguments) Validate() error {
+	return validateMatchType(args.MatchType)
+}
+
+func (args *NameMatchArguments) toMap() map[string]any {
+	if args == nil {
+		return nil
+	}
+	return matchMap(args.MatchType, "names", args.Names)
+}
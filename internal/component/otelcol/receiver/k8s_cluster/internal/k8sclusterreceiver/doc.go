@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package k8sclusterreceiver is a copy of v0.134.0 of
+// opentelemetry-collector-contrib/receiver/k8sclusterreceiver.
+//
+// The upstream receiver creates its Kubernetes clients from an auth_type
+// setting. This copy takes the functions which create the clients in its
+// Config instead, so that otelcol.receiver.k8s_cluster connects to the
+// Kubernetes API with the same client settings as the other Kubernetes
+// components.
+package k8sclusterreceiver
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/grafana/alloy/internal/component/otelcol/internal/sharedcomponent"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/k8s_cluster/internal/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// supported distributions
+	distributionKubernetes = "kubernetes"
+	distributionOpenShift  = "openshift"
+
+	// Default config values.
+	defaultCollectionInterval         = 10 * time.Second
+	defaultDistribution               = distributionKubernetes
+	defaultMetadataCollectionInterval = 5 * time.Minute
+)
+
+var defaultNodeConditionsToReport = []string{"Ready"}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Distribution:               defaultDistribution,
+		CollectionInterval:         defaultCollectionInterval,
+		NodeConditionTypesToReport: defaultNodeConditionsToReport,
+		MetadataCollectionInterval: defaultMetadataCollectionInterval,
+		MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+// NewFactory creates a factory for k8s_cluster receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(newMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(newLogsReceiver, metadata.MetricsStability),
+	)
+}
+
+// This is the map of already created k8scluster receivers for particular configurations.
+// We maintain this map because the Factory is asked log and metric receivers separately
+// when it gets CreateLogs() and CreateMetrics() but they must not
+// create separate objects, they must use one receiver object per configuration.
+var receivers = sharedcomponent.NewSharedComponents()
@@ -0,0 +1,165 @@
+// Package k8s_cluster provides an otelcol.receiver.k8s_cluster component.
+package k8s_cluster
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/alloy/internal/component"
+	commonk8s "github.com/grafana/alloy/internal/component/common/kubernetes"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/k8s_cluster/internal/k8sclusterreceiver"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pipeline"
+	"k8s.io/client-go/kubernetes"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.receiver.k8s_cluster",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := k8sclusterreceiver.NewFactory()
+			return receiver.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Supported values for the distribution argument.
+const (
+	DistributionKubernetes = "kubernetes"
+	DistributionOpenShift  = "openshift"
+)
+
+// Arguments configures the otelcol.receiver.k8s_cluster component.
+type Arguments struct {
+	// Client settings to connect to Kubernetes.
+	Client commonk8s.ClientArguments `alloy:"client,block,optional"`
+
+	CollectionInterval         time.Duration `alloy:"collection_interval,attr,optional"`
+	MetadataCollectionInterval time.Duration `alloy:"metadata_collection_interval,attr,optional"`
+	NodeConditionsToReport     []string      `alloy:"node_conditions_to_report,attr,optional"`
+	AllocatableTypesToReport   []string      `alloy:"allocatable_types_to_report,attr,optional"`
+	Distribution               string        `alloy:"distribution,attr,optional"`
+	Namespace                  string        `alloy:"namespace,attr,optional"`
+
+	Metrics            MetricsArguments            `alloy:"metrics,block,optional"`
+	ResourceAttributes ResourceAttributesArguments `alloy:"resource_attributes,block,optional"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+
+	// Output configures where to send received data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+}
+
+var (
+	_ receiver.Arguments = Arguments{}
+	_ syntax.Defaulter   = (*Arguments)(nil)
+	_ syntax.Validator   = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		Client:                     commonk8s.DefaultClientArguments,
+		CollectionInterval:         10 * time.Second,
+		MetadataCollectionInterval: 5 * time.Minute,
+		NodeConditionsToReport:     []string{"Ready"},
+		Distribution:               DistributionKubernetes,
+	}
+	args.Metrics.SetToDefault()
+	args.ResourceAttributes.SetToDefault()
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+
+	if args.CollectionInterval <= 0 {
+		errs = errors.Join(errs, fmt.Errorf("collection_interval must be greater than 0"))
+	}
+	if args.MetadataCollectionInterval < 0 {
+		errs = errors.Join(errs, fmt.Errorf("metadata_collection_interval must not be negative"))
+	}
+	switch args.Distribution {
+	case DistributionKubernetes, DistributionOpenShift:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("distribution must be %q or %q, got %q", DistributionKubernetes, DistributionOpenShift, args.Distribution))
+	}
+
+	return errs
+}
+
+// Convert implements receiver.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	cfg := k8sclusterreceiver.NewFactory().CreateDefaultConfig().(*k8sclusterreceiver.Config)
+
+	// The metrics configuration is internal to the receiver, so it can only be
+	// set by unmarshaling it.
+	err := confmap.NewFromStringMap(map[string]any{
+		"metrics":             args.Metrics.toMap(),
+		"resource_attributes": args.ResourceAttributes.toMap(),
+	}).Unmarshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.CollectionInterval = args.CollectionInterval
+	cfg.MetadataCollectionInterval = args.MetadataCollectionInterval
+	cfg.NodeConditionTypesToReport = append([]string(nil), args.NodeConditionsToReport...)
+	cfg.AllocatableTypesToReport = append([]string(nil), args.AllocatableTypesToReport...)
+	cfg.Distribution = args.Distribution
+	cfg.Namespace = args.Namespace
+
+	// The clients are only created when the receiver starts, so that invalid
+	// client settings are reported as an unhealthy component.
+	client := args.Client
+	cfg.MakeClient = func() (kubernetes.Interface, error) {
+		restConfig, err := client.BuildRESTConfig(log.NewNopLogger())
+		if err != nil {
+			return nil, err
+		}
+		return kubernetes.NewForConfig(restConfig)
+	}
+	cfg.MakeOpenShiftQuotaClient = func() (quotaclientset.Interface, error) {
+		restConfig, err := client.BuildRESTConfig(log.NewNopLogger())
+		if err != nil {
+			return nil, err
+		}
+		return quotaclientset.NewForConfig(restConfig)
+	}
+
+	return cfg, nil
+}
+
+// Extensions implements receiver.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements receiver.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements receiver.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements receiver.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
@@ -0,0 +1,220 @@
+package k8s_cluster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/internal/scheduler"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/k8s_cluster"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/k8s_cluster/internal/k8sclusterreceiver"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestArguments_Defaults(t *testing.T) {
+	var args k8s_cluster.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`output {}`), &args))
+
+	out, err := args.Convert()
+	require.NoError(t, err)
+
+	// The defaults must match the ones of the receiver.
+	expected := k8sclusterreceiver.NewFactory().CreateDefaultConfig()
+	require.Equal(t, encode(t, expected), encode(t, out))
+}
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	in := `
+		collection_interval          = "30s"
+		metadata_collection_interval = "0s"
+		node_conditions_to_report    = ["Ready", "MemoryPressure"]
+		allocatable_types_to_report  = ["cpu", "memory"]
+		distribution                 = "openshift"
+		namespace                    = "monitoring"
+
+		client {
+			api_server = "https://kubernetes.example.com:6443"
+		}
+
+		metrics {
+			k8s.node.condition {
+				enabled = true
+			}
+			k8s.pod.phase {
+				enabled = false
+			}
+		}
+
+		resource_attributes {
+			k8s.node.uid {
+				enabled = false
+			}
+		}
+
+		output {}
+	`
+
+	var args k8s_cluster.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(in), &args))
+
+	outAny, err := args.Convert()
+	require.NoError(t, err)
+	out := outAny.(*k8sclusterreceiver.Config)
+
+	require.Equal(t, 30*time.Second, out.CollectionInterval)
+	require.Equal(t, time.Duration(0), out.MetadataCollectionInterval)
+	require.Equal(t, []string{"Ready", "MemoryPressure"}, out.NodeConditionTypesToReport)
+	require.Equal(t, []string{"cpu", "memory"}, out.AllocatableTypesToReport)
+	require.Equal(t, "openshift", out.Distribution)
+	require.Equal(t, "monitoring", out.Namespace)
+
+	require.True(t, out.Metrics.K8sNodeCondition.Enabled)
+	require.False(t, out.Metrics.K8sPodPhase.Enabled)
+	require.True(t, out.Metrics.K8sContainerRestarts.Enabled)
+	require.False(t, out.ResourceAttributes.K8sNodeUID.Enabled)
+	require.True(t, out.ResourceAttributes.K8sNodeName.Enabled)
+
+	// The clients are built from the client block.
+	client, err := out.MakeClient()
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	quotaClient, err := out.MakeOpenShiftQuotaClient()
+	require.NoError(t, err)
+	require.NotNil(t, quotaClient)
+}
+
+func TestReceiver(t *testing.T) {
+	var args k8s_cluster.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`
+		collection_interval = "100ms"
+		output {}
+	`), &args))
+
+	cfgAny, err := args.Convert()
+	require.NoError(t, err)
+	cfg := cfgAny.(*k8sclusterreceiver.Config)
+
+	client := fake.NewClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default", UID: "pod-1-uid"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+	client.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Version: "v1", Kind: "Pod"}},
+	}}
+	cfg.MakeClient = func() (kubernetes.Interface, error) { return client, nil }
+
+	var (
+		fact = k8sclusterreceiver.NewFactory()
+		sink = new(consumertest.MetricsSink)
+	)
+	rcv, err := fact.CreateMetrics(t.Context(), receivertest.NewNopSettings(fact.Type()), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(t.Context(), scheduler.NewHost(util.TestLogger(t))))
+	defer func() {
+		require.NoError(t, rcv.Shutdown(context.Background()))
+	}()
+
+	require.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			if phase, ok := podPhase(md, "pod-1"); ok {
+				// The Running phase is reported as 2.
+				return phase == 2
+			}
+		}
+		return false
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+// podPhase returns the value of the k8s.pod.phase metric of the pod called
+// name in md.
+func podPhase(md pmetric.Metrics, name string) (int64, bool) {
+	for _, rm := range md.ResourceMetrics().All() {
+		podName, ok := rm.Resource().Attributes().Get("k8s.pod.name")
+		if !ok || podName.Str() != name {
+			continue
+		}
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				if m.Name() == "k8s.pod.phase" {
+					return m.Gauge().DataPoints().At(0).IntValue(), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      string
+		errorMsg string
+	}{
+		{
+			name: "invalid client",
+			cfg: `
+				client {
+					api_server      = "https://kubernetes.example.com:6443"
+					kubeconfig_file = "/etc/kubeconfig"
+				}
+				output {}
+			`,
+			errorMsg: "only one of api_server and kubeconfig_file can be set",
+		},
+		{
+			name: "invalid collection interval",
+			cfg: `
+				collection_interval = "0s"
+				output {}
+			`,
+			errorMsg: "collection_interval must be greater than 0",
+		},
+		{
+			name: "negative metadata collection interval",
+			cfg: `
+				metadata_collection_interval = "-1s"
+				output {}
+			`,
+			errorMsg: "metadata_collection_interval must not be negative",
+		},
+		{
+			name: "invalid distribution",
+			cfg: `
+				distribution = "eks"
+				output {}
+			`,
+			errorMsg: `distribution must be "kubernetes" or "openshift", got "eks"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args k8s_cluster.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+// encode returns the encoded form of cfg. The upstream metrics configuration
+// tracks whether it was set by the user in unexported fields, so the configs
+// can't be compared directly.
+func encode(t *testing.T, cfg otelcomponent.Config) map[string]any {
+	t.Helper()
+
+	conf := confmap.New()
+	require.NoError(t, conf.Marshal(cfg))
+	return conf.ToStringMap()
+}
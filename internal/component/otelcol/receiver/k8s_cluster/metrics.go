@@ -0,0 +1,310 @@
+package k8s_cluster
+
+// The types in this file mirror the metadata.yaml file of the upstream
+// receiver.
+
+// MetricsArguments configures the metrics emitted by otelcol.receiver.k8s_cluster.
+type MetricsArguments struct {
+	K8sContainerCPULimit                MetricArguments `alloy:"k8s.container.cpu_limit,block,optional"`
+	K8sContainerCPURequest              MetricArguments `alloy:"k8s.container.cpu_request,block,optional"`
+	K8sContainerEphemeralstorageLimit   MetricArguments `alloy:"k8s.container.ephemeralstorage_limit,block,optional"`
+	K8sContainerEphemeralstorageRequest MetricArguments `alloy:"k8s.container.ephemeralstorage_request,block,optional"`
+	K8sContainerMemoryLimit             MetricArguments `alloy:"k8s.container.memory_limit,block,optional"`
+	K8sContainerMemoryRequest           MetricArguments `alloy:"k8s.container.memory_request,block,optional"`
+	K8sContainerReady                   MetricArguments `alloy:"k8s.container.ready,block,optional"`
+	K8sContainerRestarts                MetricArguments `alloy:"k8s.container.restarts,block,optional"`
+	K8sContainerStatusState             MetricArguments `alloy:"k8s.container.status.state,block,optional"`
+	K8sContainerStorageLimit            MetricArguments `alloy:"k8s.container.storage_limit,block,optional"`
+	K8sContainerStorageRequest          MetricArguments `alloy:"k8s.container.storage_request,block,optional"`
+	K8sCronjobActiveJobs                MetricArguments `alloy:"k8s.cronjob.active_jobs,block,optional"`
+	K8sDaemonsetCurrentScheduledNodes   MetricArguments `alloy:"k8s.daemonset.current_scheduled_nodes,block,optional"`
+	K8sDaemonsetDesiredScheduledNodes   MetricArguments `alloy:"k8s.daemonset.desired_scheduled_nodes,block,optional"`
+	K8sDaemonsetMisscheduledNodes       MetricArguments `alloy:"k8s.daemonset.misscheduled_nodes,block,optional"`
+	K8sDaemonsetReadyNodes              MetricArguments `alloy:"k8s.daemonset.ready_nodes,block,optional"`
+	K8sDeploymentAvailable              MetricArguments `alloy:"k8s.deployment.available,block,optional"`
+	K8sDeploymentDesired                MetricArguments `alloy:"k8s.deployment.desired,block,optional"`
+	K8sHPACurrentReplicas               MetricArguments `alloy:"k8s.hpa.current_replicas,block,optional"`
+	K8sHPADesiredReplicas               MetricArguments `alloy:"k8s.hpa.desired_replicas,block,optional"`
+	K8sHPAMaxReplicas                   MetricArguments `alloy:"k8s.hpa.max_replicas,block,optional"`
+	K8sHPAMinReplicas                   MetricArguments `alloy:"k8s.hpa.min_replicas,block,optional"`
+	K8sJobActivePods                    MetricArguments `alloy:"k8s.job.active_pods,block,optional"`
+	K8sJobDesiredSuccessfulPods         MetricArguments `alloy:"k8s.job.desired_successful_pods,block,optional"`
+	K8sJobFailedPods                    MetricArguments `alloy:"k8s.job.failed_pods,block,optional"`
+	K8sJobMaxParallelPods               MetricArguments `alloy:"k8s.job.max_parallel_pods,block,optional"`
+	K8sJobSuccessfulPods                MetricArguments `alloy:"k8s.job.successful_pods,block,optional"`
+	K8sNamespacePhase                   MetricArguments `alloy:"k8s.namespace.phase,block,optional"`
+	K8sNodeCondition                    MetricArguments `alloy:"k8s.node.condition,block,optional"`
+	K8sPodPhase                         MetricArguments `alloy:"k8s.pod.phase,block,optional"`
+	K8sPodStatusReason                  MetricArguments `alloy:"k8s.pod.status_reason,block,optional"`
+	K8sReplicasetAvailable              MetricArguments `alloy:"k8s.replicaset.available,block,optional"`
+	K8sReplicasetDesired                MetricArguments `alloy:"k8s.replicaset.desired,block,optional"`
+	K8sReplicationControllerAvailable   MetricArguments `alloy:"k8s.replication_controller.available,block,optional"`
+	K8sReplicationControllerDesired     MetricArguments `alloy:"k8s.replication_controller.desired,block,optional"`
+	K8sResourceQuotaHardLimit           MetricArguments `alloy:"k8s.resource_quota.hard_limit,block,optional"`
+	K8sResourceQuotaUsed                MetricArguments `alloy:"k8s.resource_quota.used,block,optional"`
+	K8sStatefulsetCurrentPods           MetricArguments `alloy:"k8s.statefulset.current_pods,block,optional"`
+	K8sStatefulsetDesiredPods           MetricArguments `alloy:"k8s.statefulset.desired_pods,block,optional"`
+	K8sStatefulsetReadyPods             MetricArguments `alloy:"k8s.statefulset.ready_pods,block,optional"`
+	K8sStatefulsetUpdatedPods           MetricArguments `alloy:"k8s.statefulset.updated_pods,block,optional"`
+	OpenshiftAppliedclusterquotaLimit   MetricArguments `alloy:"openshift.appliedclusterquota.limit,block,optional"`
+	OpenshiftAppliedclusterquotaUsed    MetricArguments `alloy:"openshift.appliedclusterquota.used,block,optional"`
+	OpenshiftClusterquotaLimit          MetricArguments `alloy:"openshift.clusterquota.limit,block,optional"`
+	OpenshiftClusterquotaUsed           MetricArguments `alloy:"openshift.clusterquota.used,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *MetricsArguments) SetToDefault() {
+	*args = MetricsArguments{
+		K8sContainerCPULimit:                MetricArguments{Enabled: true},
+		K8sContainerCPURequest:              MetricArguments{Enabled: true},
+		K8sContainerEphemeralstorageLimit:   MetricArguments{Enabled: true},
+		K8sContainerEphemeralstorageRequest: MetricArguments{Enabled: true},
+		K8sContainerMemoryLimit:             MetricArguments{Enabled: true},
+		K8sContainerMemoryRequest:           MetricArguments{Enabled: true},
+		K8sContainerReady:                   MetricArguments{Enabled: true},
+		K8sContainerRestarts:                MetricArguments{Enabled: true},
+		K8sContainerStatusState:             MetricArguments{Enabled: false},
+		K8sContainerStorageLimit:            MetricArguments{Enabled: true},
+		K8sContainerStorageRequest:          MetricArguments{Enabled: true},
+		K8sCronjobActiveJobs:                MetricArguments{Enabled: true},
+		K8sDaemonsetCurrentScheduledNodes:   MetricArguments{Enabled: true},
+		K8sDaemonsetDesiredScheduledNodes:   MetricArguments{Enabled: true},
+		K8sDaemonsetMisscheduledNodes:       MetricArguments{Enabled: true},
+		K8sDaemonsetReadyNodes:              MetricArguments{Enabled: true},
+		K8sDeploymentAvailable:              MetricArguments{Enabled: true},
+		K8sDeploymentDesired:                MetricArguments{Enabled: true},
+		K8sHPACurrentReplicas:               MetricArguments{Enabled: true},
+		K8sHPADesiredReplicas:               MetricArguments{Enabled: true},
+		K8sHPAMaxReplicas:                   MetricArguments{Enabled: true},
+		K8sHPAMinReplicas:                   MetricArguments{Enabled: true},
+		K8sJobActivePods:                    MetricArguments{Enabled: true},
+		K8sJobDesiredSuccessfulPods:         MetricArguments{Enabled: true},
+		K8sJobFailedPods:                    MetricArguments{Enabled: true},
+		K8sJobMaxParallelPods:               MetricArguments{Enabled: true},
+		K8sJobSuccessfulPods:                MetricArguments{Enabled: true},
+		K8sNamespacePhase:                   MetricArguments{Enabled: true},
+		K8sNodeCondition:                    MetricArguments{Enabled: false},
+		K8sPodPhase:                         MetricArguments{Enabled: true},
+		K8sPodStatusReason:                  MetricArguments{Enabled: false},
+		K8sReplicasetAvailable:              MetricArguments{Enabled: true},
+		K8sReplicasetDesired:                MetricArguments{Enabled: true},
+		K8sReplicationControllerAvailable:   MetricArguments{Enabled: true},
+		K8sReplicationControllerDesired:     MetricArguments{Enabled: true},
+		K8sResourceQuotaHardLimit:           MetricArguments{Enabled: true},
+		K8sResourceQuotaUsed:                MetricArguments{Enabled: true},
+		K8sStatefulsetCurrentPods:           MetricArguments{Enabled: true},
+		K8sStatefulsetDesiredPods:           MetricArguments{Enabled: true},
+		K8sStatefulsetReadyPods:             MetricArguments{Enabled: true},
+		K8sStatefulsetUpdatedPods:           MetricArguments{Enabled: true},
+		OpenshiftAppliedclusterquotaLimit:   MetricArguments{Enabled: true},
+		OpenshiftAppliedclusterquotaUsed:    MetricArguments{Enabled: true},
+		OpenshiftClusterquotaLimit:          MetricArguments{Enabled: true},
+		OpenshiftClusterquotaUsed:           MetricArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *MetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"k8s.container.cpu_limit":                args.K8sContainerCPULimit.toMap(),
+		"k8s.container.cpu_request":              args.K8sContainerCPURequest.toMap(),
+		"k8s.container.ephemeralstorage_limit":   args.K8sContainerEphemeralstorageLimit.toMap(),
+		"k8s.container.ephemeralstorage_request": args.K8sContainerEphemeralstorageRequest.toMap(),
+		"k8s.container.memory_limit":             args.K8sContainerMemoryLimit.toMap(),
+		"k8s.container.memory_request":           args.K8sContainerMemoryRequest.toMap(),
+		"k8s.container.ready":                    args.K8sContainerReady.toMap(),
+		"k8s.container.restarts":                 args.K8sContainerRestarts.toMap(),
+		"k8s.container.status.state":             args.K8sContainerStatusState.toMap(),
+		"k8s.container.storage_limit":            args.K8sContainerStorageLimit.toMap(),
+		"k8s.container.storage_request":          args.K8sContainerStorageRequest.toMap(),
+		"k8s.cronjob.active_jobs":                args.K8sCronjobActiveJobs.toMap(),
+		"k8s.daemonset.current_scheduled_nodes":  args.K8sDaemonsetCurrentScheduledNodes.toMap(),
+		"k8s.daemonset.desired_scheduled_nodes":  args.K8sDaemonsetDesiredScheduledNodes.toMap(),
+		"k8s.daemonset.misscheduled_nodes":       args.K8sDaemonsetMisscheduledNodes.toMap(),
+		"k8s.daemonset.ready_nodes":              args.K8sDaemonsetReadyNodes.toMap(),
+		"k8s.deployment.available":               args.K8sDeploymentAvailable.toMap(),
+		"k8s.deployment.desired":                 args.K8sDeploymentDesired.toMap(),
+		"k8s.hpa.current_replicas":               args.K8sHPACurrentReplicas.toMap(),
+		"k8s.hpa.desired_replicas":               args.K8sHPADesiredReplicas.toMap(),
+		"k8s.hpa.max_replicas":                   args.K8sHPAMaxReplicas.toMap(),
+		"k8s.hpa.min_replicas":                   args.K8sHPAMinReplicas.toMap(),
+		"k8s.job.active_pods":                    args.K8sJobActivePods.toMap(),
+		"k8s.job.desired_successful_pods":        args.K8sJobDesiredSuccessfulPods.toMap(),
+		"k8s.job.failed_pods":                    args.K8sJobFailedPods.toMap(),
+		"k8s.job.max_parallel_pods":              args.K8sJobMaxParallelPods.toMap(),
+		"k8s.job.successful_pods":                args.K8sJobSuccessfulPods.toMap(),
+		"k8s.namespace.phase":                    args.K8sNamespacePhase.toMap(),
+		"k8s.node.condition":                     args.K8sNodeCondition.toMap(),
+		"k8s.pod.phase":                          args.K8sPodPhase.toMap(),
+		"k8s.pod.status_reason":                  args.K8sPodStatusReason.toMap(),
+		"k8s.replicaset.available":               args.K8sReplicasetAvailable.toMap(),
+		"k8s.replicaset.desired":                 args.K8sReplicasetDesired.toMap(),
+		"k8s.replication_controller.available":   args.K8sReplicationControllerAvailable.toMap(),
+		"k8s.replication_controller.desired":     args.K8sReplicationControllerDesired.toMap(),
+		"k8s.resource_quota.hard_limit":          args.K8sResourceQuotaHardLimit.toMap(),
+		"k8s.resource_quota.used":                args.K8sResourceQuotaUsed.toMap(),
+		"k8s.statefulset.current_pods":           args.K8sStatefulsetCurrentPods.toMap(),
+		"k8s.statefulset.desired_pods":           args.K8sStatefulsetDesiredPods.toMap(),
+		"k8s.statefulset.ready_pods":             args.K8sStatefulsetReadyPods.toMap(),
+		"k8s.statefulset.updated_pods":           args.K8sStatefulsetUpdatedPods.toMap(),
+		"openshift.appliedclusterquota.limit":    args.OpenshiftAppliedclusterquotaLimit.toMap(),
+		"openshift.appliedclusterquota.used":     args.OpenshiftAppliedclusterquotaUsed.toMap(),
+		"openshift.clusterquota.limit":           args.OpenshiftClusterquotaLimit.toMap(),
+		"openshift.clusterquota.used":            args.OpenshiftClusterquotaUsed.toMap(),
+	}
+}
+
+// ResourceAttributesArguments configures the resource attributes emitted by otelcol.receiver.k8s_cluster.
+type ResourceAttributesArguments struct {
+	ContainerID                            ResourceAttributeArguments `alloy:"container.id,block,optional"`
+	ContainerImageName                     ResourceAttributeArguments `alloy:"container.image.name,block,optional"`
+	ContainerImageTag                      ResourceAttributeArguments `alloy:"container.image.tag,block,optional"`
+	ContainerRuntime                       ResourceAttributeArguments `alloy:"container.runtime,block,optional"`
+	ContainerRuntimeVersion                ResourceAttributeArguments `alloy:"container.runtime.version,block,optional"`
+	K8sContainerName                       ResourceAttributeArguments `alloy:"k8s.container.name,block,optional"`
+	K8sContainerStatusLastTerminatedReason ResourceAttributeArguments `alloy:"k8s.container.status.last_terminated_reason,block,optional"`
+	K8sCronjobName                         ResourceAttributeArguments `alloy:"k8s.cronjob.name,block,optional"`
+	K8sCronjobUID                          ResourceAttributeArguments `alloy:"k8s.cronjob.uid,block,optional"`
+	K8sDaemonsetName                       ResourceAttributeArguments `alloy:"k8s.daemonset.name,block,optional"`
+	K8sDaemonsetUID                        ResourceAttributeArguments `alloy:"k8s.daemonset.uid,block,optional"`
+	K8sDeploymentName                      ResourceAttributeArguments `alloy:"k8s.deployment.name,block,optional"`
+	K8sDeploymentUID                       ResourceAttributeArguments `alloy:"k8s.deployment.uid,block,optional"`
+	K8sHPAName                             ResourceAttributeArguments `alloy:"k8s.hpa.name,block,optional"`
+	K8sHPAScaletargetrefApiversion         ResourceAttributeArguments `alloy:"k8s.hpa.scaletargetref.apiversion,block,optional"`
+	K8sHPAScaletargetrefKind               ResourceAttributeArguments `alloy:"k8s.hpa.scaletargetref.kind,block,optional"`
+	K8sHPAScaletargetrefName               ResourceAttributeArguments `alloy:"k8s.hpa.scaletargetref.name,block,optional"`
+	K8sHPAUID                              ResourceAttributeArguments `alloy:"k8s.hpa.uid,block,optional"`
+	K8sJobName                             ResourceAttributeArguments `alloy:"k8s.job.name,block,optional"`
+	K8sJobUID                              ResourceAttributeArguments `alloy:"k8s.job.uid,block,optional"`
+	K8sKubeletVersion                      ResourceAttributeArguments `alloy:"k8s.kubelet.version,block,optional"`
+	K8sNamespaceName                       ResourceAttributeArguments `alloy:"k8s.namespace.name,block,optional"`
+	K8sNamespaceUID                        ResourceAttributeArguments `alloy:"k8s.namespace.uid,block,optional"`
+	K8sNodeName                            ResourceAttributeArguments `alloy:"k8s.node.name,block,optional"`
+	K8sNodeUID                             ResourceAttributeArguments `alloy:"k8s.node.uid,block,optional"`
+	K8sPodName                             ResourceAttributeArguments `alloy:"k8s.pod.name,block,optional"`
+	K8sPodQosClass                         ResourceAttributeArguments `alloy:"k8s.pod.qos_class,block,optional"`
+	K8sPodUID                              ResourceAttributeArguments `alloy:"k8s.pod.uid,block,optional"`
+	K8sReplicasetName                      ResourceAttributeArguments `alloy:"k8s.replicaset.name,block,optional"`
+	K8sReplicasetUID                       ResourceAttributeArguments `alloy:"k8s.replicaset.uid,block,optional"`
+	K8sReplicationcontrollerName           ResourceAttributeArguments `alloy:"k8s.replicationcontroller.name,block,optional"`
+	K8sReplicationcontrollerUID            ResourceAttributeArguments `alloy:"k8s.replicationcontroller.uid,block,optional"`
+	K8sResourcequotaName                   ResourceAttributeArguments `alloy:"k8s.resourcequota.name,block,optional"`
+	K8sResourcequotaUID                    ResourceAttributeArguments `alloy:"k8s.resourcequota.uid,block,optional"`
+	K8sStatefulsetName                     ResourceAttributeArguments `alloy:"k8s.statefulset.name,block,optional"`
+	K8sStatefulsetUID                      ResourceAttributeArguments `alloy:"k8s.statefulset.uid,block,optional"`
+	OpenshiftClusterquotaName              ResourceAttributeArguments `alloy:"openshift.clusterquota.name,block,optional"`
+	OpenshiftClusterquotaUID               ResourceAttributeArguments `alloy:"openshift.clusterquota.uid,block,optional"`
+	OSDescription                          ResourceAttributeArguments `alloy:"os.description,block,optional"`
+	OSType                                 ResourceAttributeArguments `alloy:"os.type,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ResourceAttributesArguments) SetToDefault() {
+	*args = ResourceAttributesArguments{
+		ContainerID:                            ResourceAttributeArguments{Enabled: true},
+		ContainerImageName:                     ResourceAttributeArguments{Enabled: true},
+		ContainerImageTag:                      ResourceAttributeArguments{Enabled: true},
+		ContainerRuntime:                       ResourceAttributeArguments{Enabled: false},
+		ContainerRuntimeVersion:                ResourceAttributeArguments{Enabled: false},
+		K8sContainerName:                       ResourceAttributeArguments{Enabled: true},
+		K8sContainerStatusLastTerminatedReason: ResourceAttributeArguments{Enabled: false},
+		K8sCronjobName:                         ResourceAttributeArguments{Enabled: true},
+		K8sCronjobUID:                          ResourceAttributeArguments{Enabled: true},
+		K8sDaemonsetName:                       ResourceAttributeArguments{Enabled: true},
+		K8sDaemonsetUID:                        ResourceAttributeArguments{Enabled: true},
+		K8sDeploymentName:                      ResourceAttributeArguments{Enabled: true},
+		K8sDeploymentUID:                       ResourceAttributeArguments{Enabled: true},
+		K8sHPAName:                             ResourceAttributeArguments{Enabled: true},
+		K8sHPAScaletargetrefApiversion:         ResourceAttributeArguments{Enabled: false},
+		K8sHPAScaletargetrefKind:               ResourceAttributeArguments{Enabled: false},
+		K8sHPAScaletargetrefName:               ResourceAttributeArguments{Enabled: false},
+		K8sHPAUID:                              ResourceAttributeArguments{Enabled: true},
+		K8sJobName:                             ResourceAttributeArguments{Enabled: true},
+		K8sJobUID:                              ResourceAttributeArguments{Enabled: true},
+		K8sKubeletVersion:                      ResourceAttributeArguments{Enabled: false},
+		K8sNamespaceName:                       ResourceAttributeArguments{Enabled: true},
+		K8sNamespaceUID:                        ResourceAttributeArguments{Enabled: true},
+		K8sNodeName:                            ResourceAttributeArguments{Enabled: true},
+		K8sNodeUID:                             ResourceAttributeArguments{Enabled: true},
+		K8sPodName:                             ResourceAttributeArguments{Enabled: true},
+		K8sPodQosClass:                         ResourceAttributeArguments{Enabled: false},
+		K8sPodUID:                              ResourceAttributeArguments{Enabled: true},
+		K8sReplicasetName:                      ResourceAttributeArguments{Enabled: true},
+		K8sReplicasetUID:                       ResourceAttributeArguments{Enabled: true},
+		K8sReplicationcontrollerName:           ResourceAttributeArguments{Enabled: true},
+		K8sReplicationcontrollerUID:            ResourceAttributeArguments{Enabled: true},
+		K8sResourcequotaName:                   ResourceAttributeArguments{Enabled: true},
+		K8sResourcequotaUID:                    ResourceAttributeArguments{Enabled: true},
+		K8sStatefulsetName:                     ResourceAttributeArguments{Enabled: true},
+		K8sStatefulsetUID:                      ResourceAttributeArguments{Enabled: true},
+		OpenshiftClusterquotaName:              ResourceAttributeArguments{Enabled: true},
+		OpenshiftClusterquotaUID:               ResourceAttributeArguments{Enabled: true},
+		OSDescription:                          ResourceAttributeArguments{Enabled: false},
+		OSType:                                 ResourceAttributeArguments{Enabled: false},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *ResourceAttributesArguments) toMap() map[string]any {
+	return map[string]any{
+		"container.id":                                args.ContainerID.toMap(),
+		"container.image.name":                        args.ContainerImageName.toMap(),
+		"container.image.tag":                         args.ContainerImageTag.toMap(),
+		"container.runtime":                           args.ContainerRuntime.toMap(),
+		"container.runtime.version":                   args.ContainerRuntimeVersion.toMap(),
+		"k8s.container.name":                          args.K8sContainerName.toMap(),
+		"k8s.container.status.last_terminated_reason": args.K8sContainerStatusLastTerminatedReason.toMap(),
+		"k8s.cronjob.name":                            args.K8sCronjobName.toMap(),
+		"k8s.cronjob.uid":                             args.K8sCronjobUID.toMap(),
+		"k8s.daemonset.name":                          args.K8sDaemonsetName.toMap(),
+		"k8s.daemonset.uid":                           args.K8sDaemonsetUID.toMap(),
+		"k8s.deployment.name":                         args.K8sDeploymentName.toMap(),
+		"k8s.deployment.uid":                          args.K8sDeploymentUID.toMap(),
+		"k8s.hpa.name":                                args.K8sHPAName.toMap(),
+		"k8s.hpa.scaletargetref.apiversion":           args.K8sHPAScaletargetrefApiversion.toMap(),
+		"k8s.hpa.scaletargetref.kind":                 args.K8sHPAScaletargetrefKind.toMap(),
+		"k8s.hpa.scaletargetref.name":                 args.K8sHPAScaletargetrefName.toMap(),
+		"k8s.hpa.uid":                                 args.K8sHPAUID.toMap(),
+		"k8s.job.name":                                args.K8sJobName.toMap(),
+		"k8s.job.uid":                                 args.K8sJobUID.toMap(),
+		"k8s.kubelet.version":                         args.K8sKubeletVersion.toMap(),
+		"k8s.namespace.name":                          args.K8sNamespaceName.toMap(),
+		"k8s.namespace.uid":                           args.K8sNamespaceUID.toMap(),
+		"k8s.node.name":                               args.K8sNodeName.toMap(),
+		"k8s.node.uid":                                args.K8sNodeUID.toMap(),
+		"k8s.pod.name":                                args.K8sPodName.toMap(),
+		"k8s.pod.qos_class":                           args.K8sPodQosClass.toMap(),
+		"k8s.pod.uid":                                 args.K8sPodUID.toMap(),
+		"k8s.replicaset.name":                         args.K8sReplicasetName.toMap(),
+		"k8s.replicaset.uid":                          args.K8sReplicasetUID.toMap(),
+		"k8s.replicationcontroller.name":              args.K8sReplicationcontrollerName.toMap(),
+		"k8s.replicationcontroller.uid":               args.K8sReplicationcontrollerUID.toMap(),
+		"k8s.resourcequota.name":                      args.K8sResourcequotaName.toMap(),
+		"k8s.resourcequota.uid":                       args.K8sResourcequotaUID.toMap(),
+		"k8s.statefulset.name":                        args.K8sStatefulsetName.toMap(),
+		"k8s.statefulset.uid":                         args.K8sStatefulsetUID.toMap(),
+		"openshift.clusterquota.name":                 args.OpenshiftClusterquotaName.toMap(),
+		"openshift.clusterquota.uid":                  args.OpenshiftClusterquotaUID.toMap(),
+		"os.description":                              args.OSDescription.toMap(),
+		"os.type":                                     args.OSType.toMap(),
+	}
+}
+
+// MetricArguments configures whether a metric is emitted.
+type MetricArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *MetricArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
+
+// ResourceAttributeArguments configures whether a resource attribute is
+// emitted.
+type ResourceAttributeArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *ResourceAttributeArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
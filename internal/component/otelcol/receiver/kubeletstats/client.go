@@ -0,0 +1,31 @@
+package kubeletstats
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// kubeletClient sends requests to the kubelet API at baseURL.
+type kubeletClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+// Get implements kubeletstatsreceiver.Client.
+func (c *kubeletClient) Get(path string) ([]byte, error) {
+	resp, err := c.client.Get(c.baseURL + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response to GET %s: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %s: %s", path, resp.Status, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kubeletstatsreceiver is a copy of v0.134.0 of
+// opentelemetry-collector-contrib/receiver/kubeletstatsreceiver.
+//
+// The upstream receiver creates its kubelet and Kubernetes clients from an
+// auth_type setting. This copy takes the functions which create the clients
+// in its Config instead, so that otelcol.receiver.kubeletstats connects to
+// the Kubernetes API with the same client settings as the other Kubernetes
+// components.
+//
+// The receiver.kubeletstats.enableCPUUsageMetrics feature gate is stable
+// upstream, so it isn't registered again and is always enabled.
+package kubeletstatsreceiver
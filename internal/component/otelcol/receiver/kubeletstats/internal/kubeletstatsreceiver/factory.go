@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletstatsreceiver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats/internal/kubeletstatsreceiver/internal/kubelet"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats/internal/kubeletstatsreceiver/internal/metadata"
+)
+
+const (
+	metricGroupsConfig = "metric_groups"
+)
+
+var defaultMetricGroups = []kubelet.MetricGroup{
+	kubelet.ContainerMetricGroup,
+	kubelet.PodMetricGroup,
+	kubelet.NodeMetricGroup,
+}
+
+// NewFactory creates a factory for kubeletstats receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	scs := scraperhelper.NewDefaultControllerConfig()
+	scs.CollectionInterval = 10 * time.Second
+
+	return &Config{
+		ControllerConfig:     scs,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.Settings,
+	baseCfg component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := baseCfg.(*Config)
+	rOptions, err := cfg.getReceiverOptions()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := restClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	scrp, err := newKubeletScraper(rest, set, rOptions, cfg.MetricsBuilderConfig, cfg.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewMetricsController(&cfg.ControllerConfig, set, consumer, scraperhelper.AddScraper(metadata.Type, scrp))
+}
+
+func restClient(cfg *Config) (kubelet.RestClient, error) {
+	if cfg.MakeClient == nil {
+		return nil, errors.New("no kubelet client configured")
+	}
+	client, err := cfg.MakeClient()
+	if err != nil {
+		return nil, err
+	}
+	rest := kubelet.NewRestClient(client)
+	return rest, nil
+}
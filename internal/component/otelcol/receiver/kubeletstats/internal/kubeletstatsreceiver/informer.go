@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletstatsreceiver
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// newNodeSharedInformer is a copy of NewNodeSharedInformer from v0.134.0 of
+// opentelemetry-collector-contrib/internal/k8sconfig.
+func newNodeSharedInformer(client kubernetes.Interface, nodeName string, watchSyncPeriod time.Duration) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				if nodeName != "" {
+					opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodeName).String()
+				}
+				return client.CoreV1().Nodes().List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				if nodeName != "" {
+					opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodeName).String()
+				}
+				return client.CoreV1().Nodes().Watch(context.Background(), opts)
+			},
+		},
+		&v1.Node{},
+		watchSyncPeriod,
+	)
+	return informer
+}
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats/internal/kubeletstatsreceiver/internal/metadata"
+)
+
+func addFilesystemMetrics(mb *metadata.MetricsBuilder, filesystemMetrics metadata.FilesystemMetrics, s *stats.FsStats, currentTime pcommon.Timestamp) {
+	if s == nil {
+		return
+	}
+
+	recordIntDataPoint(mb, filesystemMetrics.Available, s.AvailableBytes, currentTime)
+	recordIntDataPoint(mb, filesystemMetrics.Capacity, s.CapacityBytes, currentTime)
+	recordIntDataPoint(mb, filesystemMetrics.Usage, s.UsedBytes, currentTime)
+}
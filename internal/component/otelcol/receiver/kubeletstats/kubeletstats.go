@@ -0,0 +1,253 @@
+// Package kubeletstats provides an otelcol.receiver.kubeletstats component.
+package kubeletstats
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/alloy/internal/component"
+	commoncfg "github.com/grafana/alloy/internal/component/common/config"
+	commonk8s "github.com/grafana/alloy/internal/component/common/kubernetes"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats/internal/kubeletstatsreceiver"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/useragent"
+	"github.com/grafana/alloy/syntax"
+	promconfig "github.com/prometheus/common/config"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pipeline"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.receiver.kubeletstats",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := kubeletstatsreceiver.NewFactory()
+			return receiver.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Supported values for the metric_groups argument.
+const (
+	MetricGroupContainer = "container"
+	MetricGroupPod       = "pod"
+	MetricGroupNode      = "node"
+	MetricGroupVolume    = "volume"
+)
+
+// Supported values for the extra_metadata_labels argument.
+const (
+	MetadataLabelContainerID = "container.id"
+	MetadataLabelVolumeType  = "k8s.volume.type"
+)
+
+// Arguments configures the otelcol.receiver.kubeletstats component.
+type Arguments struct {
+	ScraperControllerArguments otelcol.ScraperControllerArguments `alloy:",squash"`
+
+	// Endpoint is the URL of the kubelet API. When it's empty, the kubelet
+	// is reached through the proxy of the Kubernetes API server instead.
+	Endpoint            string   `alloy:"endpoint,attr,optional"`
+	ExtraMetadataLabels []string `alloy:"extra_metadata_labels,attr,optional"`
+	MetricGroups        []string `alloy:"metric_groups,attr,optional"`
+	Node                string   `alloy:"node,attr,optional"`
+
+	// HTTPClientConfig configures the requests to Endpoint.
+	HTTPClientConfig commoncfg.HTTPClientConfig `alloy:",squash"`
+
+	// Client settings to connect to the Kubernetes API server.
+	Client                      *commonk8s.ClientArguments  `alloy:"client,block,optional"`
+	CollectAllNetworkInterfaces NetworkInterfacesArguments  `alloy:"collect_all_network_interfaces,block,optional"`
+	Metrics                     MetricsArguments            `alloy:"metrics,block,optional"`
+	ResourceAttributes          ResourceAttributesArguments `alloy:"resource_attributes,block,optional"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+
+	// Output configures where to send received data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+}
+
+// NetworkInterfacesArguments configures whether network metrics are collected
+// for all the network interfaces instead of only the default one.
+type NetworkInterfacesArguments struct {
+	Pod  bool `alloy:"pod,attr,optional"`
+	Node bool `alloy:"node,attr,optional"`
+}
+
+var (
+	_ receiver.Arguments = Arguments{}
+	_ syntax.Defaulter   = (*Arguments)(nil)
+	_ syntax.Validator   = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		ScraperControllerArguments: otelcol.DefaultScraperControllerArguments,
+		HTTPClientConfig:           commoncfg.DefaultHTTPClientConfig,
+		MetricGroups:               []string{MetricGroupContainer, MetricGroupPod, MetricGroupNode},
+	}
+	args.ScraperControllerArguments.CollectionInterval = 10 * time.Second
+	args.Metrics.SetToDefault()
+	args.ResourceAttributes.SetToDefault()
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+
+	if err := args.ScraperControllerArguments.Validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if args.Endpoint != "" {
+		if u, err := url.Parse(args.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = errors.Join(errs, fmt.Errorf("endpoint must be an http or https URL, got %q", args.Endpoint))
+		}
+	} else {
+		if args.Client == nil {
+			errs = errors.Join(errs, errors.New("one of endpoint or the client block must be set"))
+		}
+		if args.Node == "" {
+			errs = errors.Join(errs, errors.New("node must be set to reach the kubelet through the Kubernetes API server"))
+		}
+		if !reflect.DeepEqual(args.HTTPClientConfig, commoncfg.DefaultHTTPClientConfig) {
+			errs = errors.Join(errs, errors.New("custom HTTP client configuration is only allowed when endpoint is set"))
+		}
+	}
+	// We must explicitly Validate because HTTPClientConfig is squashed and it won't run otherwise
+	if err := args.HTTPClientConfig.Validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if args.Client == nil && args.Metrics.usesNodeCapacity() {
+		errs = errors.Join(errs, errors.New("the client block must be set to collect metrics relative to the capacity of the node"))
+	}
+	for _, group := range args.MetricGroups {
+		switch group {
+		case MetricGroupContainer, MetricGroupPod, MetricGroupNode, MetricGroupVolume:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("invalid metric group %q", group))
+		}
+	}
+	for _, label := range args.ExtraMetadataLabels {
+		switch label {
+		case MetadataLabelContainerID, MetadataLabelVolumeType:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("invalid extra metadata label %q", label))
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+
+	cfg, err := args.Convert()
+	if err != nil {
+		return err
+	}
+	return cfg.(*kubeletstatsreceiver.Config).Validate()
+}
+
+// Convert implements receiver.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	cfg := kubeletstatsreceiver.NewFactory().CreateDefaultConfig().(*kubeletstatsreceiver.Config)
+
+	// The metrics configuration is internal to the receiver, so it can only
+	// be set by unmarshaling it.
+	input := map[string]any{
+		"metric_groups": append([]string{}, args.MetricGroups...),
+		"node":          args.Node,
+		"collect_all_network_interfaces": map[string]any{
+			"pod":  args.CollectAllNetworkInterfaces.Pod,
+			"node": args.CollectAllNetworkInterfaces.Node,
+		},
+		"metrics":             args.Metrics.toMap(),
+		"resource_attributes": args.ResourceAttributes.toMap(),
+	}
+	if len(args.ExtraMetadataLabels) > 0 {
+		input["extra_metadata_labels"] = append([]string(nil), args.ExtraMetadataLabels...)
+	}
+
+	if err := cfg.Unmarshal(confmap.NewFromStringMap(input)); err != nil {
+		return nil, err
+	}
+
+	cfg.ControllerConfig = *args.ScraperControllerArguments.Convert()
+	cfg.MakeClient = args.makeKubeletClient
+	if args.Client != nil {
+		cfg.MakeK8sAPIClient = func() (kubernetes.Interface, error) {
+			restConfig, err := args.Client.BuildRESTConfig(log.NewNopLogger())
+			if err != nil {
+				return nil, err
+			}
+			return kubernetes.NewForConfig(restConfig)
+		}
+	}
+
+	return cfg, nil
+}
+
+// makeKubeletClient creates the client of the kubelet API. It sends requests
+// to the endpoint if it's set, and to the proxy of the Kubernetes API server
+// for the node otherwise.
+func (args Arguments) makeKubeletClient() (kubeletstatsreceiver.Client, error) {
+	if args.Endpoint != "" {
+		client, err := promconfig.NewClientFromConfig(
+			*args.HTTPClientConfig.Convert(),
+			"otelcol.receiver.kubeletstats",
+			promconfig.WithUserAgent(useragent.Get()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &kubeletClient{client: client, baseURL: strings.TrimSuffix(args.Endpoint, "/")}, nil
+	}
+
+	restConfig, err := args.Client.BuildRESTConfig(log.NewNopLogger())
+	if err != nil {
+		return nil, err
+	}
+	client, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	baseURL, err := url.JoinPath(restConfig.Host, "api/v1/nodes", args.Node, "proxy")
+	if err != nil {
+		return nil, err
+	}
+	return &kubeletClient{client: client, baseURL: baseURL}, nil
+}
+
+// Extensions implements receiver.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements receiver.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements receiver.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements receiver.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
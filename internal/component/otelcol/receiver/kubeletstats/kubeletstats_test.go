@@ -0,0 +1,318 @@
+package kubeletstats_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats/internal/kubeletstatsreceiver"
+	"github.com/grafana/alloy/syntax"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestArguments_Defaults(t *testing.T) {
+	var args kubeletstats.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`
+		endpoint = "https://node-1:10250"
+		output {}
+	`), &args))
+
+	out, err := args.Convert()
+	require.NoError(t, err)
+
+	// The defaults must match the ones of the receiver, once the default
+	// metric groups are applied by its unmarshaler.
+	expected := kubeletstatsreceiver.NewFactory().CreateDefaultConfig().(*kubeletstatsreceiver.Config)
+	require.NoError(t, expected.Unmarshal(confmap.New()))
+	require.Equal(t, encode(t, expected), encode(t, out))
+}
+
+func TestArguments_UnmarshalAlloy(t *testing.T) {
+	in := `
+		collection_interval   = "30s"
+		endpoint              = "https://node-1:10250"
+		bearer_token_file     = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+		extra_metadata_labels = ["container.id", "k8s.volume.type"]
+		metric_groups         = ["node", "volume"]
+		node                  = "worker-1"
+
+		tls_config {
+			insecure_skip_verify = true
+		}
+
+		client {
+			api_server = "https://kubernetes.example.com:6443"
+		}
+
+		collect_all_network_interfaces {
+			pod = true
+		}
+
+		metrics {
+			k8s.container.cpu.node.utilization {
+				enabled = true
+			}
+			k8s.pod.uptime {
+				enabled = false
+			}
+		}
+
+		resource_attributes {
+			k8s.volume.type {
+				enabled = false
+			}
+		}
+
+		output {}
+	`
+
+	var args kubeletstats.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(in), &args))
+
+	outAny, err := args.Convert()
+	require.NoError(t, err)
+	out := outAny.(*kubeletstatsreceiver.Config)
+
+	require.Equal(t, 30*time.Second, out.CollectionInterval)
+	require.Len(t, out.ExtraMetadataLabels, 2)
+	require.EqualValues(t, "container.id", out.ExtraMetadataLabels[0])
+	require.EqualValues(t, "k8s.volume.type", out.ExtraMetadataLabels[1])
+	require.Len(t, out.MetricGroupsToCollect, 2)
+	require.EqualValues(t, "node", out.MetricGroupsToCollect[0])
+	require.EqualValues(t, "volume", out.MetricGroupsToCollect[1])
+	require.Equal(t, "worker-1", out.NodeName)
+	require.True(t, out.NetworkCollectAllInterfaces.PodMetrics)
+	require.False(t, out.NetworkCollectAllInterfaces.NodeMetrics)
+
+	require.True(t, out.Metrics.K8sContainerCPUNodeUtilization.Enabled)
+	require.False(t, out.Metrics.K8sPodUptime.Enabled)
+	require.True(t, out.Metrics.K8sPodCPUUsage.Enabled)
+	require.False(t, out.ResourceAttributes.K8sVolumeType.Enabled)
+	require.True(t, out.ResourceAttributes.K8sPodName.Enabled)
+
+	// The clients are built from the endpoint and the client block.
+	client, err := out.MakeClient()
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	require.NotNil(t, out.MakeK8sAPIClient)
+	apiClient, err := out.MakeK8sAPIClient()
+	require.NoError(t, err)
+	require.NotNil(t, apiClient)
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      string
+		errorMsg string
+	}{
+		{
+			name: "no endpoint and no client",
+			cfg: `
+				node = "worker-1"
+				output {}
+			`,
+			errorMsg: "one of endpoint or the client block must be set",
+		},
+		{
+			name: "no endpoint and no node",
+			cfg: `
+				client {}
+				output {}
+			`,
+			errorMsg: "node must be set to reach the kubelet through the Kubernetes API server",
+		},
+		{
+			name: "HTTP client settings without endpoint",
+			cfg: `
+				node              = "worker-1"
+				bearer_token_file = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+				client {}
+				output {}
+			`,
+			errorMsg: "custom HTTP client configuration is only allowed when endpoint is set",
+		},
+		{
+			name: "invalid endpoint",
+			cfg: `
+				endpoint = "node-1:10250"
+				output {}
+			`,
+			errorMsg: `endpoint must be an http or https URL, got "node-1:10250"`,
+		},
+		{
+			name: "invalid metric group",
+			cfg: `
+				endpoint      = "https://node-1:10250"
+				metric_groups = ["pod", "cluster"]
+				output {}
+			`,
+			errorMsg: `invalid metric group "cluster"`,
+		},
+		{
+			name: "invalid extra metadata label",
+			cfg: `
+				endpoint              = "https://node-1:10250"
+				extra_metadata_labels = ["k8s.pod.uid"]
+				output {}
+			`,
+			errorMsg: `invalid extra metadata label "k8s.pod.uid"`,
+		},
+		{
+			name: "node utilization without client",
+			cfg: `
+				endpoint = "https://node-1:10250"
+				node     = "worker-1"
+				metrics {
+					k8s.pod.memory.node.utilization {
+						enabled = true
+					}
+				}
+				output {}
+			`,
+			errorMsg: "the client block must be set to collect metrics relative to the capacity of the node",
+		},
+		{
+			name: "node utilization without node",
+			cfg: `
+				endpoint = "https://node-1:10250"
+				client {}
+				metrics {
+					k8s.pod.memory.node.utilization {
+						enabled = true
+					}
+				}
+				output {}
+			`,
+			errorMsg: "for k8s.pod.memory.node.utilization node setting is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args kubeletstats.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	// The fake server serves the kubelet API both directly and through the
+	// proxy of the Kubernetes API server, and requires a bearer token.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/worker-1/proxy")
+		if path != "/stats/summary" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"node": {
+				"nodeName": "worker-1",
+				"memory": {"time": "2025-01-01T00:00:00Z", "workingSetBytes": 1024}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		cfg  string
+	}{
+		{
+			name: "endpoint",
+			cfg: `
+				endpoint     = "` + server.URL + `"
+				bearer_token = "secret-token"
+			`,
+		},
+		{
+			name: "API server proxy",
+			cfg: `
+				node = "worker-1"
+				client {
+					api_server   = "` + server.URL + `"
+					bearer_token = "secret-token"
+				}
+			`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args kubeletstats.Arguments
+			require.NoError(t, syntax.Unmarshal([]byte(tc.cfg+`
+				collection_interval = "100ms"
+				metric_groups       = ["node"]
+				output {}
+			`), &args))
+
+			cfg, err := args.Convert()
+			require.NoError(t, err)
+
+			var (
+				fact = kubeletstatsreceiver.NewFactory()
+				sink = new(consumertest.MetricsSink)
+			)
+			rcv, err := fact.CreateMetrics(t.Context(), receivertest.NewNopSettings(fact.Type()), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, rcv.Start(t.Context(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, rcv.Shutdown(context.Background()))
+			}()
+
+			require.Eventually(t, func() bool {
+				for _, md := range sink.AllMetrics() {
+					if value, ok := nodeMemoryWorkingSet(md, "worker-1"); ok {
+						return value == 1024
+					}
+				}
+				return false
+			}, 10*time.Second, 50*time.Millisecond)
+		})
+	}
+}
+
+// nodeMemoryWorkingSet returns the value of the k8s.node.memory.working_set
+// metric of the node called name in md.
+func nodeMemoryWorkingSet(md pmetric.Metrics, name string) (int64, bool) {
+	for _, rm := range md.ResourceMetrics().All() {
+		nodeName, ok := rm.Resource().Attributes().Get("k8s.node.name")
+		if !ok || nodeName.Str() != name {
+			continue
+		}
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				if m.Name() == "k8s.node.memory.working_set" {
+					return m.Gauge().DataPoints().At(0).IntValue(), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// encode returns the encoded form of cfg. The metrics configuration tracks
+// whether it was set by the user in unexported fields, so the configs can't
+// be compared directly.
+func encode(t *testing.T, cfg otelcomponent.Config) map[string]any {
+	t.Helper()
+
+	conf := confmap.New()
+	require.NoError(t, conf.Marshal(cfg))
+	return conf.ToStringMap()
+}
@@ -0,0 +1,289 @@
+package kubeletstats
+
+// The types in this file mirror the metadata.yaml file of the upstream
+// receiver.
+
+// MetricsArguments configures the metrics emitted by otelcol.receiver.kubeletstats.
+type MetricsArguments struct {
+	ContainerCPUTime                     MetricArguments `alloy:"container.cpu.time,block,optional"`
+	ContainerCPUUsage                    MetricArguments `alloy:"container.cpu.usage,block,optional"`
+	ContainerCPUUtilization              MetricArguments `alloy:"container.cpu.utilization,block,optional"`
+	ContainerFilesystemAvailable         MetricArguments `alloy:"container.filesystem.available,block,optional"`
+	ContainerFilesystemCapacity          MetricArguments `alloy:"container.filesystem.capacity,block,optional"`
+	ContainerFilesystemUsage             MetricArguments `alloy:"container.filesystem.usage,block,optional"`
+	ContainerMemoryAvailable             MetricArguments `alloy:"container.memory.available,block,optional"`
+	ContainerMemoryMajorPageFaults       MetricArguments `alloy:"container.memory.major_page_faults,block,optional"`
+	ContainerMemoryPageFaults            MetricArguments `alloy:"container.memory.page_faults,block,optional"`
+	ContainerMemoryRss                   MetricArguments `alloy:"container.memory.rss,block,optional"`
+	ContainerMemoryUsage                 MetricArguments `alloy:"container.memory.usage,block,optional"`
+	ContainerMemoryWorkingSet            MetricArguments `alloy:"container.memory.working_set,block,optional"`
+	ContainerUptime                      MetricArguments `alloy:"container.uptime,block,optional"`
+	K8sContainerCPUNodeUtilization       MetricArguments `alloy:"k8s.container.cpu.node.utilization,block,optional"`
+	K8sContainerCPULimitUtilization      MetricArguments `alloy:"k8s.container.cpu_limit_utilization,block,optional"`
+	K8sContainerCPURequestUtilization    MetricArguments `alloy:"k8s.container.cpu_request_utilization,block,optional"`
+	K8sContainerMemoryNodeUtilization    MetricArguments `alloy:"k8s.container.memory.node.utilization,block,optional"`
+	K8sContainerMemoryLimitUtilization   MetricArguments `alloy:"k8s.container.memory_limit_utilization,block,optional"`
+	K8sContainerMemoryRequestUtilization MetricArguments `alloy:"k8s.container.memory_request_utilization,block,optional"`
+	K8sNodeCPUTime                       MetricArguments `alloy:"k8s.node.cpu.time,block,optional"`
+	K8sNodeCPUUsage                      MetricArguments `alloy:"k8s.node.cpu.usage,block,optional"`
+	K8sNodeCPUUtilization                MetricArguments `alloy:"k8s.node.cpu.utilization,block,optional"`
+	K8sNodeFilesystemAvailable           MetricArguments `alloy:"k8s.node.filesystem.available,block,optional"`
+	K8sNodeFilesystemCapacity            MetricArguments `alloy:"k8s.node.filesystem.capacity,block,optional"`
+	K8sNodeFilesystemUsage               MetricArguments `alloy:"k8s.node.filesystem.usage,block,optional"`
+	K8sNodeMemoryAvailable               MetricArguments `alloy:"k8s.node.memory.available,block,optional"`
+	K8sNodeMemoryMajorPageFaults         MetricArguments `alloy:"k8s.node.memory.major_page_faults,block,optional"`
+	K8sNodeMemoryPageFaults              MetricArguments `alloy:"k8s.node.memory.page_faults,block,optional"`
+	K8sNodeMemoryRss                     MetricArguments `alloy:"k8s.node.memory.rss,block,optional"`
+	K8sNodeMemoryUsage                   MetricArguments `alloy:"k8s.node.memory.usage,block,optional"`
+	K8sNodeMemoryWorkingSet              MetricArguments `alloy:"k8s.node.memory.working_set,block,optional"`
+	K8sNodeNetworkErrors                 MetricArguments `alloy:"k8s.node.network.errors,block,optional"`
+	K8sNodeNetworkIO                     MetricArguments `alloy:"k8s.node.network.io,block,optional"`
+	K8sNodeUptime                        MetricArguments `alloy:"k8s.node.uptime,block,optional"`
+	K8sPodCPUNodeUtilization             MetricArguments `alloy:"k8s.pod.cpu.node.utilization,block,optional"`
+	K8sPodCPUTime                        MetricArguments `alloy:"k8s.pod.cpu.time,block,optional"`
+	K8sPodCPUUsage                       MetricArguments `alloy:"k8s.pod.cpu.usage,block,optional"`
+	K8sPodCPUUtilization                 MetricArguments `alloy:"k8s.pod.cpu.utilization,block,optional"`
+	K8sPodCPULimitUtilization            MetricArguments `alloy:"k8s.pod.cpu_limit_utilization,block,optional"`
+	K8sPodCPURequestUtilization          MetricArguments `alloy:"k8s.pod.cpu_request_utilization,block,optional"`
+	K8sPodFilesystemAvailable            MetricArguments `alloy:"k8s.pod.filesystem.available,block,optional"`
+	K8sPodFilesystemCapacity             MetricArguments `alloy:"k8s.pod.filesystem.capacity,block,optional"`
+	K8sPodFilesystemUsage                MetricArguments `alloy:"k8s.pod.filesystem.usage,block,optional"`
+	K8sPodMemoryAvailable                MetricArguments `alloy:"k8s.pod.memory.available,block,optional"`
+	K8sPodMemoryMajorPageFaults          MetricArguments `alloy:"k8s.pod.memory.major_page_faults,block,optional"`
+	K8sPodMemoryNodeUtilization          MetricArguments `alloy:"k8s.pod.memory.node.utilization,block,optional"`
+	K8sPodMemoryPageFaults               MetricArguments `alloy:"k8s.pod.memory.page_faults,block,optional"`
+	K8sPodMemoryRss                      MetricArguments `alloy:"k8s.pod.memory.rss,block,optional"`
+	K8sPodMemoryUsage                    MetricArguments `alloy:"k8s.pod.memory.usage,block,optional"`
+	K8sPodMemoryWorkingSet               MetricArguments `alloy:"k8s.pod.memory.working_set,block,optional"`
+	K8sPodMemoryLimitUtilization         MetricArguments `alloy:"k8s.pod.memory_limit_utilization,block,optional"`
+	K8sPodMemoryRequestUtilization       MetricArguments `alloy:"k8s.pod.memory_request_utilization,block,optional"`
+	K8sPodNetworkErrors                  MetricArguments `alloy:"k8s.pod.network.errors,block,optional"`
+	K8sPodNetworkIO                      MetricArguments `alloy:"k8s.pod.network.io,block,optional"`
+	K8sPodUptime                         MetricArguments `alloy:"k8s.pod.uptime,block,optional"`
+	K8sVolumeAvailable                   MetricArguments `alloy:"k8s.volume.available,block,optional"`
+	K8sVolumeCapacity                    MetricArguments `alloy:"k8s.volume.capacity,block,optional"`
+	K8sVolumeInodes                      MetricArguments `alloy:"k8s.volume.inodes,block,optional"`
+	K8sVolumeInodesFree                  MetricArguments `alloy:"k8s.volume.inodes.free,block,optional"`
+	K8sVolumeInodesUsed                  MetricArguments `alloy:"k8s.volume.inodes.used,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *MetricsArguments) SetToDefault() {
+	*args = MetricsArguments{
+		ContainerCPUTime:                     MetricArguments{Enabled: true},
+		ContainerCPUUsage:                    MetricArguments{Enabled: true},
+		ContainerCPUUtilization:              MetricArguments{Enabled: false},
+		ContainerFilesystemAvailable:         MetricArguments{Enabled: true},
+		ContainerFilesystemCapacity:          MetricArguments{Enabled: true},
+		ContainerFilesystemUsage:             MetricArguments{Enabled: true},
+		ContainerMemoryAvailable:             MetricArguments{Enabled: true},
+		ContainerMemoryMajorPageFaults:       MetricArguments{Enabled: true},
+		ContainerMemoryPageFaults:            MetricArguments{Enabled: true},
+		ContainerMemoryRss:                   MetricArguments{Enabled: true},
+		ContainerMemoryUsage:                 MetricArguments{Enabled: true},
+		ContainerMemoryWorkingSet:            MetricArguments{Enabled: true},
+		ContainerUptime:                      MetricArguments{Enabled: false},
+		K8sContainerCPUNodeUtilization:       MetricArguments{Enabled: false},
+		K8sContainerCPULimitUtilization:      MetricArguments{Enabled: false},
+		K8sContainerCPURequestUtilization:    MetricArguments{Enabled: false},
+		K8sContainerMemoryNodeUtilization:    MetricArguments{Enabled: false},
+		K8sContainerMemoryLimitUtilization:   MetricArguments{Enabled: false},
+		K8sContainerMemoryRequestUtilization: MetricArguments{Enabled: false},
+		K8sNodeCPUTime:                       MetricArguments{Enabled: true},
+		K8sNodeCPUUsage:                      MetricArguments{Enabled: true},
+		K8sNodeCPUUtilization:                MetricArguments{Enabled: false},
+		K8sNodeFilesystemAvailable:           MetricArguments{Enabled: true},
+		K8sNodeFilesystemCapacity:            MetricArguments{Enabled: true},
+		K8sNodeFilesystemUsage:               MetricArguments{Enabled: true},
+		K8sNodeMemoryAvailable:               MetricArguments{Enabled: true},
+		K8sNodeMemoryMajorPageFaults:         MetricArguments{Enabled: true},
+		K8sNodeMemoryPageFaults:              MetricArguments{Enabled: true},
+		K8sNodeMemoryRss:                     MetricArguments{Enabled: true},
+		K8sNodeMemoryUsage:                   MetricArguments{Enabled: true},
+		K8sNodeMemoryWorkingSet:              MetricArguments{Enabled: true},
+		K8sNodeNetworkErrors:                 MetricArguments{Enabled: true},
+		K8sNodeNetworkIO:                     MetricArguments{Enabled: true},
+		K8sNodeUptime:                        MetricArguments{Enabled: false},
+		K8sPodCPUNodeUtilization:             MetricArguments{Enabled: false},
+		K8sPodCPUTime:                        MetricArguments{Enabled: true},
+		K8sPodCPUUsage:                       MetricArguments{Enabled: true},
+		K8sPodCPUUtilization:                 MetricArguments{Enabled: false},
+		K8sPodCPULimitUtilization:            MetricArguments{Enabled: false},
+		K8sPodCPURequestUtilization:          MetricArguments{Enabled: false},
+		K8sPodFilesystemAvailable:            MetricArguments{Enabled: true},
+		K8sPodFilesystemCapacity:             MetricArguments{Enabled: true},
+		K8sPodFilesystemUsage:                MetricArguments{Enabled: true},
+		K8sPodMemoryAvailable:                MetricArguments{Enabled: true},
+		K8sPodMemoryMajorPageFaults:          MetricArguments{Enabled: true},
+		K8sPodMemoryNodeUtilization:          MetricArguments{Enabled: false},
+		K8sPodMemoryPageFaults:               MetricArguments{Enabled: true},
+		K8sPodMemoryRss:                      MetricArguments{Enabled: true},
+		K8sPodMemoryUsage:                    MetricArguments{Enabled: true},
+		K8sPodMemoryWorkingSet:               MetricArguments{Enabled: true},
+		K8sPodMemoryLimitUtilization:         MetricArguments{Enabled: false},
+		K8sPodMemoryRequestUtilization:       MetricArguments{Enabled: false},
+		K8sPodNetworkErrors:                  MetricArguments{Enabled: true},
+		K8sPodNetworkIO:                      MetricArguments{Enabled: true},
+		K8sPodUptime:                         MetricArguments{Enabled: false},
+		K8sVolumeAvailable:                   MetricArguments{Enabled: true},
+		K8sVolumeCapacity:                    MetricArguments{Enabled: true},
+		K8sVolumeInodes:                      MetricArguments{Enabled: true},
+		K8sVolumeInodesFree:                  MetricArguments{Enabled: true},
+		K8sVolumeInodesUsed:                  MetricArguments{Enabled: true},
+	}
+}
+
+// usesNodeCapacity returns whether args enables metrics which are relative to
+// the capacity of the node.
+func (args *MetricsArguments) usesNodeCapacity() bool {
+	return args.K8sContainerCPUNodeUtilization.Enabled ||
+		args.K8sContainerMemoryNodeUtilization.Enabled ||
+		args.K8sPodCPUNodeUtilization.Enabled ||
+		args.K8sPodMemoryNodeUtilization.Enabled
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *MetricsArguments) toMap() map[string]any {
+	return map[string]any{
+		"container.cpu.time":                       args.ContainerCPUTime.toMap(),
+		"container.cpu.usage":                      args.ContainerCPUUsage.toMap(),
+		"container.cpu.utilization":                args.ContainerCPUUtilization.toMap(),
+		"container.filesystem.available":           args.ContainerFilesystemAvailable.toMap(),
+		"container.filesystem.capacity":            args.ContainerFilesystemCapacity.toMap(),
+		"container.filesystem.usage":               args.ContainerFilesystemUsage.toMap(),
+		"container.memory.available":               args.ContainerMemoryAvailable.toMap(),
+		"container.memory.major_page_faults":       args.ContainerMemoryMajorPageFaults.toMap(),
+		"container.memory.page_faults":             args.ContainerMemoryPageFaults.toMap(),
+		"container.memory.rss":                     args.ContainerMemoryRss.toMap(),
+		"container.memory.usage":                   args.ContainerMemoryUsage.toMap(),
+		"container.memory.working_set":             args.ContainerMemoryWorkingSet.toMap(),
+		"container.uptime":                         args.ContainerUptime.toMap(),
+		"k8s.container.cpu.node.utilization":       args.K8sContainerCPUNodeUtilization.toMap(),
+		"k8s.container.cpu_limit_utilization":      args.K8sContainerCPULimitUtilization.toMap(),
+		"k8s.container.cpu_request_utilization":    args.K8sContainerCPURequestUtilization.toMap(),
+		"k8s.container.memory.node.utilization":    args.K8sContainerMemoryNodeUtilization.toMap(),
+		"k8s.container.memory_limit_utilization":   args.K8sContainerMemoryLimitUtilization.toMap(),
+		"k8s.container.memory_request_utilization": args.K8sContainerMemoryRequestUtilization.toMap(),
+		"k8s.node.cpu.time":                        args.K8sNodeCPUTime.toMap(),
+		"k8s.node.cpu.usage":                       args.K8sNodeCPUUsage.toMap(),
+		"k8s.node.cpu.utilization":                 args.K8sNodeCPUUtilization.toMap(),
+		"k8s.node.filesystem.available":            args.K8sNodeFilesystemAvailable.toMap(),
+		"k8s.node.filesystem.capacity":             args.K8sNodeFilesystemCapacity.toMap(),
+		"k8s.node.filesystem.usage":                args.K8sNodeFilesystemUsage.toMap(),
+		"k8s.node.memory.available":                args.K8sNodeMemoryAvailable.toMap(),
+		"k8s.node.memory.major_page_faults":        args.K8sNodeMemoryMajorPageFaults.toMap(),
+		"k8s.node.memory.page_faults":              args.K8sNodeMemoryPageFaults.toMap(),
+		"k8s.node.memory.rss":                      args.K8sNodeMemoryRss.toMap(),
+		"k8s.node.memory.usage":                    args.K8sNodeMemoryUsage.toMap(),
+		"k8s.node.memory.working_set":              args.K8sNodeMemoryWorkingSet.toMap(),
+		"k8s.node.network.errors":                  args.K8sNodeNetworkErrors.toMap(),
+		"k8s.node.network.io":                      args.K8sNodeNetworkIO.toMap(),
+		"k8s.node.uptime":                          args.K8sNodeUptime.toMap(),
+		"k8s.pod.cpu.node.utilization":             args.K8sPodCPUNodeUtilization.toMap(),
+		"k8s.pod.cpu.time":                         args.K8sPodCPUTime.toMap(),
+		"k8s.pod.cpu.usage":                        args.K8sPodCPUUsage.toMap(),
+		"k8s.pod.cpu.utilization":                  args.K8sPodCPUUtilization.toMap(),
+		"k8s.pod.cpu_limit_utilization":            args.K8sPodCPULimitUtilization.toMap(),
+		"k8s.pod.cpu_request_utilization":          args.K8sPodCPURequestUtilization.toMap(),
+		"k8s.pod.filesystem.available":             args.K8sPodFilesystemAvailable.toMap(),
+		"k8s.pod.filesystem.capacity":              args.K8sPodFilesystemCapacity.toMap(),
+		"k8s.pod.filesystem.usage":                 args.K8sPodFilesystemUsage.toMap(),
+		"k8s.pod.memory.available":                 args.K8sPodMemoryAvailable.toMap(),
+		"k8s.pod.memory.major_page_faults":         args.K8sPodMemoryMajorPageFaults.toMap(),
+		"k8s.pod.memory.node.utilization":          args.K8sPodMemoryNodeUtilization.toMap(),
+		"k8s.pod.memory.page_faults":               args.K8sPodMemoryPageFaults.toMap(),
+		"k8s.pod.memory.rss":                       args.K8sPodMemoryRss.toMap(),
+		"k8s.pod.memory.usage":                     args.K8sPodMemoryUsage.toMap(),
+		"k8s.pod.memory.working_set":               args.K8sPodMemoryWorkingSet.toMap(),
+		"k8s.pod.memory_limit_utilization":         args.K8sPodMemoryLimitUtilization.toMap(),
+		"k8s.pod.memory_request_utilization":       args.K8sPodMemoryRequestUtilization.toMap(),
+		"k8s.pod.network.errors":                   args.K8sPodNetworkErrors.toMap(),
+		"k8s.pod.network.io":                       args.K8sPodNetworkIO.toMap(),
+		"k8s.pod.uptime":                           args.K8sPodUptime.toMap(),
+		"k8s.volume.available":                     args.K8sVolumeAvailable.toMap(),
+		"k8s.volume.capacity":                      args.K8sVolumeCapacity.toMap(),
+		"k8s.volume.inodes":                        args.K8sVolumeInodes.toMap(),
+		"k8s.volume.inodes.free":                   args.K8sVolumeInodesFree.toMap(),
+		"k8s.volume.inodes.used":                   args.K8sVolumeInodesUsed.toMap(),
+	}
+}
+
+// ResourceAttributesArguments configures the resource attributes emitted by otelcol.receiver.kubeletstats.
+type ResourceAttributesArguments struct {
+	AWSVolumeID                  ResourceAttributeArguments `alloy:"aws.volume.id,block,optional"`
+	ContainerID                  ResourceAttributeArguments `alloy:"container.id,block,optional"`
+	FSType                       ResourceAttributeArguments `alloy:"fs.type,block,optional"`
+	GCEPDName                    ResourceAttributeArguments `alloy:"gce.pd.name,block,optional"`
+	GlusterfsEndpointsName       ResourceAttributeArguments `alloy:"glusterfs.endpoints.name,block,optional"`
+	GlusterfsPath                ResourceAttributeArguments `alloy:"glusterfs.path,block,optional"`
+	K8sContainerName             ResourceAttributeArguments `alloy:"k8s.container.name,block,optional"`
+	K8sNamespaceName             ResourceAttributeArguments `alloy:"k8s.namespace.name,block,optional"`
+	K8sNodeName                  ResourceAttributeArguments `alloy:"k8s.node.name,block,optional"`
+	K8sPersistentvolumeclaimName ResourceAttributeArguments `alloy:"k8s.persistentvolumeclaim.name,block,optional"`
+	K8sPodName                   ResourceAttributeArguments `alloy:"k8s.pod.name,block,optional"`
+	K8sPodUID                    ResourceAttributeArguments `alloy:"k8s.pod.uid,block,optional"`
+	K8sVolumeName                ResourceAttributeArguments `alloy:"k8s.volume.name,block,optional"`
+	K8sVolumeType                ResourceAttributeArguments `alloy:"k8s.volume.type,block,optional"`
+	Partition                    ResourceAttributeArguments `alloy:"partition,block,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *ResourceAttributesArguments) SetToDefault() {
+	*args = ResourceAttributesArguments{
+		AWSVolumeID:                  ResourceAttributeArguments{Enabled: true},
+		ContainerID:                  ResourceAttributeArguments{Enabled: true},
+		FSType:                       ResourceAttributeArguments{Enabled: true},
+		GCEPDName:                    ResourceAttributeArguments{Enabled: true},
+		GlusterfsEndpointsName:       ResourceAttributeArguments{Enabled: true},
+		GlusterfsPath:                ResourceAttributeArguments{Enabled: true},
+		K8sContainerName:             ResourceAttributeArguments{Enabled: true},
+		K8sNamespaceName:             ResourceAttributeArguments{Enabled: true},
+		K8sNodeName:                  ResourceAttributeArguments{Enabled: true},
+		K8sPersistentvolumeclaimName: ResourceAttributeArguments{Enabled: true},
+		K8sPodName:                   ResourceAttributeArguments{Enabled: true},
+		K8sPodUID:                    ResourceAttributeArguments{Enabled: true},
+		K8sVolumeName:                ResourceAttributeArguments{Enabled: true},
+		K8sVolumeType:                ResourceAttributeArguments{Enabled: true},
+		Partition:                    ResourceAttributeArguments{Enabled: true},
+	}
+}
+
+// toMap encodes args to a map for use with confmap.
+func (args *ResourceAttributesArguments) toMap() map[string]any {
+	return map[string]any{
+		"aws.volume.id":                  args.AWSVolumeID.toMap(),
+		"container.id":                   args.ContainerID.toMap(),
+		"fs.type":                        args.FSType.toMap(),
+		"gce.pd.name":                    args.GCEPDName.toMap(),
+		"glusterfs.endpoints.name":       args.GlusterfsEndpointsName.toMap(),
+		"glusterfs.path":                 args.GlusterfsPath.toMap(),
+		"k8s.container.name":             args.K8sContainerName.toMap(),
+		"k8s.namespace.name":             args.K8sNamespaceName.toMap(),
+		"k8s.node.name":                  args.K8sNodeName.toMap(),
+		"k8s.persistentvolumeclaim.name": args.K8sPersistentvolumeclaimName.toMap(),
+		"k8s.pod.name":                   args.K8sPodName.toMap(),
+		"k8s.pod.uid":                    args.K8sPodUID.toMap(),
+		"k8s.volume.name":                args.K8sVolumeName.toMap(),
+		"k8s.volume.type":                args.K8sVolumeType.toMap(),
+		"partition":                      args.Partition.toMap(),
+	}
+}
+
+// MetricArguments configures whether a metric is emitted.
+type MetricArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *MetricArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
+
+// ResourceAttributeArguments configures whether a resource attribute is
+// emitted.
+type ResourceAttributeArguments struct {
+	Enabled bool `alloy:"enabled,attr"`
+}
+
+func (args *ResourceAttributeArguments) toMap() map[string]any {
+	return map[string]any{"enabled": args.Enabled}
+}
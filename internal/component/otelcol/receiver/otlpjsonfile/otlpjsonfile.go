@@ -0,0 +1,221 @@
+// Package otlpjsonfile provides an otelcol.receiver.otlpjsonfile component.
+package otlpjsonfile
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alecthomas/units"
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/filelog"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.receiver.otlpjsonfile",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			fact := otlpjsonfilereceiver.NewFactory()
+			return receiver.New(opts, fact, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.receiver.otlpjsonfile component.
+type Arguments struct {
+	MatchCriteria      filelog.MatchCriteria `alloy:",squash"`
+	PollInterval       time.Duration         `alloy:"poll_interval,attr,optional"`
+	MaxConcurrentFiles int                   `alloy:"max_concurrent_files,attr,optional"`
+	MaxBatches         int                   `alloy:"max_batches,attr,optional"`
+	StartAt            string                `alloy:"start_at,attr,optional"`
+	FingerprintSize    units.Base2Bytes      `alloy:"fingerprint_size,attr,optional"`
+	MaxLogSize         units.Base2Bytes      `alloy:"max_log_size,attr,optional"`
+	DeleteAfterRead    bool                  `alloy:"delete_after_read,attr,optional"`
+	Compression        string                `alloy:"compression,attr,optional"`
+	ReplayFile         bool                  `alloy:"replay_file,attr,optional"`
+	Resolver           filelog.Resolver      `alloy:",squash"`
+
+	// Storage is a binding to an otelcol.storage.* component extension which handles
+	// reading and writing state.
+	Storage *extension.ExtensionHandler `alloy:"storage,attr,optional"`
+
+	// DebugMetrics configures component internal metrics. Optional.
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+
+	// Output configures where to send received data. Required.
+	Output *otelcol.ConsumerArguments `alloy:"output,block"`
+}
+
+var (
+	_ receiver.Arguments = Arguments{}
+	_ syntax.Defaulter   = (*Arguments)(nil)
+	_ syntax.Validator   = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		Output:  &otelcol.ConsumerArguments{},
+		StartAt: "end",
+		Resolver: filelog.Resolver{
+			IncludeFileName: true,
+		},
+		PollInterval:       200 * time.Millisecond,
+		FingerprintSize:    units.KiB,
+		MaxLogSize:         units.MiB,
+		MaxConcurrentFiles: 1024,
+	}
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+
+	if len(args.MatchCriteria.Include) == 0 {
+		errs = errors.Join(errs, errors.New("'include' must not be empty"))
+	}
+
+	if args.MaxConcurrentFiles < 1 {
+		errs = errors.Join(errs, errors.New("'max_concurrent_files' must be positive"))
+	}
+
+	if args.MaxBatches < 0 {
+		errs = errors.Join(errs, errors.New("'max_batches' must not be negative"))
+	}
+
+	if args.StartAt != "beginning" && args.StartAt != "end" {
+		errs = errors.Join(errs, fmt.Errorf("invalid 'start_at': %s", args.StartAt))
+	}
+
+	if args.StartAt == "end" && args.DeleteAfterRead {
+		errs = errors.Join(errs, errors.New("'delete_after_read' cannot be used with 'start_at = end'"))
+	}
+
+	if args.MatchCriteria.OrderingCriteria != nil {
+		if args.MatchCriteria.OrderingCriteria.TopN < 0 {
+			errs = errors.Join(errs, errors.New("'top_n' must not be negative"))
+		}
+
+		for _, s := range args.MatchCriteria.OrderingCriteria.SortBy {
+			if !slices.Contains([]string{"timestamp", "numeric", "lexicographic", "mtime"}, s.SortType) {
+				errs = errors.Join(errs, fmt.Errorf("invalid 'sort_type': %s", s.SortType))
+			}
+		}
+	}
+
+	if args.Compression != "" && args.Compression != "gzip" && args.Compression != "auto" {
+		errs = errors.Join(errs, fmt.Errorf("invalid 'compression' type: %s", args.Compression))
+	}
+
+	if args.PollInterval < 0 {
+		errs = errors.Join(errs, errors.New("'poll_interval' must not be negative"))
+	}
+
+	if args.FingerprintSize < 0 {
+		errs = errors.Join(errs, errors.New("'fingerprint_size' must not be negative"))
+	}
+
+	if args.MaxLogSize < 0 {
+		errs = errors.Join(errs, errors.New("'max_log_size' must not be negative"))
+	}
+
+	if args.MatchCriteria.ExcludeOlderThan < 0 {
+		errs = errors.Join(errs, errors.New("'exclude_older_than' must not be negative"))
+	}
+
+	return errs
+}
+
+// Convert implements receiver.Arguments.
+func (args Arguments) Convert() (otelcomponent.Config, error) {
+	cfg := otlpjsonfilereceiver.NewFactory().CreateDefaultConfig().(*otlpjsonfilereceiver.Config)
+
+	cfg.PollInterval = args.PollInterval
+	cfg.MaxConcurrentFiles = args.MaxConcurrentFiles
+	cfg.MaxBatches = args.MaxBatches
+	cfg.StartAt = args.StartAt
+	cfg.FingerprintSize = helper.ByteSize(args.FingerprintSize)
+	cfg.MaxLogSize = helper.ByteSize(args.MaxLogSize)
+	cfg.DeleteAfterRead = args.DeleteAfterRead
+	cfg.Compression = args.Compression
+	cfg.Resolver = attrs.Resolver(args.Resolver)
+	cfg.ReplayFile = args.ReplayFile
+
+	cfg.Criteria = toCriteria(args.MatchCriteria)
+
+	// Configure storage if args.Storage is set.
+	if args.Storage != nil {
+		if args.Storage.Extension == nil {
+			return nil, fmt.Errorf("missing storage extension")
+		}
+
+		cfg.StorageID = &args.Storage.ID
+	}
+
+	return cfg, nil
+}
+
+func toCriteria(mc filelog.MatchCriteria) matcher.Criteria {
+	criteria := matcher.Criteria{
+		Include:          mc.Include,
+		Exclude:          mc.Exclude,
+		ExcludeOlderThan: mc.ExcludeOlderThan,
+	}
+	if mc.OrderingCriteria != nil {
+		criteria.OrderingCriteria.Regex = mc.OrderingCriteria.Regex
+		criteria.OrderingCriteria.TopN = mc.OrderingCriteria.TopN
+		criteria.OrderingCriteria.GroupBy = mc.OrderingCriteria.GroupBy
+
+		for _, s := range mc.OrderingCriteria.SortBy {
+			criteria.OrderingCriteria.SortBy = append(criteria.OrderingCriteria.SortBy, matcher.Sort{
+				SortType:  s.SortType,
+				RegexKey:  s.RegexKey,
+				Ascending: s.Ascending,
+				Layout:    s.Layout,
+				Location:  s.Location,
+			})
+		}
+	}
+	return criteria
+}
+
+// Extensions implements receiver.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	m := make(map[otelcomponent.ID]otelcomponent.Component)
+	if args.Storage != nil {
+		m[args.Storage.ID] = args.Storage.Extension
+	}
+	return m
+}
+
+// Exporters implements receiver.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// NextConsumers implements receiver.Arguments.
+func (args Arguments) NextConsumers() *otelcol.ConsumerArguments {
+	return args.Output
+}
+
+// DebugMetricsConfig implements receiver.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
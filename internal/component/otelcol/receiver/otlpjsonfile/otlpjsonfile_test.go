@@ -0,0 +1,164 @@
+package otlpjsonfile_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/units"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/internal/fakeconsumer"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/otlpjsonfile"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// Test performs a basic integration test which runs the
+// otelcol.receiver.otlpjsonfile component and ensures that it can read and
+// forward data.
+func Test(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	l := util.TestLogger(t)
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+	line := `{"resourceSpans":[{"scopeSpans":[{"spans":[{"name":"TestSpan"}]}]}]}`
+	require.NoError(t, os.WriteFile(path, []byte(line+"\n"), 0o644))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "otelcol.receiver.otlpjsonfile")
+	require.NoError(t, err)
+
+	cfg := fmt.Sprintf(`
+		include  = [%q]
+		start_at = "beginning"
+
+		output {
+			// no-op: will be overridden by test code.
+		}
+	`, filepath.ToSlash(path))
+
+	var args otlpjsonfile.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	// Override our settings so traces get forwarded to tracesCh.
+	tracesCh := make(chan ptrace.Traces)
+	args.Output = makeTracesOutput(tracesCh)
+
+	go func() {
+		err := ctrl.Run(ctx, args)
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(3*time.Second))
+
+	select {
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "failed waiting for traces")
+	case tr := <-tracesCh:
+		require.Equal(t, 1, tr.SpanCount())
+		require.Equal(t, "TestSpan", tr.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	}
+}
+
+// makeTracesOutput returns ConsumerArguments which will forward traces to the
+// provided channel.
+func makeTracesOutput(ch chan ptrace.Traces) *otelcol.ConsumerArguments {
+	tracesConsumer := fakeconsumer.Consumer{
+		ConsumeTracesFunc: func(ctx context.Context, t ptrace.Traces) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ch <- t:
+				return nil
+			}
+		},
+	}
+
+	return &otelcol.ConsumerArguments{
+		Traces: []otelcol.Consumer{&tracesConsumer},
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	alloyCfg := `
+		include              = ["/var/otlp/*.json"]
+		exclude              = ["/var/otlp/excluded.json"]
+		poll_interval        = "10s"
+		max_concurrent_files = 10
+		start_at             = "beginning"
+		max_log_size         = "10MiB"
+		delete_after_read    = true
+		replay_file          = true
+		include_file_path    = true
+
+		output {}
+	`
+	var args otlpjsonfile.Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(alloyCfg), &args))
+	require.Equal(t, 10*units.MiB, args.MaxLogSize)
+
+	converted, err := args.Convert()
+	require.NoError(t, err)
+
+	cfg := converted.(*otlpjsonfilereceiver.Config)
+	require.Equal(t, []string{"/var/otlp/*.json"}, cfg.Criteria.Include)
+	require.Equal(t, []string{"/var/otlp/excluded.json"}, cfg.Criteria.Exclude)
+	require.Equal(t, 10*time.Second, cfg.PollInterval)
+	require.Equal(t, 10, cfg.MaxConcurrentFiles)
+	require.Equal(t, "beginning", cfg.StartAt)
+	require.Equal(t, helper.ByteSize(10*units.MiB), cfg.MaxLogSize)
+	require.True(t, cfg.DeleteAfterRead)
+	require.True(t, cfg.ReplayFile)
+	require.True(t, cfg.Resolver.IncludeFileName)
+	require.True(t, cfg.Resolver.IncludeFilePath)
+	require.Nil(t, cfg.StorageID)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      string
+		errorMsg string
+	}{
+		{
+			name: "InvalidStartAt",
+			cfg: `
+				include  = ["/var/otlp/*.json"]
+				start_at = "middle"
+				output {}
+			`,
+			errorMsg: "invalid 'start_at': middle",
+		},
+		{
+			name: "DeleteAfterReadAtEnd",
+			cfg: `
+				include           = ["/var/otlp/*.json"]
+				delete_after_read = true
+				output {}
+			`,
+			errorMsg: "'delete_after_read' cannot be used with 'start_at = end'",
+		},
+		{
+			name: "EmptyInclude",
+			cfg: `
+				include = []
+				output {}
+			`,
+			errorMsg: "'include' must not be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args otlpjsonfile.Arguments
+			require.EqualError(t, syntax.Unmarshal([]byte(tc.cfg), &args), tc.errorMsg)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Package storage provides command line utilities for the storage extensions
+// of otelcol components.
+package storage
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// InstallTools installs command line utilities as subcommands of the provided
+// cmd.
+func InstallTools(cmd *cobra.Command) {
+	cmd.AddCommand(
+		listCmd(),
+		inspectCmd(),
+		purgeCmd(),
+	)
+}
+
+func listCmd() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "list [storage path]",
+		Short: "List the clients of a storage extension",
+		Long: `list reads the data of an otelcol.storage.file or otelcol.storage.sqlite
+component and lists the clients which wrote data to it, along with the
+number of batches in their persistent sending queue, if any.
+
+The storage path is the directory of the component, or the database file of
+an otelcol.storage.sqlite component.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			store := openStore(args[0], timeout, true)
+			defer store.Close()
+
+			clients, err := store.Clients()
+			if err != nil {
+				fmt.Printf("failed to list clients: %v\n", err)
+				os.Exit(1)
+			}
+
+			table := tablewriter.NewWriter(os.Stdout)
+			defer table.Render()
+
+			table.SetHeader([]string{"Client", "Keys", "Bytes", "Queued Batches"})
+
+			for _, client := range clients {
+				summary, err := Summarize(store, client)
+				if err != nil {
+					fmt.Printf("failed to read client %s: %v\n", client, err)
+					os.Exit(1)
+				}
+
+				batches := "-"
+				if summary.Queue != nil {
+					batches = strconv.Itoa(summary.Queue.Batches())
+				}
+				table.Append([]string{client, strconv.Itoa(summary.Keys), strconv.Itoa(summary.Bytes), batches})
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Second, "how long to wait for the storage to be unlocked")
+	return cmd
+}
+
+func inspectCmd() *cobra.Command {
+	var (
+		timeout time.Duration
+		index   int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inspect [storage path] [client]",
+		Short: "Inspect the persistent sending queue of a client",
+		Long: `inspect reads the persistent sending queue written by a client of an
+otelcol.storage.file or otelcol.storage.sqlite component, and lists the
+batches it holds.
+
+Pass --batch to print the content of a batch as OTLP JSON.
+
+Examples:
+
+List the batches queued by the traces queue of otelcol.exporter.otlp.default:
+
+inspect /var/lib/alloy/data/otelcol.storage.file.default exporter_otlp_otelcol.exporter.otlp.default_traces
+
+
+Print the content of the batch with index 42:
+
+inspect --batch 42 /var/lib/alloy/data/otelcol.storage.file.default exporter_otlp_otelcol.exporter.otlp.default_traces
+`,
+		Args: cobra.ExactArgs(2),
+		Run: func(_ *cobra.Command, args []string) {
+			client := args[1]
+
+			store := openStore(args[0], timeout, true)
+			defer store.Close()
+
+			summary, err := Summarize(store, client)
+			if err != nil {
+				fmt.Printf("failed to read client: %v\n", err)
+				os.Exit(1)
+			} else if summary.Queue == nil {
+				fmt.Printf("client %s doesn't hold a persistent sending queue\n", client)
+				os.Exit(1)
+			}
+
+			batches, err := QueuedBatches(store, client)
+			if err != nil {
+				fmt.Printf("failed to read queue: %v\n", err)
+				os.Exit(1)
+			}
+
+			if index >= 0 {
+				for _, batch := range batches {
+					if batch.Index != uint64(index) {
+						continue
+					}
+					buf, err := batch.JSON(client)
+					if err != nil {
+						fmt.Printf("failed to decode batch: %v\n", err)
+						os.Exit(1)
+					}
+					fmt.Println(string(buf))
+					return
+				}
+				fmt.Printf("batch %d not found\n", index)
+				os.Exit(1)
+			}
+
+			md := summary.Queue
+			fmt.Printf("Read Index:         %d\n", md.ReadIndex)
+			fmt.Printf("Write Index:        %d\n", md.WriteIndex)
+			fmt.Printf("Queued Batches:     %d\n", md.Batches())
+			if md.ItemsSize >= 0 {
+				fmt.Printf("Queued Items:       %d\n", md.ItemsSize)
+				fmt.Printf("Queued Bytes:       %d\n", md.BytesSize)
+			}
+			fmt.Printf("\n")
+
+			table := tablewriter.NewWriter(os.Stdout)
+			defer table.Render()
+
+			table.SetHeader([]string{"Batch", "State", "Bytes", "Items"})
+
+			for _, batch := range batches {
+				items := "-"
+				if batch.Items >= 0 {
+					items = strconv.Itoa(batch.Items)
+				}
+				table.Append([]string{strconv.FormatUint(batch.Index, 10), batch.State, strconv.Itoa(batch.Bytes), items})
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Second, "how long to wait for the storage to be unlocked")
+	cmd.Flags().Int64Var(&index, "batch", -1, "index of a batch to print as OTLP JSON")
+	return cmd
+}
+
+func purgeCmd() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "purge [storage path] [client]",
+		Short: "Delete the data of a client",
+		Long: `purge deletes all the data written by a client of an otelcol.storage.file or
+otelcol.storage.sqlite component. For a persistent sending queue, the queued
+batches are dropped and the queue starts empty the next time it's used.
+
+The storage must not be in use by a running Alloy instance.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(_ *cobra.Command, args []string) {
+			client := args[1]
+
+			store := openStore(args[0], timeout, false)
+			defer store.Close()
+
+			deleted, err := store.Purge(client)
+			if err != nil {
+				fmt.Printf("failed to purge client: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Deleted %d keys from %s.\n", deleted, client)
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Second, "how long to wait for the storage to be unlocked")
+	return cmd
+}
+
+func openStore(path string, timeout time.Duration, readOnly bool) Store {
+	store, err := Open(path, timeout, readOnly)
+	if err != nil {
+		fmt.Printf("failed to open storage: %v\n", err)
+		os.Exit(1)
+	}
+	return store
+}
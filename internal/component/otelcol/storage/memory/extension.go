@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+// memoryStorage is a storage extension which keeps data in memory. Data
+// survives clients being closed and reopened, but not the extension being
+// shut down.
+type memoryStorage struct {
+	mut     sync.Mutex
+	clients map[string]*client
+}
+
+var _ storage.Extension = (*memoryStorage)(nil)
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{clients: make(map[string]*client)}
+}
+
+// Start implements component.Component.
+func (ms *memoryStorage) Start(context.Context, component.Host) error {
+	return nil
+}
+
+// Shutdown implements component.Component.
+func (ms *memoryStorage) Shutdown(context.Context) error {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	ms.clients = make(map[string]*client)
+	return nil
+}
+
+// GetClient implements storage.Extension.
+func (ms *memoryStorage) GetClient(_ context.Context, kind component.Kind, id component.ID, name string) (storage.Client, error) {
+	key := fmt.Sprintf("%s_%s_%s", kind, id.Type(), id.Name())
+	if name != "" {
+		key += "_" + name
+	}
+
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	c, ok := ms.clients[key]
+	if !ok {
+		c = &client{data: make(map[string][]byte)}
+		ms.clients[key] = c
+	}
+	return c, nil
+}
+
+type client struct {
+	mut  sync.Mutex
+	data map[string][]byte
+}
+
+var _ storage.Client = (*client)(nil)
+
+// Get implements storage.Client.
+func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set implements storage.Client.
+func (c *client) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete implements storage.Client.
+func (c *client) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch implements storage.Client.
+func (c *client) Batch(_ context.Context, ops ...*storage.Operation) error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = cloneBytes(c.data[op.Key])
+		case storage.Set:
+			c.data[op.Key] = cloneBytes(op.Value)
+		case storage.Delete:
+			delete(c.data, op.Key)
+		default:
+			return fmt.Errorf("unsupported operation type %d", op.Type)
+		}
+	}
+	return nil
+}
+
+// Close implements storage.Client.
+func (c *client) Close(context.Context) error {
+	return nil
+}
+
+// cloneBytes copies b so that callers can't modify the stored data.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+const (
+	typeStr = "memory_storage"
+)
+
+// Config configures the memory storage extension.
+type Config struct{}
+
+// NewFactory creates a factory for the memory storage extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		component.MustNewType(typeStr),
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelDevelopment,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+func createExtension(_ context.Context, _ extension.Settings, _ component.Config) (extension.Extension, error) {
+	return newMemoryStorage(), nil
+}
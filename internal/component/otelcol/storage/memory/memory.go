@@ -0,0 +1,66 @@
+// Package memory provides an otelcol.storage.memory component.
+package memory
+
+import (
+	"github.com/grafana/alloy/internal/component"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.storage.memory",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   extension.Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return extension.New(opts, NewFactory(), args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.storage.memory component.
+type Arguments struct {
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+var (
+	_ extension.Arguments = Arguments{}
+	_ syntax.Defaulter    = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{}
+	args.DebugMetrics.SetToDefault()
+}
+
+// Convert implements extension.Arguments.
+func (args Arguments) Convert(component.Options) (otelcomponent.Config, error) {
+	return &Config{}, nil
+}
+
+// ExportsHandler implements extension.Arguments.
+func (args Arguments) ExportsHandler() bool {
+	return true
+}
+
+// Extensions implements extension.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements extension.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// DebugMetricsConfig implements extension.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
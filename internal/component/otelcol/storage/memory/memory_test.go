@@ -0,0 +1,80 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/component/otelcol/storage/memory"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	extstorage "go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+func TestExtension(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	ctrl, err := componenttest.NewControllerFromID(util.TestLogger(t), "otelcol.storage.memory")
+	require.NoError(t, err)
+
+	var args memory.Arguments
+	args.SetToDefault()
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Second), "component never started")
+	require.NoError(t, ctrl.WaitExports(time.Second), "component never exported anything")
+
+	exports := ctrl.Exports().(extension.Exports)
+	require.NotNil(t, exports.Handler)
+
+	ext, ok := exports.Handler.Extension.(extstorage.Extension)
+	require.True(t, ok, "extension is not of type extstorage.Extension")
+
+	id := otelcomponent.MustNewID("test")
+	cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, id, "traces")
+	require.NoError(t, err)
+
+	b, err := cl.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	value := []byte("value")
+	require.NoError(t, cl.Set(ctx, "key", value))
+
+	// Modifying the value after it's set must not modify the stored data.
+	value[0] = 'V'
+
+	getOp := extstorage.GetOperation("key")
+	require.NoError(t, cl.Batch(ctx, extstorage.SetOperation("other", []byte("other")), getOp))
+	require.Equal(t, "value", string(getOp.Value))
+	require.NoError(t, cl.Close(ctx))
+
+	// The data of a client survives it being closed.
+	cl, err = ext.GetClient(ctx, otelcomponent.KindExporter, id, "traces")
+	require.NoError(t, err)
+
+	b, err = cl.Get(ctx, "other")
+	require.NoError(t, err)
+	require.Equal(t, "other", string(b))
+
+	require.NoError(t, cl.Delete(ctx, "other"))
+	b, err = cl.Get(ctx, "other")
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	// Clients with different names don't share data.
+	other, err := ext.GetClient(ctx, otelcomponent.KindExporter, id, "logs")
+	require.NoError(t, err)
+
+	b, err = other.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Nil(t, b)
+}
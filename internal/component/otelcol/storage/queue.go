@@ -0,0 +1,361 @@
+package storage
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	pdatareq "go.opentelemetry.io/collector/pdata/xpdata/request"
+	"go.opentelemetry.io/collector/pipeline"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Keys used by the persistent sending queue of exporters to store its
+// metadata. The legacy keys are used by older versions of the queue.
+//
+// The key of the metadata holds the version of its layout, so a queue written
+// by a newer version of the exporters uses a different key.
+const (
+	queueMetadataKey       = "qmv0"
+	queueMetadataKeyPrefix = "qmv"
+
+	legacyReadIndexKey  = "ri"
+	legacyWriteIndexKey = "wi"
+	legacyDispatchedKey = "di"
+)
+
+// States of a batch in a persistent sending queue.
+const (
+	BatchStatePending    = "pending"
+	BatchStateDispatched = "dispatched"
+)
+
+// ClientSummary summarizes the data written by a client.
+type ClientSummary struct {
+	Name  string
+	Keys  int
+	Bytes int
+
+	// Queue is set when the client holds a persistent sending queue.
+	Queue *QueueMetadata
+}
+
+// QueueMetadata is the metadata of a persistent sending queue.
+type QueueMetadata struct {
+	// ItemsSize and BytesSize are the size of the queue as tracked by the
+	// queue. They're only recorded by recent versions of the queue.
+	ItemsSize int64
+	BytesSize int64
+
+	// ReadIndex is the index of the next batch to read from the queue, and
+	// WriteIndex the index of the next batch to write to the queue.
+	ReadIndex  uint64
+	WriteIndex uint64
+
+	// Dispatched holds the indexes of the batches which were read from the
+	// queue but not sent yet.
+	Dispatched []uint64
+}
+
+// Batches returns the number of batches in the queue.
+func (m *QueueMetadata) Batches() int {
+	return int(m.WriteIndex-m.ReadIndex) + len(m.Dispatched)
+}
+
+// QueuedBatch is a batch of telemetry in a persistent sending queue.
+type QueuedBatch struct {
+	Index uint64
+	State string
+	Bytes int
+
+	// Items is the number of spans, data points or log records in the batch.
+	// It's -1 if the batch can't be decoded.
+	Items int
+
+	value []byte
+	err   error
+}
+
+// Summarize summarizes the data written by client.
+func Summarize(s Store, client string) (ClientSummary, error) {
+	summary := ClientSummary{Name: client}
+	legacy := map[string][]byte{}
+
+	err := s.Read(client, func(key string, value []byte) error {
+		summary.Keys++
+		summary.Bytes += len(value)
+
+		switch key {
+		case queueMetadataKey:
+			md, err := decodeQueueMetadata(value)
+			if err != nil {
+				return fmt.Errorf("failed to decode queue metadata: %w", err)
+			}
+			summary.Queue = md
+		case legacyReadIndexKey, legacyWriteIndexKey, legacyDispatchedKey:
+			legacy[key] = slices.Clone(value)
+		default:
+			if isQueueMetadataKey(key) {
+				return fmt.Errorf("unsupported queue metadata version %q, only %q is supported", key, queueMetadataKey)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return summary, err
+	}
+
+	if summary.Queue == nil && legacy[legacyReadIndexKey] != nil && legacy[legacyWriteIndexKey] != nil {
+		md, err := decodeLegacyQueueMetadata(legacy)
+		if err != nil {
+			return summary, fmt.Errorf("failed to decode queue metadata: %w", err)
+		}
+		summary.Queue = md
+	}
+	return summary, nil
+}
+
+// QueuedBatches returns the batches in the persistent sending queue of
+// client, in index order.
+func QueuedBatches(s Store, client string) ([]QueuedBatch, error) {
+	summary, err := Summarize(s, client)
+	if err != nil {
+		return nil, err
+	} else if summary.Queue == nil {
+		return nil, fmt.Errorf("client %q doesn't hold a persistent sending queue", client)
+	}
+	md := summary.Queue
+	signal := clientSignal(client)
+
+	var batches []QueuedBatch
+	err = s.Read(client, func(key string, value []byte) error {
+		index, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		batch := QueuedBatch{
+			Index: index,
+			State: BatchStatePending,
+			Bytes: len(value),
+			value: slices.Clone(value),
+		}
+		if slices.Contains(md.Dispatched, index) {
+			batch.State = BatchStateDispatched
+		} else if index < md.ReadIndex || index >= md.WriteIndex {
+			// The batch was sent already, but wasn't deleted yet.
+			return nil
+		}
+		batch.Items, batch.err = countItems(signal, value)
+		batches = append(batches, batch)
+		return nil
+	})
+	slices.SortFunc(batches, func(a, b QueuedBatch) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return batches, err
+}
+
+// JSON returns the batch encoded as OTLP JSON.
+func (b QueuedBatch) JSON(client string) ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	switch clientSignal(client) {
+	case pipeline.SignalTraces:
+		td, err := decodeTraces(b.value)
+		if err != nil {
+			return nil, err
+		}
+		return (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+	case pipeline.SignalMetrics:
+		md, err := decodeMetrics(b.value)
+		if err != nil {
+			return nil, err
+		}
+		return (&pmetric.JSONMarshaler{}).MarshalMetrics(md)
+	case pipeline.SignalLogs:
+		ld, err := decodeLogs(b.value)
+		if err != nil {
+			return nil, err
+		}
+		return (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	default:
+		return nil, fmt.Errorf("unsupported signal for client %q", client)
+	}
+}
+
+// isQueueMetadataKey returns whether key holds the metadata of a queue, with
+// any version of the layout.
+func isQueueMetadataKey(key string) bool {
+	version, ok := strings.CutPrefix(key, queueMetadataKeyPrefix)
+	if !ok || version == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(version, 10, 64)
+	return err == nil
+}
+
+// clientSignal returns the signal of the queue held by client. The queue of
+// an exporter is named after the signal it sends.
+func clientSignal(client string) pipeline.Signal {
+	for _, signal := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs} {
+		if strings.HasSuffix(client, "_"+signal.String()) {
+			return signal
+		}
+	}
+	return pipeline.Signal{}
+}
+
+func countItems(signal pipeline.Signal, value []byte) (int, error) {
+	switch signal {
+	case pipeline.SignalTraces:
+		td, err := decodeTraces(value)
+		if err != nil {
+			return -1, err
+		}
+		return td.SpanCount(), nil
+	case pipeline.SignalMetrics:
+		md, err := decodeMetrics(value)
+		if err != nil {
+			return -1, err
+		}
+		return md.DataPointCount(), nil
+	case pipeline.SignalLogs:
+		ld, err := decodeLogs(value)
+		if err != nil {
+			return -1, err
+		}
+		return ld.LogRecordCount(), nil
+	default:
+		return -1, errors.New("unsupported signal")
+	}
+}
+
+// The queue stores batches along with their request context, unless it was
+// written by an older version of the queue.
+
+func decodeTraces(value []byte) (ptrace.Traces, error) {
+	_, td, err := pdatareq.UnmarshalTraces(value)
+	if errors.Is(err, pdatareq.ErrInvalidFormat) {
+		return (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(value)
+	}
+	return td, err
+}
+
+func decodeMetrics(value []byte) (pmetric.Metrics, error) {
+	_, md, err := pdatareq.UnmarshalMetrics(value)
+	if errors.Is(err, pdatareq.ErrInvalidFormat) {
+		return (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(value)
+	}
+	return md, err
+}
+
+func decodeLogs(value []byte) (plog.Logs, error) {
+	_, ld, err := pdatareq.UnmarshalLogs(value)
+	if errors.Is(err, pdatareq.ErrInvalidFormat) {
+		return (&plog.ProtoUnmarshaler{}).UnmarshalLogs(value)
+	}
+	return ld, err
+}
+
+// decodeQueueMetadata decodes the queue metadata, which is a protobuf message
+// with the following fields:
+//
+//	sfixed64 items_size = 1;
+//	sfixed64 bytes_size = 2;
+//	fixed64 read_index = 3;
+//	fixed64 write_index = 4;
+//	repeated fixed64 currently_dispatched_items = 5;
+//
+// Unknown fields are skipped, but known fields with another type are an error.
+func decodeQueueMetadata(buf []byte) (*QueueMetadata, error) {
+	var md QueueMetadata
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		buf = buf[n:]
+
+		switch {
+		case num == 5 && typ == protowire.BytesType:
+			packed, n := protowire.ConsumeBytes(buf)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			buf = buf[n:]
+			for len(packed) > 0 {
+				v, n := protowire.ConsumeFixed64(packed)
+				if n < 0 {
+					return nil, protowire.ParseError(n)
+				}
+				packed = packed[n:]
+				md.Dispatched = append(md.Dispatched, v)
+			}
+		case num >= 1 && num <= 5 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(buf)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			buf = buf[n:]
+			switch num {
+			case 1:
+				md.ItemsSize = int64(v)
+			case 2:
+				md.BytesSize = int64(v)
+			case 3:
+				md.ReadIndex = v
+			case 4:
+				md.WriteIndex = v
+			case 5:
+				md.Dispatched = append(md.Dispatched, v)
+			}
+		case num >= 1 && num <= 5:
+			return nil, fmt.Errorf("unexpected wire type %d for field %d", typ, num)
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, buf)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			buf = buf[n:]
+		}
+	}
+	return &md, nil
+}
+
+// decodeLegacyQueueMetadata decodes the queue metadata written by older
+// versions of the queue, which store each field under its own key.
+func decodeLegacyQueueMetadata(values map[string][]byte) (*QueueMetadata, error) {
+	ri, wi := values[legacyReadIndexKey], values[legacyWriteIndexKey]
+	if len(ri) != 8 || len(wi) != 8 {
+		return nil, errors.New("invalid queue index")
+	}
+	md := &QueueMetadata{
+		ItemsSize:  -1,
+		BytesSize:  -1,
+		ReadIndex:  binary.LittleEndian.Uint64(ri),
+		WriteIndex: binary.LittleEndian.Uint64(wi),
+	}
+
+	// The dispatched batches are stored as a count followed by the indexes.
+	if di := values[legacyDispatchedKey]; len(di) >= 4 {
+		count := int(binary.LittleEndian.Uint32(di))
+		di = di[4:]
+		if len(di) < count*8 {
+			return nil, errors.New("invalid dispatched batches")
+		}
+		for i := 0; i < count; i++ {
+			md.Dispatched = append(md.Dispatched, binary.LittleEndian.Uint64(di[i*8:]))
+		}
+	}
+	return md, nil
+}
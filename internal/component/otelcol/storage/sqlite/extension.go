@@ -0,0 +1,232 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// DatabaseFile is the name of the database file in the directory of the
+// extension.
+const DatabaseFile = "storage.db"
+
+// pageSize is the size of the pages of the database. It's used to convert
+// the maximum size of the database into a number of pages.
+const pageSize = 4096
+
+// sqliteStorage is a storage extension which stores the data of each client
+// in a separate table of a SQLite database.
+type sqliteStorage struct {
+	logger *zap.Logger
+	cfg    *Config
+
+	mut    sync.Mutex
+	db     *sql.DB
+	closed bool
+}
+
+var _ storage.Extension = (*sqliteStorage)(nil)
+
+func newSQLiteStorage(logger *zap.Logger, cfg *Config) *sqliteStorage {
+	return &sqliteStorage{logger: logger, cfg: cfg}
+}
+
+// Start implements component.Component.
+func (s *sqliteStorage) Start(context.Context, component.Host) error {
+	_, err := s.open()
+	return err
+}
+
+// open opens the database if it isn't open yet and returns it. The handler of
+// the extension is exported before it's started, so clients may be requested
+// before Start is called.
+func (s *sqliteStorage) open() (*sql.DB, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return nil, errors.New("the storage extension is shut down")
+	} else if s.db != nil {
+		return s.db, nil
+	}
+
+	if err := os.MkdirAll(s.cfg.Directory, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", dataSourceName(filepath.Join(s.cfg.Directory, DatabaseFile), s.cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	// A single connection serializes the operations of the clients, which
+	// avoids them failing on each other's locks.
+	db.SetMaxOpenConns(1)
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	s.db = db
+	return db, nil
+}
+
+// dataSourceName returns the data source name to open the database at path
+// with the settings of cfg.
+func dataSourceName(path string, cfg *Config) string {
+	params := url.Values{}
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", cfg.Timeout.Milliseconds()))
+	params.Add("_pragma", fmt.Sprintf("page_size(%d)", pageSize))
+	if cfg.MaxSize > 0 {
+		params.Add("_pragma", fmt.Sprintf("max_page_count(%d)", max(cfg.MaxSize/pageSize, 1)))
+	}
+	return DataSourceName(path, params)
+}
+
+// DataSourceName returns the data source name to open the database at path
+// with the SQLite driver, using the given URI parameters.
+func DataSourceName(path string, params url.Values) string {
+	// Escape the characters which have a meaning in SQLite URIs.
+	path = strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23").Replace(filepath.ToSlash(path))
+	return "file:" + path + "?" + params.Encode()
+}
+
+// Shutdown implements component.Component.
+func (s *sqliteStorage) Shutdown(context.Context) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.closed = true
+	if s.db == nil {
+		return nil
+	}
+	err := s.db.Close()
+	s.db = nil
+	return err
+}
+
+// GetClient implements storage.Extension.
+func (s *sqliteStorage) GetClient(ctx context.Context, kind component.Kind, id component.ID, name string) (storage.Client, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	table := TableName(kind, id, name)
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (key TEXT PRIMARY KEY, value BLOB)`, quoteIdentifier(table))
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return nil, fmt.Errorf("failed to create table %s: %w", table, convertError(err))
+	}
+	return newClient(db, table), nil
+}
+
+// TableName returns the name of the table which holds the data of the client
+// with the given name of the component with the given kind and ID.
+func TableName(kind component.Kind, id component.ID, name string) string {
+	table := fmt.Sprintf("%s_%s_%s", strings.ToLower(kind.String()), id.Type(), id.Name())
+	if name != "" {
+		table += "_" + name
+	}
+	return table
+}
+
+// quoteIdentifier quotes name to be used as an identifier in queries.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// convertError converts the errors reported when the database is full into
+// storage.ErrStorageFull.
+func convertError(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code()&0xff == sqlite3.SQLITE_FULL {
+		return fmt.Errorf("%w: %w", storage.ErrStorageFull, err)
+	}
+	return err
+}
+
+type client struct {
+	db *sql.DB
+
+	getQuery    string
+	setQuery    string
+	deleteQuery string
+}
+
+var _ storage.Client = (*client)(nil)
+
+func newClient(db *sql.DB, table string) *client {
+	table = quoteIdentifier(table)
+	return &client{
+		db:          db,
+		getQuery:    fmt.Sprintf(`SELECT value FROM %s WHERE key = ?`, table),
+		setQuery:    fmt.Sprintf(`INSERT INTO %s (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value`, table),
+		deleteQuery: fmt.Sprintf(`DELETE FROM %s WHERE key = ?`, table),
+	}
+}
+
+// Get implements storage.Client.
+func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set implements storage.Client.
+func (c *client) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete implements storage.Client.
+func (c *client) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch implements storage.Client. The operations are applied in a single
+// transaction.
+func (c *client) Batch(ctx context.Context, ops ...*storage.Operation) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return convertError(err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			err = tx.QueryRowContext(ctx, c.getQuery, op.Key).Scan(&op.Value)
+			if errors.Is(err, sql.ErrNoRows) {
+				op.Value, err = nil, nil
+			}
+		case storage.Set:
+			_, err = tx.ExecContext(ctx, c.setQuery, op.Key, op.Value)
+		case storage.Delete:
+			_, err = tx.ExecContext(ctx, c.deleteQuery, op.Key)
+		default:
+			err = fmt.Errorf("unsupported operation type %d", op.Type)
+		}
+		if err != nil {
+			return convertError(err)
+		}
+	}
+	return convertError(tx.Commit())
+}
+
+// Close implements storage.Client. The database is shared by the clients of
+// the extension, so it's closed when the extension is shut down instead.
+func (c *client) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+const (
+	typeStr = "sqlite_storage"
+)
+
+// Config configures the SQLite storage extension.
+type Config struct {
+	// Directory is the directory which holds the database.
+	Directory string
+	// MaxSize is the maximum size of the database in bytes. 0 means no limit.
+	MaxSize int64
+	// Timeout is how long to wait for the database to be unlocked.
+	Timeout time.Duration
+}
+
+// NewFactory creates a factory for the SQLite storage extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		component.MustNewType(typeStr),
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelDevelopment,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Timeout: time.Second,
+	}
+}
+
+func createExtension(_ context.Context, set extension.Settings, cfg component.Config) (extension.Extension, error) {
+	return newSQLiteStorage(set.Logger, cfg.(*Config)), nil
+}
@@ -0,0 +1,107 @@
+// Package sqlite provides an otelcol.storage.sqlite component.
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/units"
+	"github.com/grafana/alloy/internal/component"
+	otelcolCfg "github.com/grafana/alloy/internal/component/otelcol/config"
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/syntax"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "otelcol.storage.sqlite",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   extension.Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return extension.New(opts, NewFactory(), args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures the otelcol.storage.sqlite component.
+type Arguments struct {
+	// Directory specifies the directory where the database is stored.
+	Directory string `alloy:"directory,attr,optional"`
+
+	// MaxSize specifies the maximum size of the database. 0 means no limit.
+	MaxSize units.Base2Bytes `alloy:"max_size,attr,optional"`
+
+	// Timeout specifies how long to wait for the database to be unlocked.
+	Timeout time.Duration `alloy:"timeout,attr,optional"`
+
+	DebugMetrics otelcolCfg.DebugMetricsArguments `alloy:"debug_metrics,block,optional"`
+}
+
+var (
+	_ extension.Arguments = Arguments{}
+	_ syntax.Defaulter    = (*Arguments)(nil)
+	_ syntax.Validator    = (*Arguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		Timeout: time.Second,
+	}
+	args.DebugMetrics.SetToDefault()
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	var errs error
+	if args.MaxSize < 0 {
+		errs = errors.Join(errs, errors.New("max_size must not be negative"))
+	} else if args.MaxSize > 0 && args.MaxSize < pageSize {
+		errs = errors.Join(errs, fmt.Errorf("max_size must be at least %d bytes", pageSize))
+	}
+	if args.Timeout < 0 {
+		errs = errors.Join(errs, errors.New("timeout must not be negative"))
+	}
+	return errs
+}
+
+// Convert implements extension.Arguments.
+func (args Arguments) Convert(opts component.Options) (otelcomponent.Config, error) {
+	cfg := &Config{
+		Directory: args.Directory,
+		MaxSize:   int64(args.MaxSize),
+		Timeout:   args.Timeout,
+	}
+	// The default directory depends on Alloy's storage.path, so it can't be
+	// set in SetToDefault.
+	if cfg.Directory == "" {
+		cfg.Directory = opts.DataPath
+	}
+	return cfg, nil
+}
+
+// ExportsHandler implements extension.Arguments.
+func (args Arguments) ExportsHandler() bool {
+	return true
+}
+
+// Extensions implements extension.Arguments.
+func (args Arguments) Extensions() map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// Exporters implements extension.Arguments.
+func (args Arguments) Exporters() map[pipeline.Signal]map[otelcomponent.ID]otelcomponent.Component {
+	return nil
+}
+
+// DebugMetricsConfig implements extension.Arguments.
+func (args Arguments) DebugMetricsConfig() otelcolCfg.DebugMetricsArguments {
+	return args.DebugMetrics
+}
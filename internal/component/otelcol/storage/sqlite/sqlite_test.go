@@ -0,0 +1,137 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/units"
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/component/otelcol/storage/sqlite"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	extstorage "go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+func TestExtension(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	var args sqlite.Arguments
+	args.SetToDefault()
+	args.Directory = t.TempDir()
+
+	ext := newTestExtension(t, ctx, args)
+
+	cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, otelcomponent.MustNewID("test"), "traces")
+	require.NoError(t, err)
+
+	b, err := cl.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	require.NoError(t, cl.Set(ctx, "key", []byte("value")))
+	require.NoError(t, cl.Set(ctx, "key", []byte("updated")))
+
+	getOp := extstorage.GetOperation("key")
+	require.NoError(t, cl.Batch(ctx, extstorage.SetOperation("other", []byte("other")), getOp))
+	require.Equal(t, "updated", string(getOp.Value))
+
+	require.NoError(t, cl.Delete(ctx, "other"))
+	b, err = cl.Get(ctx, "other")
+	require.NoError(t, err)
+	require.Nil(t, b)
+	require.NoError(t, cl.Close(ctx))
+
+	// Clients with different names don't share data.
+	other, err := ext.GetClient(ctx, otelcomponent.KindExporter, otelcomponent.MustNewID("test"), "logs")
+	require.NoError(t, err)
+
+	b, err = other.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Nil(t, b)
+}
+
+func TestExtension_MaxSize(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	var args sqlite.Arguments
+	args.SetToDefault()
+	args.Directory = t.TempDir()
+	args.MaxSize = 64 * units.KiB
+
+	ext := newTestExtension(t, ctx, args)
+
+	cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, otelcomponent.MustNewID("test"), "traces")
+	require.NoError(t, err)
+
+	value := make([]byte, 8*units.KiB)
+	for i := range 16 {
+		err = cl.Set(ctx, string(rune('a'+i)), value)
+		if err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, extstorage.ErrStorageFull)
+
+	// Removing data makes room for new data.
+	require.NoError(t, cl.Delete(ctx, "a"))
+	require.NoError(t, cl.Delete(ctx, "b"))
+	require.NoError(t, cl.Set(ctx, "a", value))
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      string
+		errorMsg string
+	}{
+		{
+			name:     "max size smaller than a page",
+			cfg:      `max_size = "1KiB"`,
+			errorMsg: "max_size must be at least 4096 bytes",
+		},
+		{
+			name:     "negative timeout",
+			cfg:      `timeout = "-1s"`,
+			errorMsg: "timeout must not be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args sqlite.Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
+
+// newTestExtension runs the component with args and returns the storage
+// extension it exports.
+func newTestExtension(t *testing.T, ctx context.Context, args sqlite.Arguments) extstorage.Extension {
+	t.Helper()
+
+	ctrl, err := componenttest.NewControllerFromID(util.TestLogger(t), "otelcol.storage.sqlite")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Second), "component never started")
+	require.NoError(t, ctrl.WaitExports(time.Second), "component never exported anything")
+
+	exports := ctrl.Exports().(extension.Exports)
+	require.NotNil(t, exports.Handler)
+
+	ext, ok := exports.Handler.Extension.(extstorage.Extension)
+	require.True(t, ok, "extension is not of type extstorage.Extension")
+	return ext
+}
@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/storage/sqlite"
+	"go.etcd.io/bbolt"
+)
+
+// Store gives access to the data written by the clients of a storage
+// extension.
+type Store interface {
+	// Clients returns the names of the clients with data in the store.
+	Clients() ([]string, error)
+
+	// Read calls fn with each key and value written by client, in key order.
+	// value is only valid until fn returns.
+	Read(client string, fn func(key string, value []byte) error) error
+
+	// Purge deletes all the data written by client and returns the number of
+	// deleted keys.
+	Purge(client string) (int, error)
+
+	// Close closes the store.
+	Close() error
+}
+
+// sqliteHeader is the header of SQLite database files.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// Open opens the data of the storage extension at path. path is either the
+// directory of an otelcol.storage.file component, or the directory or database
+// file of an otelcol.storage.sqlite component. timeout is how long to wait for
+// the data to be unlocked.
+func Open(path string, timeout time.Duration, readOnly bool) (Store, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		dbPath := filepath.Join(path, sqlite.DatabaseFile)
+		if _, err := os.Stat(dbPath); err != nil {
+			return &boltStore{dir: path, timeout: timeout, readOnly: readOnly}, nil
+		}
+		path = dbPath
+	}
+
+	if ok, err := hasHeader(path, sqliteHeader); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("%s isn't a SQLite database", path)
+	}
+	return openSQLiteStore(path, timeout, readOnly)
+}
+
+func hasHeader(path string, header []byte) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, len(header))
+	if _, err := f.Read(buf); err != nil {
+		return false, nil
+	}
+	return bytes.Equal(buf, header), nil
+}
+
+// boltStore reads the data of an otelcol.storage.file component, which writes
+// each client to its own bbolt file.
+type boltStore struct {
+	dir      string
+	timeout  time.Duration
+	readOnly bool
+}
+
+// boltBucket is the bucket which holds the data of a client.
+var boltBucket = []byte("default")
+
+func (s *boltStore) Clients() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		// The directory may hold other files, such as the temporary files of
+		// compactions, so only keep the ones holding client data.
+		err := s.view(entry.Name(), func(*bbolt.Bucket) error { return nil })
+		if errors.Is(err, bbolt.ErrTimeout) {
+			return nil, fmt.Errorf("%s is locked, it may be in use by a running Alloy instance", entry.Name())
+		} else if err == nil {
+			clients = append(clients, entry.Name())
+		}
+	}
+	return clients, nil
+}
+
+func (s *boltStore) Read(client string, fn func(key string, value []byte) error) error {
+	return s.view(client, func(b *bbolt.Bucket) error {
+		return b.ForEach(func(k, v []byte) error {
+			return fn(string(k), v)
+		})
+	})
+}
+
+func (s *boltStore) Purge(client string) (int, error) {
+	if s.readOnly {
+		return 0, errors.New("store is read-only")
+	}
+
+	db, err := s.open(client)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var deleted int
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(boltBucket)
+		if b == nil {
+			return nil
+		}
+		deleted = b.Stats().KeyN
+		if err := tx.DeleteBucket(boltBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(boltBucket)
+		return err
+	})
+	return deleted, err
+}
+
+func (s *boltStore) Close() error {
+	return nil
+}
+
+func (s *boltStore) open(client string) (*bbolt.DB, error) {
+	path := filepath.Join(s.dir, client)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("client %q not found: %w", client, err)
+	}
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: s.timeout, ReadOnly: s.readOnly})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("%s is locked, it may be in use by a running Alloy instance: %w", client, err)
+	}
+	return db, err
+}
+
+func (s *boltStore) view(client string, fn func(*bbolt.Bucket) error) error {
+	db, err := s.open(client)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(boltBucket)
+		if b == nil {
+			return fmt.Errorf("%s doesn't hold client data", client)
+		}
+		return fn(b)
+	})
+}
+
+// sqliteStore reads the data of an otelcol.storage.sqlite component, which
+// writes each client to its own table.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func openSQLiteStore(path string, timeout time.Duration, readOnly bool) (*sqliteStore, error) {
+	params := url.Values{}
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", timeout.Milliseconds()))
+	if readOnly {
+		params.Add("mode", "ro")
+	}
+
+	db, err := sql.Open("sqlite", sqlite.DataSourceName(path, params))
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) Clients() ([]string, error) {
+	rows, err := s.db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clients []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		clients = append(clients, name)
+	}
+	return clients, rows.Err()
+}
+
+func (s *sqliteStore) Read(client string, fn func(key string, value []byte) error) error {
+	if err := s.checkClient(client); err != nil {
+		return err
+	}
+
+	rows, err := s.db.Query(fmt.Sprintf(`SELECT key, value FROM %s ORDER BY key`, quoteIdentifier(client)))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			key   string
+			value []byte
+		)
+		if err := rows.Scan(&key, &value); err != nil {
+			return err
+		}
+		if err := fn(key, value); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s *sqliteStore) Purge(client string) (int, error) {
+	if err := s.checkClient(client); err != nil {
+		return 0, err
+	}
+
+	res, err := s.db.Exec(fmt.Sprintf(`DELETE FROM %s`, quoteIdentifier(client)))
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	return int(deleted), err
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *sqliteStore) checkClient(client string) error {
+	clients, err := s.Clients()
+	if err != nil {
+		return err
+	} else if !slices.Contains(clients, client) {
+		return fmt.Errorf("client %q not found", client)
+	}
+	return nil
+}
+
+// quoteIdentifier quotes name to be used as an identifier in queries.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
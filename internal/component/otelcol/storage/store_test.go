@@ -0,0 +1,362 @@
+package storage_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol/storage"
+	"github.com/grafana/alloy/internal/component/otelcol/storage/sqlite"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/stretchr/testify/require"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	otelextension "go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	extstorage "go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	pdatareq "go.opentelemetry.io/collector/pdata/xpdata/request"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestStore(t *testing.T) {
+	tests := []struct {
+		name    string
+		factory otelextension.Factory
+		config  func(dir string) otelcomponent.Config
+	}{
+		{
+			name:    "file",
+			factory: filestorage.NewFactory(),
+			config: func(dir string) otelcomponent.Config {
+				cfg := filestorage.NewFactory().CreateDefaultConfig().(*filestorage.Config)
+				cfg.Directory = dir
+				return cfg
+			},
+		},
+		{
+			name:    "sqlite",
+			factory: sqlite.NewFactory(),
+			config: func(dir string) otelcomponent.Config {
+				return &sqlite.Config{Directory: dir, Timeout: time.Second}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeQueue(t, tc.factory, tc.config(dir))
+
+			store, err := storage.Open(dir, time.Second, true)
+			require.NoError(t, err)
+
+			clients, err := store.Clients()
+			require.NoError(t, err)
+			require.Equal(t, []string{"exporter_otlp_default_logs", "exporter_otlp_default_traces"}, clients)
+
+			summary, err := storage.Summarize(store, "exporter_otlp_default_logs")
+			require.NoError(t, err)
+			require.Equal(t, 1, summary.Keys)
+			require.Nil(t, summary.Queue)
+
+			summary, err = storage.Summarize(store, "exporter_otlp_default_traces")
+			require.NoError(t, err)
+			require.Equal(t, 5, summary.Keys)
+			require.Equal(t, &storage.QueueMetadata{
+				ItemsSize:  3,
+				BytesSize:  100,
+				ReadIndex:  2,
+				WriteIndex: 4,
+				Dispatched: []uint64{1},
+			}, summary.Queue)
+			require.Equal(t, 3, summary.Queue.Batches())
+
+			batches, err := storage.QueuedBatches(store, "exporter_otlp_default_traces")
+			require.NoError(t, err)
+			require.Len(t, batches, 3)
+			require.Equal(t, uint64(1), batches[0].Index)
+			require.Equal(t, storage.BatchStateDispatched, batches[0].State)
+			require.Equal(t, 1, batches[0].Items)
+			require.Equal(t, uint64(2), batches[1].Index)
+			require.Equal(t, storage.BatchStatePending, batches[1].State)
+			require.Equal(t, 2, batches[1].Items)
+			require.Equal(t, uint64(3), batches[2].Index)
+			require.Equal(t, -1, batches[2].Items)
+
+			buf, err := batches[1].JSON("exporter_otlp_default_traces")
+			require.NoError(t, err)
+			require.Contains(t, string(buf), `"name":"span-1"`)
+
+			_, err = batches[2].JSON("exporter_otlp_default_traces")
+			require.Error(t, err)
+
+			_, err = store.Purge("exporter_otlp_default_traces")
+			require.Error(t, err, "read-only stores can't be purged")
+			require.NoError(t, store.Close())
+
+			store, err = storage.Open(dir, time.Second, false)
+			require.NoError(t, err)
+			defer store.Close()
+
+			deleted, err := store.Purge("exporter_otlp_default_traces")
+			require.NoError(t, err)
+			require.Equal(t, 5, deleted)
+
+			summary, err = storage.Summarize(store, "exporter_otlp_default_traces")
+			require.NoError(t, err)
+			require.Equal(t, 0, summary.Keys)
+
+			_, err = store.Purge("exporter_otlp_other_traces")
+			require.ErrorContains(t, err, `client "exporter_otlp_other_traces" not found`)
+		})
+	}
+}
+
+func TestStore_LegacyQueueMetadata(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	ext := startExtension(t, sqlite.NewFactory(), &sqlite.Config{Directory: dir, Timeout: time.Second})
+	cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, otelcomponent.MustNewID("otlp"), "metrics")
+	require.NoError(t, err)
+
+	dispatched := binary.LittleEndian.AppendUint32(nil, 1)
+	dispatched = binary.LittleEndian.AppendUint64(dispatched, 4)
+	require.NoError(t, cl.Batch(ctx,
+		extstorage.SetOperation("ri", binary.LittleEndian.AppendUint64(nil, 5)),
+		extstorage.SetOperation("wi", binary.LittleEndian.AppendUint64(nil, 7)),
+		extstorage.SetOperation("di", dispatched),
+	))
+	require.NoError(t, ext.Shutdown(ctx))
+
+	store, err := storage.Open(dir, time.Second, true)
+	require.NoError(t, err)
+	defer store.Close()
+
+	summary, err := storage.Summarize(store, "exporter_otlp__metrics")
+	require.NoError(t, err)
+	require.Equal(t, &storage.QueueMetadata{
+		ItemsSize:  -1,
+		BytesSize:  -1,
+		ReadIndex:  5,
+		WriteIndex: 7,
+		Dispatched: []uint64{4},
+	}, summary.Queue)
+}
+
+func TestStore_ExporterQueue(t *testing.T) {
+	dir := t.TempDir()
+	startExporterQueue(t, dir)
+
+	store, err := storage.Open(dir, time.Second, true)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Pin the layout of the queue written by the vendored exporterhelper, so
+	// that updating it fails this test if the layout changes.
+	var keys []string
+	require.NoError(t, store.Read("exporter_otlp_queue_traces", func(key string, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	require.Equal(t, []string{"0", "1", "2", "qmv0"}, keys)
+
+	summary, err := storage.Summarize(store, "exporter_otlp_queue_traces")
+	require.NoError(t, err)
+	require.Equal(t, &storage.QueueMetadata{
+		ItemsSize:  6,
+		BytesSize:  132,
+		ReadIndex:  1,
+		WriteIndex: 3,
+		Dispatched: []uint64{0},
+	}, summary.Queue)
+
+	batches, err := storage.QueuedBatches(store, "exporter_otlp_queue_traces")
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+	for i, batch := range batches {
+		require.Equal(t, uint64(i), batch.Index)
+		require.Equal(t, i+1, batch.Items)
+	}
+	require.Equal(t, storage.BatchStateDispatched, batches[0].State)
+	require.Equal(t, storage.BatchStatePending, batches[1].State)
+}
+
+func TestStore_UnsupportedQueueMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		value       []byte
+		expectedErr string
+	}{
+		{
+			name:        "newer version",
+			key:         "qmv1",
+			value:       encodeMetadata(1, 10, 0, 1),
+			expectedErr: `unsupported queue metadata version "qmv1", only "qmv0" is supported`,
+		},
+		{
+			name:        "unexpected field type",
+			key:         "qmv0",
+			value:       protowire.AppendVarint(protowire.AppendTag(nil, 3, protowire.VarintType), 1),
+			expectedErr: "failed to decode queue metadata: unexpected wire type 0 for field 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			ctx := context.Background()
+
+			ext := startExtension(t, sqlite.NewFactory(), &sqlite.Config{Directory: dir, Timeout: time.Second})
+			cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, otelcomponent.MustNewID("otlp"), "traces")
+			require.NoError(t, err)
+			require.NoError(t, cl.Set(ctx, tc.key, tc.value))
+			require.NoError(t, ext.Shutdown(ctx))
+
+			store, err := storage.Open(dir, time.Second, true)
+			require.NoError(t, err)
+			defer store.Close()
+
+			_, err = storage.Summarize(store, "exporter_otlp__traces")
+			require.EqualError(t, err, tc.expectedErr)
+
+			_, err = storage.QueuedBatches(store, "exporter_otlp__traces")
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+// startExporterQueue starts an exporter of the collector with a persistent
+// sending queue, and sends it three batches of one, two and three spans. The
+// export of the first batch blocks until the test ends, so the queue holds a
+// dispatched batch and two pending batches.
+func startExporterQueue(t *testing.T, dir string) {
+	t.Helper()
+	ctx := context.Background()
+
+	storageID := otelcomponent.MustNewID("sqlite")
+	ext := startExtension(t, sqlite.NewFactory(), &sqlite.Config{Directory: dir, Timeout: time.Second})
+	host := &storageHost{extensions: map[otelcomponent.ID]otelcomponent.Component{storageID: ext}}
+
+	qCfg := exporterhelper.NewDefaultQueueConfig()
+	qCfg.NumConsumers = 1
+	qCfg.StorageID = &storageID
+
+	dispatched := make(chan struct{})
+	release := make(chan struct{})
+	set := exportertest.NewNopSettings(otelcomponent.MustNewType("otlp"))
+	set.ID = otelcomponent.MustNewIDWithName("otlp", "queue")
+	exp, err := exporterhelper.NewTraces(ctx, set, &struct{}{},
+		func(context.Context, ptrace.Traces) error {
+			select {
+			case dispatched <- struct{}{}:
+			default:
+			}
+			<-release
+			return nil
+		},
+		exporterhelper.WithQueue(qCfg),
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(ctx, host))
+	t.Cleanup(func() {
+		close(release)
+		require.NoError(t, exp.Shutdown(ctx))
+		require.NoError(t, ext.Shutdown(ctx))
+	})
+
+	require.NoError(t, exp.ConsumeTraces(ctx, newTraces(1)))
+	<-dispatched
+	require.NoError(t, exp.ConsumeTraces(ctx, newTraces(2)))
+	require.NoError(t, exp.ConsumeTraces(ctx, newTraces(3)))
+}
+
+type storageHost struct {
+	extensions map[otelcomponent.ID]otelcomponent.Component
+}
+
+func (h *storageHost) GetExtensions() map[otelcomponent.ID]otelcomponent.Component {
+	return h.extensions
+}
+
+// writeQueue writes the data of a persistent sending queue with a dispatched
+// batch, a pending batch and a corrupted batch, and the data of a client which
+// isn't a queue.
+func writeQueue(t *testing.T, factory otelextension.Factory, cfg otelcomponent.Config) {
+	t.Helper()
+	ctx := context.Background()
+
+	ext := startExtension(t, factory, cfg)
+
+	id := otelcomponent.MustNewIDWithName("otlp", "default")
+	cl, err := ext.GetClient(ctx, otelcomponent.KindExporter, id, "traces")
+	require.NoError(t, err)
+
+	require.NoError(t, cl.Batch(ctx,
+		extstorage.SetOperation("qmv0", encodeMetadata(3, 100, 2, 4, 1)),
+		// The batch at index 0 was sent but not deleted yet.
+		extstorage.SetOperation("0", marshalTraces(t, 1)),
+		extstorage.SetOperation("1", marshalTraces(t, 1)),
+		extstorage.SetOperation("2", marshalTraces(t, 2)),
+		extstorage.SetOperation("3", []byte("corrupted")),
+	))
+	require.NoError(t, cl.Close(ctx))
+
+	other, err := ext.GetClient(ctx, otelcomponent.KindExporter, id, "logs")
+	require.NoError(t, err)
+	require.NoError(t, other.Set(ctx, "key", []byte("value")))
+	require.NoError(t, other.Close(ctx))
+
+	require.NoError(t, ext.Shutdown(ctx))
+}
+
+func startExtension(t *testing.T, factory otelextension.Factory, cfg otelcomponent.Config) extstorage.Extension {
+	t.Helper()
+
+	ext, err := factory.Create(context.Background(), extensiontest.NewNopSettings(factory.Type()), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	return ext.(extstorage.Extension)
+}
+
+func encodeMetadata(itemsSize, bytesSize int64, readIndex, writeIndex uint64, dispatched ...uint64) []byte {
+	var buf []byte
+	buf = protowire.AppendTag(buf, 1, protowire.Fixed64Type)
+	buf = protowire.AppendFixed64(buf, uint64(itemsSize))
+	buf = protowire.AppendTag(buf, 2, protowire.Fixed64Type)
+	buf = protowire.AppendFixed64(buf, uint64(bytesSize))
+	buf = protowire.AppendTag(buf, 3, protowire.Fixed64Type)
+	buf = protowire.AppendFixed64(buf, readIndex)
+	buf = protowire.AppendTag(buf, 4, protowire.Fixed64Type)
+	buf = protowire.AppendFixed64(buf, writeIndex)
+
+	var packed []byte
+	for _, index := range dispatched {
+		packed = protowire.AppendFixed64(packed, index)
+	}
+	buf = protowire.AppendTag(buf, 5, protowire.BytesType)
+	return protowire.AppendBytes(buf, packed)
+}
+
+func newTraces(spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	ss := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	for i := range spans {
+		ss.Spans().AppendEmpty().SetName("span-" + string(rune('0'+i)))
+	}
+	return td
+}
+
+func marshalTraces(t *testing.T, spans int) []byte {
+	t.Helper()
+
+	buf, err := pdatareq.MarshalTraces(context.Background(), newTraces(spans))
+	require.NoError(t, err)
+	return buf
+}
@@ -0,0 +1,299 @@
+package delta_to_cumulative
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/metadata"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"go.uber.org/atomic"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/service/labelstore"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "prometheus.delta_to_cumulative",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// deltaTemporality is the value of the temporality label which marks a series
+// as a delta series.
+const deltaTemporality = "delta"
+
+// Arguments holds values which are used to configure the
+// prometheus.delta_to_cumulative component.
+type Arguments struct {
+	// Where the converted metrics should be forwarded to.
+	ForwardTo []storage.Appendable `alloy:"forward_to,attr"`
+
+	// The label which marks a series as a delta series when set to "delta".
+	TemporalityLabel string `alloy:"temporality_label,attr,optional"`
+	// A regular expression matching the names of metrics which are always
+	// treated as delta series.
+	MetricNameRegex string `alloy:"metric_name_regex,attr,optional"`
+	// How long a series can go without samples before its state is dropped.
+	MaxStale time.Duration `alloy:"max_stale,attr,optional"`
+	// The maximum number of series to track.
+	MaxStreams int `alloy:"max_streams,attr,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		TemporalityLabel: "__temporality__",
+		MaxStale:         5 * time.Minute,
+		MaxStreams:       100_000,
+	}
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	if args.MaxStale <= 0 {
+		return fmt.Errorf("max_stale must be greater than 0")
+	}
+	if args.MaxStreams <= 0 {
+		return fmt.Errorf("max_streams must be greater than 0 and is %d", args.MaxStreams)
+	}
+	if args.TemporalityLabel == "" && args.MetricNameRegex == "" {
+		return fmt.Errorf("at least one of temporality_label or metric_name_regex must be set")
+	}
+	_, err := compileMetricNameRegex(args.MetricNameRegex)
+	return err
+}
+
+func compileMetricNameRegex(s string) (*regexp.Regexp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile("^(?:" + s + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid metric_name_regex: %w", err)
+	}
+	return re, nil
+}
+
+// Exports holds values which are exported by the
+// prometheus.delta_to_cumulative component.
+type Exports struct {
+	Receiver storage.Appendable `alloy:"receiver,attr"`
+}
+
+// Component implements the prometheus.delta_to_cumulative component.
+type Component struct {
+	opts     component.Options
+	receiver *prometheus.Interceptor
+	fanout   *prometheus.Fanout
+	tracker  *tracker
+	exited   atomic.Bool
+
+	mut              sync.RWMutex
+	temporalityLabel string
+	metricNameRegex  *regexp.Regexp
+	maxStale         time.Duration
+	updated          chan struct{}
+}
+
+var _ component.Component = (*Component)(nil)
+
+// New creates a new prometheus.delta_to_cumulative component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	data, err := opts.GetServiceData(labelstore.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	ls := data.(labelstore.LabelStore)
+
+	c := &Component{
+		opts:    opts,
+		tracker: newTracker(newMetrics(opts.Registerer), args.MaxStale, args.MaxStreams),
+		updated: make(chan struct{}, 1),
+	}
+	c.fanout = prometheus.NewFanout(args.ForwardTo, opts.ID, opts.Registerer, ls, prometheus.NoopMetadataStore{})
+	c.receiver = prometheus.NewInterceptor(
+		c.fanout,
+		ls,
+		prometheus.WithComponentID(opts.ID),
+		prometheus.WithAppendHook(func(ref storage.SeriesRef, l labels.Labels, t int64, v float64, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", opts.ID)
+			}
+
+			newLbls, isDelta := c.classify(l)
+			if !isDelta {
+				return next.Append(forwardRef(ref, l, newLbls), newLbls, t, v)
+			}
+
+			key := seriesKey(newLbls)
+			if value.IsStaleNaN(v) {
+				// Forward the staleness marker and forget about the series.
+				c.tracker.delete(key)
+				return next.Append(forwardRef(ref, l, newLbls), newLbls, t, v)
+			}
+
+			cumulative, ok := c.tracker.addFloat(key, t, v)
+			if !ok {
+				return 0, nil
+			}
+			return next.Append(forwardRef(ref, l, newLbls), newLbls, t, cumulative)
+		}),
+		prometheus.WithHistogramHook(func(ref storage.SeriesRef, l labels.Labels, t int64, h *histogram.Histogram, fh *histogram.FloatHistogram, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", opts.ID)
+			}
+
+			newLbls, isDelta := c.classify(l)
+			if !isDelta {
+				return next.AppendHistogram(forwardRef(ref, l, newLbls), newLbls, t, h, fh)
+			}
+
+			if h != nil {
+				fh = h.ToFloat(nil)
+			}
+			ref = forwardRef(ref, l, newLbls)
+			if fh == nil {
+				return next.AppendHistogram(ref, newLbls, t, h, fh)
+			}
+
+			key := seriesKey(newLbls)
+			if value.IsStaleNaN(fh.Sum) {
+				c.tracker.delete(key)
+				return next.AppendHistogram(ref, newLbls, t, nil, fh)
+			}
+
+			cumulative, ok := c.tracker.addHistogram(key, t, fh)
+			if !ok {
+				return 0, nil
+			}
+			return next.AppendHistogram(ref, newLbls, t, nil, cumulative)
+		}),
+		prometheus.WithExemplarHook(func(ref storage.SeriesRef, l labels.Labels, e exemplar.Exemplar, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", opts.ID)
+			}
+
+			newLbls, _ := c.classify(l)
+			return next.AppendExemplar(forwardRef(ref, l, newLbls), newLbls, e)
+		}),
+		prometheus.WithMetadataHook(func(ref storage.SeriesRef, l labels.Labels, m metadata.Metadata, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", opts.ID)
+			}
+
+			newLbls, _ := c.classify(l)
+			return next.UpdateMetadata(forwardRef(ref, l, newLbls), newLbls, m)
+		}),
+	)
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	defer c.exited.Store(true)
+
+	c.mut.RLock()
+	interval := c.maxStale
+	c.mut.RUnlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.updated:
+			c.mut.RLock()
+			newInterval := c.maxStale
+			c.mut.RUnlock()
+
+			if newInterval != interval {
+				interval = newInterval
+				ticker.Reset(interval)
+			}
+		case <-ticker.C:
+			c.tracker.evictStale()
+		}
+	}
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	re, err := compileMetricNameRegex(newArgs.MetricNameRegex)
+	if err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	c.temporalityLabel = newArgs.TemporalityLabel
+	c.metricNameRegex = re
+	c.maxStale = newArgs.MaxStale
+	c.mut.Unlock()
+
+	c.tracker.setLimits(newArgs.MaxStale, newArgs.MaxStreams)
+	c.fanout.UpdateChildren(newArgs.ForwardTo)
+
+	select {
+	case c.updated <- struct{}{}:
+	default:
+	}
+
+	c.opts.OnStateChange(Exports{Receiver: c.receiver})
+	return nil
+}
+
+// classify reports whether the series with labels l is a delta series, and
+// returns the labels of the series to forward, without the temporality label.
+// The returned labels are l itself if l has no temporality label.
+func (c *Component) classify(l labels.Labels) (labels.Labels, bool) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	if c.temporalityLabel != "" {
+		if temporality := l.Get(c.temporalityLabel); temporality != "" {
+			return labels.NewBuilder(l).Del(c.temporalityLabel).Labels(), temporality == deltaTemporality
+		}
+	}
+	if c.metricNameRegex != nil && c.metricNameRegex.MatchString(l.Get(labels.MetricName)) {
+		return l, true
+	}
+	return l, false
+}
+
+// forwardRef returns the SeriesRef to forward for a series whose labels were
+// changed from l to newLbls.
+func forwardRef(ref storage.SeriesRef, l, newLbls labels.Labels) storage.SeriesRef {
+	// Since SeriesRefs are tied to the labels, we send zero to indicate the
+	// seriesRef should be recalculated downstream.
+	if l.Len() != newLbls.Len() {
+		return 0
+	}
+	return ref
+}
+
+func seriesKey(l labels.Labels) string {
+	return string(l.Bytes(nil))
+}
@@ -0,0 +1,147 @@
+package delta_to_cumulative
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+)
+
+type testSample struct {
+	val float64
+	l   labels.Labels
+}
+
+func TestArguments(t *testing.T) {
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(`forward_to = []`), &args))
+	require.Equal(t, "__temporality__", args.TemporalityLabel)
+	require.Equal(t, 5*time.Minute, args.MaxStale)
+	require.Equal(t, 100_000, args.MaxStreams)
+
+	cfg := `
+		forward_to        = []
+		temporality_label = ""
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), "at least one of temporality_label or metric_name_regex must be set")
+
+	cfg = `
+		forward_to        = []
+		metric_name_regex = "("
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), "invalid metric_name_regex")
+
+	cfg = `
+		forward_to  = []
+		max_streams = 0
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), "max_streams must be greater than 0")
+}
+
+func TestConvertsDeltas(t *testing.T) {
+	var (
+		actualSamples = make(chan testSample, 100)
+		args          Arguments
+	)
+	args.SetToDefault()
+	args.MetricNameRegex = "sdk_.*_delta"
+	args.ForwardTo = testAppendable(actualSamples)
+
+	comp, err := New(testOptions(t), args)
+	require.NoError(t, err)
+
+	var (
+		labelDelta = labels.FromStrings("__name__", "requests_total", "__temporality__", "delta")
+		labelCum   = labels.FromStrings("__name__", "errors_total", "__temporality__", "cumulative")
+		nameDelta  = labels.FromStrings("__name__", "sdk_requests_delta")
+		other      = labels.FromStrings("__name__", "up")
+	)
+
+	app := comp.receiver.Appender(t.Context())
+	for i, s := range []struct {
+		l labels.Labels
+		v float64
+	}{
+		{labelDelta, 1},
+		{labelDelta, 2},
+		{labelCum, 5},
+		{nameDelta, 10},
+		{nameDelta, 10},
+		{other, 1},
+		{labelDelta, math.Float64frombits(value.StaleNaN)},
+		{labelDelta, 4},
+	} {
+		_, err := app.Append(0, s.l, int64(i+1)*1000, s.v)
+		require.NoError(t, err)
+	}
+	require.NoError(t, app.Commit())
+
+	expected := []testSample{
+		{val: 1, l: labels.FromStrings("__name__", "requests_total")},
+		{val: 3, l: labels.FromStrings("__name__", "requests_total")},
+		{val: 5, l: labels.FromStrings("__name__", "errors_total")},
+		{val: 10, l: nameDelta},
+		{val: 20, l: nameDelta},
+		{val: 1, l: other},
+		{val: math.Float64frombits(value.StaleNaN), l: labels.FromStrings("__name__", "requests_total")},
+		// The staleness marker resets the series.
+		{val: 4, l: labels.FromStrings("__name__", "requests_total")},
+	}
+	for _, exp := range expected {
+		select {
+		case actual := <-actualSamples:
+			require.Equal(t, exp.l, actual.l)
+			if value.IsStaleNaN(exp.val) {
+				require.True(t, value.IsStaleNaN(actual.val))
+			} else {
+				require.Equal(t, exp.val, actual.val)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for sample %v", exp)
+		}
+	}
+}
+
+func testAppendable(actualSamples chan testSample) []storage.Appendable {
+	hookFn := func(
+		ref storage.SeriesRef,
+		l labels.Labels,
+		ts int64,
+		val float64,
+		next storage.Appender,
+	) (storage.SeriesRef, error) {
+
+		actualSamples <- testSample{val: val, l: l}
+		return ref, nil
+	}
+
+	ls := labelstore.New(nil, prometheus.DefaultRegisterer)
+	return []storage.Appendable{alloyprom.NewInterceptor(
+		nil,
+		ls,
+		alloyprom.WithAppendHook(
+			hookFn))}
+}
+
+func testOptions(t *testing.T) component.Options {
+	return component.Options{
+		ID:            "prometheus.delta_to_cumulative.test",
+		Logger:        util.TestAlloyLogger(t),
+		Registerer:    prometheus.NewRegistry(),
+		OnStateChange: func(component.Exports) {},
+		GetServiceData: func(name string) (interface{}, error) {
+			return labelstore.New(nil, prometheus.DefaultRegisterer), nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package delta_to_cumulative
+
+import (
+	"github.com/grafana/alloy/internal/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Values of the reason label of the streams evicted metric.
+const (
+	evictReasonStale = "stale"
+	evictReasonLimit = "limit"
+)
+
+type metrics struct {
+	streams        prometheus.Gauge
+	streamsEvicted *prometheus.CounterVec
+	samplesDropped prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	var m metrics
+
+	m.streams = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "prometheus_delta_to_cumulative_streams",
+		Help: "Number of delta series currently tracked.",
+	})
+	m.streamsEvicted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "prometheus_delta_to_cumulative_streams_evicted_total",
+		Help: "Total number of tracked delta series which were evicted.",
+	}, []string{"reason"})
+	m.samplesDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "prometheus_delta_to_cumulative_samples_dropped_total",
+		Help: "Total number of delta samples dropped because they were out of order.",
+	})
+
+	if r != nil {
+		m.streams = util.MustRegisterOrGet(r, m.streams).(prometheus.Gauge)
+		m.streamsEvicted = util.MustRegisterOrGet(r, m.streamsEvicted).(*prometheus.CounterVec)
+		m.samplesDropped = util.MustRegisterOrGet(r, m.samplesDropped).(prometheus.Counter)
+	}
+	return &m
+}
@@ -0,0 +1,190 @@
+package delta_to_cumulative
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/model/histogram"
+)
+
+// stream holds the cumulative state of a single delta series.
+type stream struct {
+	key      string
+	lastSeen time.Time
+	lastTs   int64
+
+	// Exactly one of value and fh is used, depending on isHistogram.
+	isHistogram bool
+	value       float64
+	fh          *histogram.FloatHistogram
+}
+
+// tracker accumulates delta samples into cumulative ones. It holds at most
+// maxStreams streams; when the limit is reached, the least recently updated
+// stream is evicted to make room for new ones.
+type tracker struct {
+	metrics *metrics
+	now     func() time.Time
+
+	mut        sync.Mutex
+	maxStale   time.Duration
+	maxStreams int
+	streams    map[string]*list.Element
+	// lru holds *stream values, most recently updated first.
+	lru *list.List
+}
+
+func newTracker(m *metrics, maxStale time.Duration, maxStreams int) *tracker {
+	return &tracker{
+		metrics:    m,
+		now:        time.Now,
+		maxStale:   maxStale,
+		maxStreams: maxStreams,
+		streams:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// setLimits updates the idle timeout and the maximum number of streams,
+// evicting streams if the new limit is lower than the number of tracked
+// streams.
+func (t *tracker) setLimits(maxStale time.Duration, maxStreams int) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	t.maxStale = maxStale
+	t.maxStreams = maxStreams
+	for t.lru.Len() > t.maxStreams {
+		t.evictOldest()
+	}
+	t.metrics.streams.Set(float64(t.lru.Len()))
+}
+
+// addFloat adds the delta v at timestamp ts to the stream identified by key
+// and returns the cumulative value. It returns false if the sample is older
+// than or as old as the latest sample of the stream and must be dropped.
+func (t *tracker) addFloat(key string, ts int64, v float64) (float64, bool) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	s, ok := t.get(key, false)
+	if ok && ts <= s.lastTs {
+		t.metrics.samplesDropped.Inc()
+		return 0, false
+	}
+
+	s.value += v
+	s.lastTs = ts
+	return s.value, true
+}
+
+// addHistogram adds the delta fh at timestamp ts to the stream identified by
+// key and returns the cumulative histogram. It returns false if the sample
+// must be dropped, either because it's out of order or because it can't be
+// added to the previous histogram.
+func (t *tracker) addHistogram(key string, ts int64, fh *histogram.FloatHistogram) (*histogram.FloatHistogram, bool) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	s, ok := t.get(key, true)
+	if ok && ts <= s.lastTs {
+		t.metrics.samplesDropped.Inc()
+		return nil, false
+	}
+
+	var sum *histogram.FloatHistogram
+	if s.fh == nil {
+		sum = fh.Copy()
+	} else {
+		var err error
+		sum, err = s.fh.Copy().Add(fh)
+		if err != nil {
+			// The bucket layouts are incompatible, for example because the
+			// custom bucket boundaries changed. Start over from this sample.
+			sum = fh.Copy()
+		}
+	}
+	sum.CounterResetHint = histogram.UnknownCounterReset
+
+	s.fh = sum
+	s.lastTs = ts
+	return sum.Copy(), true
+}
+
+// get returns the stream for key, creating it if it doesn't exist or has been
+// idle for longer than maxStale. The boolean result reports whether an
+// existing stream was found. The mut lock must be held when calling it.
+func (t *tracker) get(key string, isHistogram bool) (*stream, bool) {
+	now := t.now()
+
+	if elem, ok := t.streams[key]; ok {
+		s := elem.Value.(*stream)
+		// Reset streams which have been idle for too long, or which changed
+		// between float and histogram samples.
+		if now.Sub(s.lastSeen) <= t.maxStale && s.isHistogram == isHistogram {
+			s.lastSeen = now
+			t.lru.MoveToFront(elem)
+			return s, true
+		}
+		t.remove(elem)
+		t.metrics.streamsEvicted.WithLabelValues(evictReasonStale).Inc()
+	}
+
+	for t.lru.Len() >= t.maxStreams {
+		t.evictOldest()
+	}
+
+	s := &stream{key: key, lastSeen: now, isHistogram: isHistogram}
+	t.streams[key] = t.lru.PushFront(s)
+	t.metrics.streams.Set(float64(t.lru.Len()))
+	return s, false
+}
+
+// delete forgets about the stream identified by key.
+func (t *tracker) delete(key string) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	if elem, ok := t.streams[key]; ok {
+		t.remove(elem)
+		t.metrics.streams.Set(float64(t.lru.Len()))
+	}
+}
+
+// evictStale removes every stream which hasn't been updated for longer than
+// maxStale.
+func (t *tracker) evictStale() {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	now := t.now()
+	for elem := t.lru.Back(); elem != nil; {
+		s := elem.Value.(*stream)
+		if now.Sub(s.lastSeen) <= t.maxStale {
+			// Streams are ordered by the time they were last updated, so all
+			// remaining streams are recent enough.
+			break
+		}
+		prev := elem.Prev()
+		t.remove(elem)
+		t.metrics.streamsEvicted.WithLabelValues(evictReasonStale).Inc()
+		elem = prev
+	}
+	t.metrics.streams.Set(float64(t.lru.Len()))
+}
+
+// evictOldest removes the least recently updated stream. The mut lock must be
+// held when calling it.
+func (t *tracker) evictOldest() {
+	if elem := t.lru.Back(); elem != nil {
+		t.remove(elem)
+		t.metrics.streamsEvicted.WithLabelValues(evictReasonLimit).Inc()
+	}
+}
+
+// remove removes a stream. The mut lock must be held when calling it.
+func (t *tracker) remove(elem *list.Element) {
+	t.lru.Remove(elem)
+	delete(t.streams, elem.Value.(*stream).key)
+}
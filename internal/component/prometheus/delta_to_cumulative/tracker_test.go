@@ -0,0 +1,103 @@
+package delta_to_cumulative
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker_Float(t *testing.T) {
+	tr := newTracker(newMetrics(prometheus.NewRegistry()), 5*time.Minute, 10)
+
+	v, ok := tr.addFloat("a", 1000, 2)
+	require.True(t, ok)
+	require.Equal(t, 2.0, v)
+
+	v, ok = tr.addFloat("a", 2000, 3)
+	require.True(t, ok)
+	require.Equal(t, 5.0, v)
+
+	// Out of order and duplicate samples are dropped.
+	_, ok = tr.addFloat("a", 1500, 1)
+	require.False(t, ok)
+	_, ok = tr.addFloat("a", 2000, 1)
+	require.False(t, ok)
+	require.Equal(t, 2.0, testutil.ToFloat64(tr.metrics.samplesDropped))
+
+	// Streams are independent.
+	v, ok = tr.addFloat("b", 1000, 7)
+	require.True(t, ok)
+	require.Equal(t, 7.0, v)
+}
+
+func TestTracker_Histogram(t *testing.T) {
+	tr := newTracker(newMetrics(prometheus.NewRegistry()), 5*time.Minute, 10)
+
+	delta := &histogram.FloatHistogram{
+		Count:           2,
+		Sum:             3,
+		Schema:          0,
+		ZeroThreshold:   0.001,
+		PositiveSpans:   []histogram.Span{{Offset: 0, Length: 1}},
+		PositiveBuckets: []float64{2},
+	}
+
+	fh, ok := tr.addHistogram("a", 1000, delta)
+	require.True(t, ok)
+	require.Equal(t, 2.0, fh.Count)
+
+	fh, ok = tr.addHistogram("a", 2000, delta)
+	require.True(t, ok)
+	require.Equal(t, 4.0, fh.Count)
+	require.Equal(t, 6.0, fh.Sum)
+	require.Equal(t, []float64{4}, fh.PositiveBuckets)
+
+	// The input histogram isn't modified.
+	require.Equal(t, 2.0, delta.Count)
+}
+
+func TestTracker_MaxStale(t *testing.T) {
+	now := time.Now()
+	tr := newTracker(newMetrics(prometheus.NewRegistry()), time.Minute, 10)
+	tr.now = func() time.Time { return now }
+
+	_, _ = tr.addFloat("a", 1000, 2)
+	_, _ = tr.addFloat("b", 1000, 2)
+
+	// A stream which has been idle for longer than max_stale starts over.
+	now = now.Add(2 * time.Minute)
+	v, ok := tr.addFloat("a", 2000, 3)
+	require.True(t, ok)
+	require.Equal(t, 3.0, v)
+
+	// Stale streams are evicted.
+	now = now.Add(30 * time.Second)
+	tr.evictStale()
+	require.Len(t, tr.streams, 1)
+	require.Contains(t, tr.streams, "a")
+	require.Equal(t, 2.0, testutil.ToFloat64(tr.metrics.streamsEvicted.WithLabelValues(evictReasonStale)))
+}
+
+func TestTracker_MaxStreams(t *testing.T) {
+	tr := newTracker(newMetrics(prometheus.NewRegistry()), time.Minute, 2)
+
+	_, _ = tr.addFloat("a", 1000, 1)
+	_, _ = tr.addFloat("b", 1000, 1)
+	_, _ = tr.addFloat("a", 2000, 1)
+
+	// The least recently updated stream is evicted to make room.
+	_, _ = tr.addFloat("c", 1000, 1)
+	require.Len(t, tr.streams, 2)
+	require.NotContains(t, tr.streams, "b")
+	require.Equal(t, 1.0, testutil.ToFloat64(tr.metrics.streamsEvicted.WithLabelValues(evictReasonLimit)))
+
+	// Lowering the limit evicts streams immediately.
+	tr.setLimits(time.Minute, 1)
+	require.Len(t, tr.streams, 1)
+	require.Contains(t, tr.streams, "c")
+	require.Equal(t, 1.0, testutil.ToFloat64(tr.metrics.streams))
+}
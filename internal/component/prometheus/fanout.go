@@ -101,7 +101,9 @@ func (f *Fanout) Appender(ctx context.Context) storage.Appender {
 		if x == nil {
 			continue
 		}
-		app.children = append(app.children, x.Appender(ctx))
+		// Children which are the receivers of other components report their
+		// panics themselves; the rest only fail the append.
+		app.children = append(app.children, recoveringAppender{next: x.Appender(ctx)})
 	}
 	return app
 }
@@ -3,9 +3,11 @@ package prometheus
 import (
 	"testing"
 
+	"github.com/grafana/alloy/internal/component"
 	"github.com/grafana/alloy/internal/service/labelstore"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/storage"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +28,40 @@ func TestCommit(t *testing.T) {
 	err := app.Commit()
 	require.NoError(t, err)
 }
+
+func TestPanickingChild(t *testing.T) {
+	ls := labelstore.New(nil, prometheus.DefaultRegisterer)
+
+	var panics []*component.PanicError
+	unregister := component.RegisterPanicHandler("panicking", func(pe *component.PanicError) {
+		panics = append(panics, pe)
+	})
+	defer unregister()
+
+	panicking := NewInterceptor(nil, ls,
+		WithComponentID("panicking"),
+		WithAppendHook(func(storage.SeriesRef, labels.Labels, int64, float64, storage.Appender) (storage.SeriesRef, error) {
+			panic("oops")
+		}),
+	)
+	var appended int
+	healthy := NewInterceptor(nil, ls,
+		WithAppendHook(func(ref storage.SeriesRef, _ labels.Labels, _ int64, _ float64, _ storage.Appender) (storage.SeriesRef, error) {
+			appended++
+			return ref, nil
+		}),
+	)
+
+	fanout := NewFanout([]storage.Appendable{panicking, healthy}, "", prometheus.NewRegistry(), ls, NoopMetadataStore{})
+	app := fanout.Appender(t.Context())
+	_, err := app.Append(0, labels.FromStrings("__name__", "up"), 0, 1)
+
+	// The panic is returned to the sender and reported on the panicking
+	// component, and the other children still get the sample.
+	var pe *component.PanicError
+	require.ErrorAs(t, err, &pe)
+	require.Equal(t, "oops", pe.Value)
+	require.Len(t, panics, 1)
+	require.Equal(t, 1, appended)
+	require.NoError(t, app.Commit())
+}
@@ -111,7 +111,7 @@ func (f *Interceptor) Appender(ctx context.Context) storage.Appender {
 	if f.next != nil {
 		app.child = f.next.Appender(ctx)
 	}
-	return app
+	return recoveringAppender{next: app, componentID: f.componentID}
 }
 
 func (f *Interceptor) String() string {
@@ -0,0 +1,36 @@
+package receive_graphite
+
+import (
+	"github.com/grafana/alloy/internal/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	linesTotal       *prometheus.CounterVec
+	parseErrorsTotal *prometheus.CounterVec
+	connections      prometheus.Gauge
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	var m metrics
+
+	m.linesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "prometheus_receive_graphite_lines_total",
+		Help: "Total number of Graphite lines received.",
+	}, []string{"protocol"})
+	m.parseErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "prometheus_receive_graphite_parse_errors_total",
+		Help: "Total number of Graphite lines which could not be parsed.",
+	}, []string{"protocol"})
+	m.connections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "prometheus_receive_graphite_tcp_connections",
+		Help: "Current number of open TCP connections.",
+	})
+
+	if r != nil {
+		m.linesTotal = util.MustRegisterOrGet(r, m.linesTotal).(*prometheus.CounterVec)
+		m.parseErrorsTotal = util.MustRegisterOrGet(r, m.parseErrorsTotal).(*prometheus.CounterVec)
+		m.connections = util.MustRegisterOrGet(r, m.connections).(prometheus.Gauge)
+	}
+	return &m
+}
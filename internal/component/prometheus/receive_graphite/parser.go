@@ -0,0 +1,122 @@
+package receive_graphite
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/util/strutil"
+)
+
+// sample is a single parsed Graphite data point.
+type sample struct {
+	labels labels.Labels
+	ts     int64
+	value  float64
+}
+
+// parser converts Graphite plaintext lines into Prometheus samples.
+type parser struct {
+	templates []compiledTemplate
+	separator string
+	now       func() time.Time
+}
+
+// parseLine parses a single line of the Graphite plaintext protocol:
+//
+//	<metric path>[;tag=value...] <value> [<timestamp>]
+func (p *parser) parseLine(line string) (sample, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || len(fields) > 3 {
+		return sample{}, fmt.Errorf("expected 2 or 3 fields, got %d", len(fields))
+	}
+
+	value, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return sample{}, fmt.Errorf("invalid value %q: %w", fields[1], err)
+	}
+
+	ts := p.now().UnixMilli()
+	if len(fields) == 3 {
+		secs, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return sample{}, fmt.Errorf("invalid timestamp %q: %w", fields[2], err)
+		}
+		// Graphite clients conventionally send -1 to mean "now".
+		if secs >= 0 {
+			ts = int64(math.Round(secs * 1000))
+		}
+	}
+
+	path, tags, err := splitTags(fields[0])
+	if err != nil {
+		return sample{}, err
+	}
+	nodes := strings.Split(path, ".")
+	for _, n := range nodes {
+		if n == "" {
+			return sample{}, fmt.Errorf("metric path %q contains an empty node", path)
+		}
+	}
+
+	lbls := make(map[string]string, len(tags)+1)
+	name := ""
+	matched := false
+	for i := range p.templates {
+		if p.templates[i].matches(nodes) {
+			name = p.templates[i].apply(nodes, p.separator, lbls)
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		name = strings.Join(nodes, p.separator)
+	}
+	if name == "" {
+		return sample{}, fmt.Errorf("metric path %q produced an empty metric name", path)
+	}
+
+	// Tags sent on the wire take precedence over labels from templates.
+	for k, v := range tags {
+		lbls[k] = v
+	}
+
+	b := labels.NewScratchBuilder(len(lbls) + 1)
+	b.Add(model.MetricNameLabel, strutil.SanitizeFullLabelName(name))
+	for k, v := range lbls {
+		b.Add(k, v)
+	}
+	b.Sort()
+
+	return sample{labels: b.Labels(), ts: ts, value: value}, nil
+}
+
+// splitTags splits a tagged Graphite metric path ("path;k=v;k2=v2") into its
+// path and tags.
+func splitTags(s string) (string, map[string]string, error) {
+	path, rest, found := strings.Cut(s, ";")
+	if !found {
+		return path, nil, nil
+	}
+	tags := make(map[string]string)
+	for _, kv := range strings.Split(rest, ";") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" || v == "" {
+			return "", nil, fmt.Errorf("invalid tag %q", kv)
+		}
+		if k == "name" {
+			// Graphite reserves the "name" tag for the metric path itself.
+			continue
+		}
+		name := strutil.SanitizeFullLabelName(k)
+		if strings.HasPrefix(name, model.ReservedLabelPrefix) {
+			return "", nil, fmt.Errorf("tag %q is reserved", k)
+		}
+		tags[name] = v
+	}
+	return path, tags, nil
+}
@@ -0,0 +1,175 @@
+package receive_graphite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLine(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name      string
+		templates []Template
+		line      string
+		expected  sample
+		err       string
+	}{
+		{
+			name: "plain path",
+			line: "servers.host-1.cpu.load 1.5 1600000000",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "servers_host_1_cpu_load"),
+				ts:     1600000000000,
+				value:  1.5,
+			},
+		},
+		{
+			name: "missing timestamp uses now",
+			line: "foo.bar 2",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "foo_bar"),
+				ts:     now.UnixMilli(),
+				value:  2,
+			},
+		},
+		{
+			name: "negative timestamp uses now",
+			line: "foo.bar 2 -1",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "foo_bar"),
+				ts:     now.UnixMilli(),
+				value:  2,
+			},
+		},
+		{
+			name: "tags",
+			line: "disk.used;datacenter=dc1;rack.id=a1 42 1600000000",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "disk_used", "datacenter", "dc1", "rack_id", "a1"),
+				ts:     1600000000000,
+				value:  42,
+			},
+		},
+		{
+			name: "template",
+			templates: []Template{
+				{Filter: "servers.*", Template: ".host.measurement*", Labels: map[string]string{"source": "collectd"}},
+			},
+			line: "servers.web01.cpu.user 10 1600000000",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "cpu_user", "host", "web01", "source", "collectd"),
+				ts:     1600000000000,
+				value:  10,
+			},
+		},
+		{
+			name: "first matching template wins",
+			templates: []Template{
+				{Filter: "stats.*.counters", Template: ".env.type.measurement*"},
+				{Template: "measurement*"},
+			},
+			line: "stats.prod.counters.requests 5 1600000000",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "requests", "env", "prod", "type", "counters"),
+				ts:     1600000000000,
+				value:  5,
+			},
+		},
+		{
+			name: "tags override template labels",
+			templates: []Template{
+				{Template: "host.measurement"},
+			},
+			line: "web01.uptime;host=override 1 1600000000",
+			expected: sample{
+				labels: labels.FromStrings("__name__", "uptime", "host", "override"),
+				ts:     1600000000000,
+				value:  1,
+			},
+		},
+		{
+			name: "too few fields",
+			line: "foo.bar",
+			err:  "expected 2 or 3 fields, got 1",
+		},
+		{
+			name: "invalid value",
+			line: "foo.bar abc",
+			err:  `invalid value "abc"`,
+		},
+		{
+			name: "invalid tag",
+			line: "foo.bar;baz 1",
+			err:  `invalid tag "baz"`,
+		},
+		{
+			name: "reserved tag",
+			line: "foo.bar;__name__=baz 1",
+			err:  `tag "__name__" is reserved`,
+		},
+		{
+			name: "reserved sanitized tag",
+			line: "foo.bar;__meta.host=baz 1",
+			err:  `tag "__meta.host" is reserved`,
+		},
+		{
+			name: "empty node",
+			line: "foo..bar 1",
+			err:  "contains an empty node",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			templates, err := compileTemplates(tc.templates)
+			require.NoError(t, err)
+			p := &parser{
+				templates: templates,
+				separator: "_",
+				now:       func() time.Time { return now },
+			}
+
+			actual, err := p.parseLine(tc.line)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTemplateValidate(t *testing.T) {
+	tests := []struct {
+		template Template
+		err      string
+	}{
+		{template: Template{Template: "host.measurement*"}},
+		{template: Template{Template: ""}, err: "template must not be empty"},
+		{template: Template{Template: "host.region"}, err: `must contain at least one "measurement" part`},
+		{template: Template{Template: "measurement*.host"}, err: `"measurement*" may only be used as the last part`},
+		{template: Template{Template: "my-host.measurement"}, err: `invalid label name "my-host"`},
+		{template: Template{Filter: "a..b", Template: "measurement"}, err: "contains an empty node"},
+		{template: Template{Template: "measurement", Labels: map[string]string{"1abc": "x"}}, err: `invalid label name "1abc"`},
+		{template: Template{Template: "__name__.measurement"}, err: `label name "__name__" is reserved`},
+		{template: Template{Template: "__host.measurement"}, err: `label name "__host" is reserved`},
+		{template: Template{Template: "measurement", Labels: map[string]string{"__name__": "x"}}, err: `label name "__name__" is reserved`},
+		{template: Template{Template: "measurement", Labels: map[string]string{"__meta_host": "x"}}, err: `label name "__meta_host" is reserved`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.template.Template, func(t *testing.T) {
+			err := tc.template.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package receive_graphite
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/component"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service/labelstore"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "prometheus.receive_graphite",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments holds values which are used to configure the
+// prometheus.receive_graphite component.
+type Arguments struct {
+	ListenTCP string               `alloy:"listen_tcp,attr,optional"`
+	ListenUDP string               `alloy:"listen_udp,attr,optional"`
+	Separator string               `alloy:"separator,attr,optional"`
+	Templates []Template           `alloy:"template,block,optional"`
+	ForwardTo []storage.Appendable `alloy:"forward_to,attr"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		ListenTCP: "0.0.0.0:2003",
+		Separator: "_",
+	}
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	if args.ListenTCP == "" && args.ListenUDP == "" {
+		return fmt.Errorf("at least one of listen_tcp or listen_udp must be set")
+	}
+	if args.Separator == "" {
+		return fmt.Errorf("separator must not be empty")
+	}
+	_, err := compileTemplates(args.Templates)
+	return err
+}
+
+// Component implements the prometheus.receive_graphite component.
+type Component struct {
+	opts    component.Options
+	fanout  *alloyprom.Fanout
+	metrics *metrics
+
+	updateMut sync.Mutex
+	args      Arguments
+	server    *server
+}
+
+var _ component.Component = (*Component)(nil)
+
+// New creates a new prometheus.receive_graphite component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	service, err := opts.GetServiceData(labelstore.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	ls := service.(labelstore.LabelStore)
+
+	c := &Component{
+		opts:    opts,
+		fanout:  alloyprom.NewFanout(args.ForwardTo, opts.ID, opts.Registerer, ls, alloyprom.NoopMetadataStore{}),
+		metrics: newMetrics(opts.Registerer),
+	}
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run satisfies the Component interface.
+func (c *Component) Run(ctx context.Context) error {
+	defer func() {
+		c.updateMut.Lock()
+		defer c.updateMut.Unlock()
+		c.shutdownServer()
+	}()
+
+	<-ctx.Done()
+	level.Info(c.opts.Logger).Log("msg", "terminating due to context done")
+	return nil
+}
+
+// Update satisfies the Component interface.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+	c.fanout.UpdateChildren(newArgs.ForwardTo)
+
+	templates, err := compileTemplates(newArgs.Templates)
+	if err != nil {
+		return err
+	}
+	p := &parser{
+		templates: templates,
+		separator: newArgs.Separator,
+		now:       time.Now,
+	}
+
+	c.updateMut.Lock()
+	defer c.updateMut.Unlock()
+
+	if c.server != nil && c.args.ListenTCP == newArgs.ListenTCP && c.args.ListenUDP == newArgs.ListenUDP {
+		c.server.setParser(p)
+		c.args = newArgs
+		return nil
+	}
+	c.shutdownServer()
+
+	s, err := newServer(c.opts.Logger, c.fanout, c.metrics, p, newArgs.ListenTCP, newArgs.ListenUDP)
+	if err != nil {
+		return err
+	}
+	c.server = s
+	c.args = newArgs
+	return nil
+}
+
+// shutdownServer stops the currently running server. The updateMut lock
+// must be held when calling it.
+func (c *Component) shutdownServer() {
+	if c.server != nil {
+		c.server.stop()
+		c.server = nil
+	}
+}
@@ -0,0 +1,222 @@
+package receive_graphite
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+)
+
+type testSample struct {
+	ts  int64
+	val float64
+	l   labels.Labels
+}
+
+func TestArguments(t *testing.T) {
+	cfg := `
+		listen_tcp = "127.0.0.1:2003"
+		listen_udp = "127.0.0.1:2003"
+		template {
+			filter   = "servers.*"
+			template = ".host.measurement*"
+			labels   = { "source" = "collectd" }
+		}
+		forward_to = []
+	`
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+	require.Equal(t, "127.0.0.1:2003", args.ListenTCP)
+	require.Equal(t, "127.0.0.1:2003", args.ListenUDP)
+	require.Equal(t, "_", args.Separator)
+	require.Len(t, args.Templates, 1)
+	require.Equal(t, map[string]string{"source": "collectd"}, args.Templates[0].Labels)
+
+	cfg = `
+		listen_tcp = ""
+		forward_to = []
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), "at least one of listen_tcp or listen_udp must be set")
+
+	cfg = `
+		template {
+			template = "host"
+		}
+		forward_to = []
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), `must contain at least one "measurement" part`)
+}
+
+func TestForwardsMetrics(t *testing.T) {
+	for _, protocol := range []string{protocolTCP, protocolUDP} {
+		t.Run(protocol, func(t *testing.T) {
+			actualSamples := make(chan testSample, 100)
+			addr := fmt.Sprintf("127.0.0.1:%d", getFreePort(t))
+
+			args := Arguments{
+				Separator: "_",
+				Templates: []Template{{Filter: "servers.*", Template: ".host.measurement*"}},
+				ForwardTo: testAppendable(actualSamples),
+			}
+			if protocol == protocolTCP {
+				args.ListenTCP = addr
+			} else {
+				args.ListenUDP = addr
+			}
+
+			comp, err := New(testOptions(t), args)
+			require.NoError(t, err)
+			go func() {
+				require.NoError(t, comp.Run(t.Context()))
+			}()
+
+			conn, err := net.Dial(protocol, addr)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("servers.web01.cpu.user 10 1600000000\nnot a valid line\ndisk.used;dc=eu 42 1600000001\n"))
+			require.NoError(t, err)
+
+			expected := []testSample{
+				{ts: 1600000000000, val: 10, l: labels.FromStrings("__name__", "cpu_user", "host", "web01")},
+				{ts: 1600000001000, val: 42, l: labels.FromStrings("__name__", "disk_used", "dc", "eu")},
+			}
+			for _, exp := range expected {
+				select {
+				case actual := <-actualSamples:
+					require.Equal(t, exp, actual)
+				case <-time.After(5 * time.Second):
+					t.Fatalf("timed out waiting for sample %v", exp)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateTemplates(t *testing.T) {
+	actualSamples := make(chan testSample, 100)
+	addr := fmt.Sprintf("127.0.0.1:%d", getFreePort(t))
+
+	args := Arguments{
+		ListenTCP: addr,
+		Separator: "_",
+		ForwardTo: testAppendable(actualSamples),
+	}
+	comp, err := New(testOptions(t), args)
+	require.NoError(t, err)
+	go func() {
+		require.NoError(t, comp.Run(t.Context()))
+	}()
+
+	send := func(line string) testSample {
+		conn, err := net.Dial("tcp", addr)
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = conn.Write([]byte(line + "\n"))
+		require.NoError(t, err)
+
+		select {
+		case s := <-actualSamples:
+			return s
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for sample")
+		}
+		return testSample{}
+	}
+
+	require.Equal(t, labels.FromStrings("__name__", "web01_load"), send("web01.load 1 1600000000").l)
+
+	args.Templates = []Template{{Template: "host.measurement"}}
+	require.NoError(t, comp.Update(args))
+
+	require.Equal(t, labels.FromStrings("__name__", "load", "host", "web01"), send("web01.load 1 1600000000").l)
+}
+
+func TestLongLineClosesConnection(t *testing.T) {
+	actualSamples := make(chan testSample, 100)
+	addr := fmt.Sprintf("127.0.0.1:%d", getFreePort(t))
+
+	comp, err := New(testOptions(t), Arguments{
+		ListenTCP: addr,
+		Separator: "_",
+		ForwardTo: testAppendable(actualSamples),
+	})
+	require.NoError(t, err)
+	go func() {
+		require.NoError(t, comp.Run(t.Context()))
+	}()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("foo.bar 1 1600000000\n" + strings.Repeat("a", maxLineSize+1)))
+	require.NoError(t, err)
+
+	select {
+	case s := <-actualSamples:
+		require.Equal(t, labels.FromStrings("__name__", "foo_bar"), s.l)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sample")
+	}
+
+	// The server closes the connection instead of buffering the line. The
+	// close may be seen as a reset since the line wasn't read entirely.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(t, err)
+	var netErr net.Error
+	require.False(t, errors.As(err, &netErr) && netErr.Timeout(), "connection wasn't closed: %s", err)
+}
+
+func testAppendable(actualSamples chan testSample) []storage.Appendable {
+	hookFn := func(
+		ref storage.SeriesRef,
+		l labels.Labels,
+		ts int64,
+		val float64,
+		next storage.Appender,
+	) (storage.SeriesRef, error) {
+
+		actualSamples <- testSample{ts: ts, val: val, l: l}
+		return ref, nil
+	}
+
+	ls := labelstore.New(nil, prometheus.DefaultRegisterer)
+	return []storage.Appendable{alloyprom.NewInterceptor(
+		nil,
+		ls,
+		alloyprom.WithAppendHook(
+			hookFn))}
+}
+
+func testOptions(t *testing.T) component.Options {
+	return component.Options{
+		ID:         "prometheus.receive_graphite.test",
+		Logger:     util.TestAlloyLogger(t),
+		Registerer: prometheus.NewRegistry(),
+		GetServiceData: func(name string) (interface{}, error) {
+			return labelstore.New(nil, prometheus.DefaultRegisterer), nil
+		},
+	}
+}
+
+func getFreePort(t *testing.T) int {
+	p, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,242 @@
+package receive_graphite
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+const (
+	protocolTCP = "tcp"
+	protocolUDP = "udp"
+
+	// maxUDPPacketSize is the largest UDP payload accepted.
+	maxUDPPacketSize = 65535
+	// maxLineSize is the longest line accepted over TCP, including the
+	// newline. Connections sending longer lines are closed.
+	maxLineSize = 64 * 1024
+)
+
+// server accepts Graphite plaintext lines over TCP and UDP and appends the
+// parsed samples to an appendable.
+type server struct {
+	logger     log.Logger
+	appendable storage.Appendable
+	metrics    *metrics
+
+	parserMut sync.RWMutex
+	parser    *parser
+
+	tcpListener net.Listener
+	udpConn     net.PacketConn
+
+	connsMut sync.Mutex
+	conns    map[net.Conn]struct{}
+
+	wg sync.WaitGroup
+}
+
+func newServer(logger log.Logger, appendable storage.Appendable, m *metrics, p *parser, listenTCP, listenUDP string) (*server, error) {
+	s := &server{
+		logger:     logger,
+		appendable: appendable,
+		metrics:    m,
+		parser:     p,
+		conns:      make(map[net.Conn]struct{}),
+	}
+
+	if listenTCP != "" {
+		l, err := net.Listen("tcp", listenTCP)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen on TCP address %q: %w", listenTCP, err)
+		}
+		s.tcpListener = l
+	}
+	if listenUDP != "" {
+		conn, err := net.ListenPacket("udp", listenUDP)
+		if err != nil {
+			if s.tcpListener != nil {
+				_ = s.tcpListener.Close()
+			}
+			return nil, fmt.Errorf("failed to listen on UDP address %q: %w", listenUDP, err)
+		}
+		s.udpConn = conn
+	}
+
+	if s.tcpListener != nil {
+		level.Info(logger).Log("msg", "listening for Graphite lines", "protocol", protocolTCP, "address", s.tcpListener.Addr().String())
+		s.wg.Add(1)
+		go s.serveTCP()
+	}
+	if s.udpConn != nil {
+		level.Info(logger).Log("msg", "listening for Graphite lines", "protocol", protocolUDP, "address", s.udpConn.LocalAddr().String())
+		s.wg.Add(1)
+		go s.serveUDP()
+	}
+	return s, nil
+}
+
+func (s *server) setParser(p *parser) {
+	s.parserMut.Lock()
+	defer s.parserMut.Unlock()
+	s.parser = p
+}
+
+func (s *server) getParser() *parser {
+	s.parserMut.RLock()
+	defer s.parserMut.RUnlock()
+	return s.parser
+}
+
+// stop closes all listeners and open connections and waits for all
+// goroutines to exit.
+func (s *server) stop() {
+	if s.tcpListener != nil {
+		_ = s.tcpListener.Close()
+	}
+	if s.udpConn != nil {
+		_ = s.udpConn.Close()
+	}
+
+	s.connsMut.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.connsMut.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *server) serveTCP() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.tcpListener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				level.Error(s.logger).Log("msg", "failed to accept TCP connection", "err", err)
+			}
+			return
+		}
+
+		s.connsMut.Lock()
+		s.conns[conn] = struct{}{}
+		s.connsMut.Unlock()
+		s.metrics.connections.Inc()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.connsMut.Lock()
+				delete(s.conns, conn)
+				s.connsMut.Unlock()
+				s.metrics.connections.Dec()
+				_ = conn.Close()
+			}()
+			s.handleConn(conn)
+		}()
+	}
+}
+
+// handleConn reads lines from a TCP connection. Samples are committed
+// whenever no more data is immediately available, so that long-lived
+// connections which send infrequently don't hold samples back. The connection
+// is closed if a line is longer than maxLineSize, so that a client can't make
+// the buffer grow without bound.
+func (s *server) handleConn(conn net.Conn) {
+	r := bufio.NewReaderSize(conn, maxLineSize)
+	app := s.appendable.Appender(context.Background())
+	pending := 0
+
+	commit := func() {
+		if pending == 0 {
+			return
+		}
+		if err := app.Commit(); err != nil {
+			level.Error(s.logger).Log("msg", "failed to commit Graphite samples", "err", err)
+		}
+		app = s.appendable.Appender(context.Background())
+		pending = 0
+	}
+	defer commit()
+
+	for {
+		line, err := r.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			s.metrics.linesTotal.WithLabelValues(protocolTCP).Inc()
+			s.metrics.parseErrorsTotal.WithLabelValues(protocolTCP).Inc()
+			level.Warn(s.logger).Log("msg", "closing TCP connection which sent a line longer than the maximum line size", "remote", conn.RemoteAddr().String(), "max_line_size", maxLineSize)
+			return
+		}
+		if len(line) > 0 {
+			if s.handleLine(app, string(line), protocolTCP) {
+				pending++
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				level.Debug(s.logger).Log("msg", "error reading from TCP connection", "remote", conn.RemoteAddr().String(), "err", err)
+			}
+			return
+		}
+		if r.Buffered() == 0 {
+			commit()
+		}
+	}
+}
+
+func (s *server) serveUDP() {
+	defer s.wg.Done()
+
+	buf := make([]byte, maxUDPPacketSize)
+	for {
+		n, _, err := s.udpConn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				level.Error(s.logger).Log("msg", "failed to read UDP packet", "err", err)
+			}
+			return
+		}
+
+		app := s.appendable.Appender(context.Background())
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			s.handleLine(app, line, protocolUDP)
+		}
+		if err := app.Commit(); err != nil {
+			level.Error(s.logger).Log("msg", "failed to commit Graphite samples", "err", err)
+		}
+	}
+}
+
+// handleLine parses a single line and appends it to app. It returns true if
+// a sample was appended.
+func (s *server) handleLine(app storage.Appender, line, protocol string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return false
+	}
+	s.metrics.linesTotal.WithLabelValues(protocol).Inc()
+
+	sample, err := s.getParser().parseLine(line)
+	if err != nil {
+		s.metrics.parseErrorsTotal.WithLabelValues(protocol).Inc()
+		level.Debug(s.logger).Log("msg", "failed to parse Graphite line", "line", line, "err", err)
+		return false
+	}
+	if _, err := app.Append(0, sample.labels, sample.ts, sample.value); err != nil {
+		level.Debug(s.logger).Log("msg", "failed to append Graphite sample", "line", line, "err", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package receive_graphite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/prometheus/util/strutil"
+)
+
+const (
+	templatePartMeasurement    = "measurement"
+	templatePartMeasurementAll = "measurement*"
+)
+
+// Template configures how a Graphite metric path is split into a metric
+// name and a set of labels.
+type Template struct {
+	// Filter is a dot-separated pattern which must match the metric path for
+	// the template to be applied. A "*" matches any single node. An empty
+	// filter matches every path.
+	Filter string `alloy:"filter,attr,optional"`
+	// Template is a dot-separated list of parts describing each node of the
+	// metric path.
+	Template string `alloy:"template,attr"`
+	// Labels are static labels added to every series matched by the
+	// template.
+	Labels map[string]string `alloy:"labels,attr,optional"`
+}
+
+// Validate checks that the template and filter are well-formed.
+func (t *Template) Validate() error {
+	if t.Template == "" {
+		return fmt.Errorf("template must not be empty")
+	}
+	parts := strings.Split(t.Template, ".")
+	hasMeasurement := false
+	for i, p := range parts {
+		switch p {
+		case templatePartMeasurement:
+			hasMeasurement = true
+		case templatePartMeasurementAll:
+			if i != len(parts)-1 {
+				return fmt.Errorf("template %q: %q may only be used as the last part", t.Template, templatePartMeasurementAll)
+			}
+			hasMeasurement = true
+		case "":
+		default:
+			if err := validateLabelName(p); err != nil {
+				return fmt.Errorf("template %q: %w", t.Template, err)
+			}
+		}
+	}
+	if !hasMeasurement {
+		return fmt.Errorf("template %q must contain at least one %q part", t.Template, templatePartMeasurement)
+	}
+	if t.Filter != "" {
+		for _, node := range strings.Split(t.Filter, ".") {
+			if node == "" {
+				return fmt.Errorf("filter %q contains an empty node", t.Filter)
+			}
+		}
+	}
+	for name := range t.Labels {
+		if err := validateLabelName(name); err != nil {
+			return fmt.Errorf("template %q: %w", t.Template, err)
+		}
+	}
+	return nil
+}
+
+// validateLabelName checks that name is a valid label name which templates
+// may set. Names starting with "__", including the metric name label, are
+// reserved for internal use.
+func validateLabelName(name string) error {
+	if strutil.SanitizeFullLabelName(name) != name {
+		return fmt.Errorf("invalid label name %q", name)
+	}
+	if strings.HasPrefix(name, "__") {
+		return fmt.Errorf("label name %q is reserved", name)
+	}
+	return nil
+}
+
+// compiledTemplate is a validated Template ready to be matched against
+// metric paths.
+type compiledTemplate struct {
+	filter []string
+	parts  []string
+	labels map[string]string
+}
+
+func compileTemplates(templates []Template) ([]compiledTemplate, error) {
+	res := make([]compiledTemplate, 0, len(templates))
+	for _, t := range templates {
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+		ct := compiledTemplate{
+			parts:  strings.Split(t.Template, "."),
+			labels: t.Labels,
+		}
+		if t.Filter != "" {
+			ct.filter = strings.Split(t.Filter, ".")
+		}
+		res = append(res, ct)
+	}
+	return res, nil
+}
+
+// matches reports whether the filter of the template matches the given
+// metric path nodes. A filter matches when each of its nodes matches the
+// corresponding node of the path; the path may be longer than the filter.
+func (t *compiledTemplate) matches(nodes []string) bool {
+	if len(t.filter) > len(nodes) {
+		return false
+	}
+	for i, f := range t.filter {
+		if f != "*" && f != nodes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// apply splits nodes into a metric name and a set of labels according to
+// the template. Nodes without a corresponding template part are dropped.
+func (t *compiledTemplate) apply(nodes []string, sep string, lbls map[string]string) string {
+	var name []string
+	for i, p := range t.parts {
+		if i >= len(nodes) {
+			break
+		}
+		switch p {
+		case "":
+			// Skip the node.
+		case templatePartMeasurement:
+			name = append(name, nodes[i])
+		case templatePartMeasurementAll:
+			name = append(name, nodes[i:]...)
+		default:
+			if prev, ok := lbls[p]; ok {
+				lbls[p] = prev + sep + nodes[i]
+			} else {
+				lbls[p] = nodes[i]
+			}
+		}
+	}
+	for k, v := range t.labels {
+		if _, ok := lbls[k]; !ok {
+			lbls[k] = v
+		}
+	}
+	return strings.Join(name, sep)
+}
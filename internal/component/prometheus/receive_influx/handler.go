@@ -0,0 +1,263 @@
+package receive_influx
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/influxdata/line-protocol/v2/lineprotocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/util/strutil"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/util"
+)
+
+// valueField is the field name which, following the Telegraf Prometheus
+// output convention, isn't appended to the metric name.
+const valueField = "value"
+
+type sample struct {
+	labels labels.Labels
+	ts     int64
+	value  float64
+}
+
+type handler struct {
+	logger     log.Logger
+	appendable storage.Appendable
+	now        func() time.Time
+
+	pointsTotal   prometheus.Counter
+	samplesTotal  prometheus.Counter
+	requestErrors *prometheus.CounterVec
+}
+
+func newHandler(logger log.Logger, reg prometheus.Registerer, appendable storage.Appendable) *handler {
+	h := &handler{
+		logger:     logger,
+		appendable: appendable,
+		now:        time.Now,
+
+		pointsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "prometheus_receive_influx_points_total",
+			Help: "Total number of line protocol points received.",
+		}),
+		samplesTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "prometheus_receive_influx_samples_total",
+			Help: "Total number of samples produced from line protocol points.",
+		}),
+		requestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "prometheus_receive_influx_request_errors_total",
+			Help: "Total number of write requests which failed.",
+		}, []string{"reason"}),
+	}
+
+	if reg != nil {
+		h.pointsTotal = util.MustRegisterOrGet(reg, h.pointsTotal).(prometheus.Counter)
+		h.samplesTotal = util.MustRegisterOrGet(reg, h.samplesTotal).(prometheus.Counter)
+		h.requestErrors = util.MustRegisterOrGet(reg, h.requestErrors).(*prometheus.CounterVec)
+	}
+	return h
+}
+
+// handleV1 serves the InfluxDB 1.x /write endpoint.
+func (h *handler) handleV1(w http.ResponseWriter, r *http.Request) {
+	prec, err := parseV1Precision(r.URL.Query().Get("precision"))
+	if err != nil {
+		h.requestErrors.WithLabelValues("bad_request").Inc()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.handle(w, r, prec)
+}
+
+// handleV2 serves the InfluxDB 2.x /api/v2/write endpoint. The org and
+// bucket parameters are accepted but ignored.
+func (h *handler) handleV2(w http.ResponseWriter, r *http.Request) {
+	prec, err := parseV2Precision(r.URL.Query().Get("precision"))
+	if err != nil {
+		h.requestErrors.WithLabelValues("bad_request").Inc()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.handle(w, r, prec)
+}
+
+func handlePing(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) handle(w http.ResponseWriter, r *http.Request, prec lineprotocol.Precision) {
+	body := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			h.requestErrors.WithLabelValues("bad_request").Inc()
+			http.Error(w, fmt.Sprintf("invalid gzip body: %s", err), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+
+	samples, err := h.parse(body, prec)
+	if err != nil {
+		h.requestErrors.WithLabelValues("bad_request").Inc()
+		level.Debug(h.logger).Log("msg", "failed to parse line protocol", "err", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	app := h.appendable.Appender(r.Context())
+	for _, s := range samples {
+		if _, err := app.Append(0, s.labels, s.ts, s.value); err != nil {
+			_ = app.Rollback()
+			h.requestErrors.WithLabelValues("append").Inc()
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if err := app.Commit(); err != nil {
+		h.requestErrors.WithLabelValues("append").Inc()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.samplesTotal.Add(float64(len(samples)))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parse decodes line protocol points from r and converts every numeric or
+// boolean field into a sample. String fields are ignored.
+func (h *handler) parse(r io.Reader, prec lineprotocol.Precision) ([]sample, error) {
+	var (
+		now     = h.now()
+		dec     = lineprotocol.NewDecoder(r)
+		samples []sample
+		tags    = make(map[string]string)
+	)
+
+	for dec.Next() {
+		h.pointsTotal.Inc()
+
+		measurement, err := dec.Measurement()
+		if err != nil {
+			return nil, err
+		}
+		clear(tags)
+		for {
+			key, value, err := dec.NextTag()
+			if err != nil {
+				return nil, err
+			}
+			if key == nil {
+				break
+			}
+			name := strutil.SanitizeFullLabelName(string(key))
+			if name == model.MetricNameLabel {
+				continue
+			}
+			tags[name] = string(value)
+		}
+
+		type field struct {
+			name  string
+			value float64
+		}
+		var fields []field
+		for {
+			key, value, err := dec.NextField()
+			if err != nil {
+				return nil, err
+			}
+			if key == nil {
+				break
+			}
+			v, ok := fieldValue(value)
+			if !ok {
+				continue
+			}
+			fields = append(fields, field{name: string(key), value: v})
+		}
+
+		ts, err := dec.Time(prec, now)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range fields {
+			name := string(measurement)
+			if f.name != valueField {
+				name += "_" + f.name
+			}
+
+			b := labels.NewScratchBuilder(len(tags) + 1)
+			b.Add(model.MetricNameLabel, strutil.SanitizeFullLabelName(name))
+			for k, v := range tags {
+				b.Add(k, v)
+			}
+			b.Sort()
+
+			samples = append(samples, sample{labels: b.Labels(), ts: ts.UnixMilli(), value: f.value})
+		}
+	}
+	if err := dec.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+func fieldValue(v lineprotocol.Value) (float64, bool) {
+	switch v.Kind() {
+	case lineprotocol.Float:
+		return v.FloatV(), true
+	case lineprotocol.Int:
+		return float64(v.IntV()), true
+	case lineprotocol.Uint:
+		return float64(v.UintV()), true
+	case lineprotocol.Bool:
+		if v.BoolV() {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func parseV1Precision(s string) (lineprotocol.Precision, error) {
+	switch s {
+	case "", "n", "ns":
+		return lineprotocol.Nanosecond, nil
+	case "u", "us":
+		return lineprotocol.Microsecond, nil
+	case "ms":
+		return lineprotocol.Millisecond, nil
+	case "s":
+		return lineprotocol.Second, nil
+	default:
+		return 0, fmt.Errorf("unsupported precision %q", s)
+	}
+}
+
+func parseV2Precision(s string) (lineprotocol.Precision, error) {
+	switch s {
+	case "", "ns":
+		return lineprotocol.Nanosecond, nil
+	case "us":
+		return lineprotocol.Microsecond, nil
+	case "ms":
+		return lineprotocol.Millisecond, nil
+	case "s":
+		return lineprotocol.Second, nil
+	default:
+		return 0, fmt.Errorf("unsupported precision %q", s)
+	}
+}
@@ -0,0 +1,163 @@
+package receive_influx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/component"
+	fnet "github.com/grafana/alloy/internal/component/common/net"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/util"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "prometheus.receive_influx",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments holds values which are used to configure the
+// prometheus.receive_influx component.
+type Arguments struct {
+	Server    *fnet.ServerConfig   `alloy:",squash"`
+	ForwardTo []storage.Appendable `alloy:"forward_to,attr"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		Server: fnet.DefaultServerConfig(),
+	}
+}
+
+// Component implements the prometheus.receive_influx component.
+type Component struct {
+	opts               component.Options
+	handler            *handler
+	fanout             *alloyprom.Fanout
+	uncheckedCollector *util.UncheckedCollector
+
+	updateMut sync.RWMutex
+	args      Arguments
+	server    *fnet.TargetServer
+}
+
+var _ component.Component = (*Component)(nil)
+
+// New creates a new prometheus.receive_influx component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	service, err := opts.GetServiceData(labelstore.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	ls := service.(labelstore.LabelStore)
+	fanout := alloyprom.NewFanout(args.ForwardTo, opts.ID, opts.Registerer, ls, alloyprom.NoopMetadataStore{})
+
+	uncheckedCollector := util.NewUncheckedCollector(nil)
+	opts.Registerer.MustRegister(uncheckedCollector)
+
+	c := &Component{
+		opts:               opts,
+		handler:            newHandler(opts.Logger, opts.Registerer, fanout),
+		fanout:             fanout,
+		uncheckedCollector: uncheckedCollector,
+	}
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run satisfies the Component interface.
+func (c *Component) Run(ctx context.Context) error {
+	defer func() {
+		c.updateMut.Lock()
+		defer c.updateMut.Unlock()
+		c.shutdownServer()
+	}()
+
+	<-ctx.Done()
+	level.Info(c.opts.Logger).Log("msg", "terminating due to context done")
+	return nil
+}
+
+// Update satisfies the Component interface.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+	c.fanout.UpdateChildren(newArgs.ForwardTo)
+
+	c.updateMut.Lock()
+	defer c.updateMut.Unlock()
+
+	serverNeedsUpdate := !reflect.DeepEqual(c.args.Server, newArgs.Server)
+	if !serverNeedsUpdate {
+		c.args = newArgs
+		return nil
+	}
+	c.shutdownServer()
+
+	s, err := c.createNewServer(newArgs)
+	if err != nil {
+		return err
+	}
+	c.server = s
+
+	err = c.server.MountAndRun(func(router *mux.Router) {
+		router.Path("/write").Methods(http.MethodPost).HandlerFunc(c.handler.handleV1)
+		router.Path("/api/v2/write").Methods(http.MethodPost).HandlerFunc(c.handler.handleV2)
+		router.Path("/ping").Methods(http.MethodGet, http.MethodHead).HandlerFunc(handlePing)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.args = newArgs
+	return nil
+}
+
+func (c *Component) createNewServer(args Arguments) (*fnet.TargetServer, error) {
+	// [server.Server] registers new metrics every time it is created. To
+	// avoid issues with re-registering metrics with the same name, we create a
+	// new registry for the server every time we create one, and pass it to an
+	// unchecked collector to bypass uniqueness checking.
+	serverRegistry := prometheus.NewRegistry()
+	c.uncheckedCollector.SetCollector(serverRegistry)
+
+	s, err := fnet.NewTargetServer(
+		c.opts.Logger,
+		"prometheus_receive_influx",
+		serverRegistry,
+		args.Server,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create server: %v", err)
+	}
+
+	return s, nil
+}
+
+// shutdownServer will shut down the currently used server.
+// It is not goroutine-safe and an updateMut write lock must be held when it's called.
+func (c *Component) shutdownServer() {
+	if c.server != nil {
+		c.server.StopAndShutdown()
+		c.server = nil
+	}
+}
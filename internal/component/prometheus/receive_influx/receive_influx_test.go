@@ -0,0 +1,169 @@
+package receive_influx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/line-protocol/v2/lineprotocol"
+	"github.com/phayes/freeport"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	fnet "github.com/grafana/alloy/internal/component/common/net"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/util"
+)
+
+type testSample struct {
+	ts  int64
+	val float64
+	l   labels.Labels
+}
+
+func TestParse(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	h := newHandler(util.TestAlloyLogger(t), nil, nil)
+	h.now = func() time.Time { return now }
+
+	input := strings.Join([]string{
+		`cpu,host=web01,cpu=cpu-total usage_user=1.5,usage_system=2i,state="ok" 1600000000000000000`,
+		`mem,host=web01 value=42u,ok=true`,
+		`disk\ io,host\ name=a\,b reads=3 1600000001000000000`,
+	}, "\n")
+
+	samples, err := h.parse(strings.NewReader(input), lineprotocol.Nanosecond)
+	require.NoError(t, err)
+	require.Equal(t, []sample{
+		{labels: labels.FromStrings("__name__", "cpu_usage_user", "cpu", "cpu-total", "host", "web01"), ts: 1600000000000, value: 1.5},
+		{labels: labels.FromStrings("__name__", "cpu_usage_system", "cpu", "cpu-total", "host", "web01"), ts: 1600000000000, value: 2},
+		{labels: labels.FromStrings("__name__", "mem", "host", "web01"), ts: now.UnixMilli(), value: 42},
+		{labels: labels.FromStrings("__name__", "mem_ok", "host", "web01"), ts: now.UnixMilli(), value: 1},
+		{labels: labels.FromStrings("__name__", "disk_io_reads", "host_name", "a,b"), ts: 1600000001000, value: 3},
+	}, samples)
+
+	_, err = h.parse(strings.NewReader("cpu,host=web01"), lineprotocol.Nanosecond)
+	require.Error(t, err)
+}
+
+func TestForwardsMetrics(t *testing.T) {
+	actualSamples := make(chan testSample, 100)
+	port := getFreePort(t)
+
+	args := Arguments{
+		Server: &fnet.ServerConfig{
+			HTTP: &fnet.HTTPConfig{
+				ListenAddress: "localhost",
+				ListenPort:    port,
+			},
+			GRPC: &fnet.GRPCConfig{ListenAddress: "127.0.0.1", ListenPort: getFreePort(t)},
+		},
+		ForwardTo: testAppendable(actualSamples),
+	}
+	comp, err := New(testOptions(t), args)
+	require.NoError(t, err)
+	go func() {
+		require.NoError(t, comp.Run(t.Context()))
+	}()
+
+	baseURL := fmt.Sprintf("http://localhost:%d", port)
+
+	// Wait for the server to be ready.
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(baseURL + "/ping")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusNoContent
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// InfluxDB 1.x endpoint with second precision.
+	resp, err := http.Post(baseURL+"/write?db=telegraf&precision=s", "text/plain", strings.NewReader("load,host=a value=1.5 1600000000\n"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Equal(t, testSample{ts: 1600000000000, val: 1.5, l: labels.FromStrings("__name__", "load", "host", "a")}, receive(t, actualSamples))
+
+	// InfluxDB 2.x endpoint with a gzipped body.
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write([]byte("net,iface=eth0 bytes_recv=10i 1600000000000\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/api/v2/write?org=o&bucket=b&precision=ms", &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Equal(t, testSample{ts: 1600000000000, val: 10, l: labels.FromStrings("__name__", "net_bytes_recv", "iface", "eth0")}, receive(t, actualSamples))
+
+	// Invalid input is rejected.
+	resp, err = http.Post(baseURL+"/write", "text/plain", strings.NewReader("not line protocol\n"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(baseURL+"/write?precision=h", "text/plain", strings.NewReader("load value=1\n"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func receive(t *testing.T, ch chan testSample) testSample {
+	select {
+	case s := <-ch:
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sample")
+	}
+	return testSample{}
+}
+
+func testAppendable(actualSamples chan testSample) []storage.Appendable {
+	hookFn := func(
+		ref storage.SeriesRef,
+		l labels.Labels,
+		ts int64,
+		val float64,
+		next storage.Appender,
+	) (storage.SeriesRef, error) {
+
+		actualSamples <- testSample{ts: ts, val: val, l: l}
+		return ref, nil
+	}
+
+	ls := labelstore.New(nil, prometheus.DefaultRegisterer)
+	return []storage.Appendable{alloyprom.NewInterceptor(
+		nil,
+		ls,
+		alloyprom.WithAppendHook(
+			hookFn))}
+}
+
+func testOptions(t *testing.T) component.Options {
+	return component.Options{
+		ID:         "prometheus.receive_influx.test",
+		Logger:     util.TestAlloyLogger(t),
+		Registerer: prometheus.NewRegistry(),
+		GetServiceData: func(name string) (interface{}, error) {
+			return labelstore.New(nil, prometheus.DefaultRegisterer), nil
+		},
+	}
+}
+
+func getFreePort(t *testing.T) int {
+	p, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return p
+}
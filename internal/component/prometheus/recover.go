@@ -0,0 +1,71 @@
+package prometheus
+
+import (
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/metadata"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+// recoveringAppender is a storage.Appender which recovers the panics of the
+// appender it wraps. Appenders run in the goroutine of the component sending
+// the samples, so without it a panicking receiver would take down the sender,
+// and the other children of its Fanout, with it.
+//
+// Recovered panics are returned as a *component.PanicError and reported on the
+// component with the given global ID, if it's set.
+type recoveringAppender struct {
+	next        storage.Appender
+	componentID string
+}
+
+var _ storage.Appender = recoveringAppender{}
+
+func (a recoveringAppender) SetOptions(opts *storage.AppendOptions) {
+	var err error
+	defer component.RecoverPanic(a.componentID, &err)
+	a.next.SetOptions(opts)
+}
+
+func (a recoveringAppender) Append(ref storage.SeriesRef, l labels.Labels, t int64, v float64) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.Append(ref, l, t, v)
+}
+
+func (a recoveringAppender) Commit() (err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.Commit()
+}
+
+func (a recoveringAppender) Rollback() (err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.Rollback()
+}
+
+func (a recoveringAppender) AppendExemplar(ref storage.SeriesRef, l labels.Labels, e exemplar.Exemplar) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.AppendExemplar(ref, l, e)
+}
+
+func (a recoveringAppender) UpdateMetadata(ref storage.SeriesRef, l labels.Labels, m metadata.Metadata) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.UpdateMetadata(ref, l, m)
+}
+
+func (a recoveringAppender) AppendHistogram(ref storage.SeriesRef, l labels.Labels, t int64, h *histogram.Histogram, fh *histogram.FloatHistogram) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.AppendHistogram(ref, l, t, h, fh)
+}
+
+func (a recoveringAppender) AppendCTZeroSample(ref storage.SeriesRef, l labels.Labels, t, ct int64) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.AppendCTZeroSample(ref, l, t, ct)
+}
+
+func (a recoveringAppender) AppendHistogramCTZeroSample(ref storage.SeriesRef, l labels.Labels, t, ct int64, h *histogram.Histogram, fh *histogram.FloatHistogram) (_ storage.SeriesRef, err error) {
+	defer component.RecoverPanic(a.componentID, &err)
+	return a.next.AppendHistogramCTZeroSample(ref, l, t, ct, h, fh)
+}
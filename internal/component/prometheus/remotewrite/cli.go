@@ -2,12 +2,21 @@ package remotewrite
 
 import (
 	"fmt"
+	"io"
+	"math"
+	neturl "net/url"
 	"os"
 	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/grafana/alloy/internal/static/agentctl/waltools"
 	"github.com/olekukonko/tablewriter"
+	config_util "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	promconfig "github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/model/timestamp"
+	"github.com/prometheus/prometheus/storage/remote"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +27,9 @@ func InstallTools(cmd *cobra.Command) {
 		samplesCmd(),
 		targetStatsCmd(),
 		walStatsCmd(),
+		walExportCmd(),
+		walReplayCmd(),
+		walCheckCmd(),
 	)
 }
 
@@ -203,6 +215,291 @@ deletion but then comes back at some point).`,
 	}
 }
 
+func walExportCmd() *cobra.Command {
+	var (
+		selector string
+		format   string
+		output   string
+		from     string
+		to       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wal-export [WAL directory]",
+		Short: "Export series and samples from the WAL",
+		Long: `wal-export reads a WAL directory and writes the series matching a label
+selector, along with their samples, to a file or to stdout.
+
+Two output formats are supported:
+
+openmetrics:  The OpenMetrics text format, with a timestamp on every sample.
+              Native histograms and staleness markers are omitted.
+remote-write: A single snappy-compressed Prometheus remote write request, as
+              it would be sent over the wire.
+
+All matching data is held in memory while exporting. Use a label selector or
+a time range to limit the amount of data read from large WALs.
+
+Examples:
+
+Export the 'up' series as OpenMetrics text:
+
+wal-export -s up /tmp/wal
+
+
+Export all series within 'job=a' as a remote write request:
+
+wal-export -s '{job="a"}' --format remote-write -o request.bin /tmp/wal
+`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			directory, err := walDirectory(args[0])
+			if err != nil {
+				return err
+			}
+
+			opts, err := exportOptions(selector, from, to)
+			if err != nil {
+				return err
+			}
+
+			var write func(io.Writer, []*waltools.ExportedSeries) error
+			switch format {
+			case "openmetrics":
+				write = waltools.WriteOpenMetrics
+			case "remote-write":
+				write = waltools.WriteRemoteWriteRequest
+			default:
+				return fmt.Errorf("unsupported format %q, expected openmetrics or remote-write", format)
+			}
+
+			series, err := waltools.ExportSeries(directory, opts)
+			if err != nil {
+				return fmt.Errorf("failed to export series: %w", err)
+			}
+
+			if output == "" || output == "-" {
+				if err := write(os.Stdout, series); err != nil {
+					return fmt.Errorf("failed to write series: %w", err)
+				}
+				return nil
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			if err := write(f, series); err != nil {
+				_ = f.Close()
+				return fmt.Errorf("failed to write series: %w", err)
+			}
+			// Closing the file reports write errors which weren't reported
+			// by write itself.
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to close output file: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "s", "{}", "label selector to search for")
+	cmd.Flags().StringVarP(&format, "format", "f", "openmetrics", "output format, one of openmetrics or remote-write")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write to, defaults to stdout")
+	cmd.Flags().StringVar(&from, "from", "", "only export samples at or after this RFC3339 timestamp")
+	cmd.Flags().StringVar(&to, "to", "", "only export samples at or before this RFC3339 timestamp")
+	return cmd
+}
+
+func walReplayCmd() *cobra.Command {
+	var (
+		selector        string
+		from            string
+		to              string
+		url             string
+		headers         map[string]string
+		bearerTokenFile string
+		timeout         time.Duration
+		batchSize       int
+		maxRetries      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wal-replay --url URL [WAL directory]",
+		Short: "Send the data in the WAL to a remote write endpoint",
+		Long: `wal-replay reads a WAL directory and sends the series matching a label
+selector, along with their samples, to a Prometheus remote write endpoint.
+
+The WAL doesn't record which samples were already delivered, so wal-replay
+sends every sample within the selected time range. Use --from and --to to
+limit replay to the time range of an outage.
+
+The WAL must not be in use by a running Alloy instance.
+
+Examples:
+
+Replay samples written during an outage to a Mimir tenant:
+
+wal-replay --url http://mimir:9009/api/v1/push --header X-Scope-OrgID=tenant \
+  --from 2024-01-01T10:00:00Z --to 2024-01-01T12:00:00Z /tmp/wal
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			directory, err := walDirectory(args[0])
+			if err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+
+			opts, err := exportOptions(selector, from, to)
+			if err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+
+			endpoint, err := neturl.Parse(url)
+			if err != nil {
+				fmt.Printf("invalid URL: %v\n", err)
+				os.Exit(1)
+			}
+			client, err := remote.NewWriteClient("wal-replay", &remote.ClientConfig{
+				URL:     &config_util.URL{URL: endpoint},
+				Timeout: model.Duration(timeout),
+				HTTPClientConfig: config_util.HTTPClientConfig{
+					BearerTokenFile: bearerTokenFile,
+				},
+				Headers:       headers,
+				WriteProtoMsg: promconfig.RemoteWriteProtoMsgV1,
+			})
+			if err != nil {
+				fmt.Printf("failed to create client: %v\n", err)
+				os.Exit(1)
+			}
+
+			stats, err := waltools.Replay(cmd.Context(), directory, client, waltools.ReplayOptions{
+				ExportOptions: opts,
+				BatchSize:     batchSize,
+				MaxRetries:    maxRetries,
+				MinBackoff:    time.Second,
+			})
+			fmt.Printf("Series Sent:        %d\n", stats.Series)
+			fmt.Printf("Samples Sent:       %d\n", stats.Samples)
+			fmt.Printf("Histograms Sent:    %d\n", stats.Histograms)
+			fmt.Printf("Requests Sent:      %d\n", stats.Requests)
+			if err != nil {
+				fmt.Printf("failed to replay WAL: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "s", "{}", "label selector to search for")
+	cmd.Flags().StringVar(&from, "from", "", "only send samples at or after this RFC3339 timestamp")
+	cmd.Flags().StringVar(&to, "to", "", "only send samples at or before this RFC3339 timestamp")
+	cmd.Flags().StringVar(&url, "url", "", "remote write endpoint to send samples to")
+	cmd.Flags().StringToStringVar(&headers, "header", nil, "extra HTTP header to send, in the form key=value")
+	cmd.Flags().StringVar(&bearerTokenFile, "bearer-token-file", "", "file containing a bearer token to authenticate with")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "timeout for each request")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 2000, "maximum number of samples per request")
+	cmd.Flags().IntVar(&maxRetries, "max-retries", 5, "maximum number of retries for a request after a recoverable error")
+	must(cmd.MarkFlagRequired("url"))
+	return cmd
+}
+
+func walCheckCmd() *cobra.Command {
+	var repair bool
+
+	cmd := &cobra.Command{
+		Use:   "wal-check [WAL directory]",
+		Short: "Validate the WAL and optionally repair it",
+		Long: `wal-check reads every record in a WAL directory and reports the first
+corruption found, if any.
+
+With --repair, the corrupted segment is truncated at the corruption and all
+newer segments are deleted. Data after the corruption is lost. Corruptions
+within a checkpoint can't be repaired.
+
+The WAL must not be in use by a running Alloy instance.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			directory, err := walDirectory(args[0])
+			if err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+
+			res, err := waltools.Check(directory)
+			if err != nil {
+				fmt.Printf("failed to check WAL: %v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("Checkpoint:         %s\n", res.Checkpoint)
+			fmt.Printf("First Segment:      %d\n", res.FirstSegment)
+			fmt.Printf("Latest Segment:     %d\n", res.LastSegment)
+			fmt.Printf("Valid Records:      %d\n", res.Records)
+
+			if res.Corruption == nil {
+				fmt.Printf("\nNo corruption found.\n")
+				return
+			}
+			fmt.Printf("\nCorruption found: %v\n", res.Corruption)
+
+			if !repair {
+				fmt.Printf("Run with --repair to truncate the WAL at the corruption.\n")
+				os.Exit(1)
+			}
+			if err := waltools.Repair(directory, res.Corruption); err != nil {
+				fmt.Printf("failed to repair WAL: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("WAL repaired.\n")
+		},
+	}
+
+	cmd.Flags().BoolVar(&repair, "repair", false, "truncate the WAL at the first corruption")
+	return cmd
+}
+
+// walDirectory validates that directory exists, and returns its wal
+// subdirectory if there is one.
+func walDirectory(directory string) (string, error) {
+	if _, err := os.Stat(directory); os.IsNotExist(err) {
+		return "", fmt.Errorf("%s does not exist", directory)
+	} else if err != nil {
+		return "", fmt.Errorf("error getting wal: %w", err)
+	}
+
+	// Check if ./wal is a subdirectory, use that instead.
+	if _, err := os.Stat(filepath.Join(directory, "wal")); err == nil {
+		directory = filepath.Join(directory, "wal")
+	}
+	return directory, nil
+}
+
+func exportOptions(selector, from, to string) (waltools.ExportOptions, error) {
+	opts := waltools.ExportOptions{
+		Selector: selector,
+		MinTime:  math.MinInt64,
+		MaxTime:  math.MaxInt64,
+	}
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return opts, fmt.Errorf("invalid --from timestamp: %w", err)
+		}
+		opts.MinTime = timestamp.FromTime(t)
+	}
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return opts, fmt.Errorf("invalid --to timestamp: %w", err)
+		}
+		opts.MaxTime = timestamp.FromTime(t)
+	}
+	return opts, nil
+}
+
 func must(err error) {
 	if err != nil {
 		panic(err)
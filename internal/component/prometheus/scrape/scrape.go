@@ -46,6 +46,7 @@ func init() {
 		Name:      "prometheus.scrape",
 		Stability: featuregate.StabilityGenerallyAvailable,
 		Args:      Arguments{},
+		Exports:   Exports{},
 
 		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
 			return New(opts, args.(Arguments))
@@ -142,6 +143,8 @@ type Arguments struct {
 	HonorMetadata bool `alloy:"honor_metadata,attr,optional"`
 
 	Clustering cluster.ComponentBlock `alloy:"clustering,block,optional"`
+
+	TargetStatus TargetStatusArguments `alloy:"target_status,block,optional"`
 }
 
 // SetToDefault implements syntax.Defaulter.
@@ -164,6 +167,7 @@ func (arg *Arguments) SetToDefault() {
 		EnableCompression:              true,
 		NativeHistogramBucketLimit:     0,
 		NativeHistogramMinBucketFactor: 0,
+		TargetStatus:                   DefaultTargetStatusArguments,
 	}
 }
 
@@ -398,10 +402,18 @@ func (c *Component) Run(ctx context.Context) error {
 		}
 	}()
 
+	var (
+		statusState   targetStatusState
+		statusRefresh <-chan time.Time
+	)
+	defer statusState.stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case <-statusRefresh:
+			c.refreshTargetStatus(ctx, &statusState)
 		case <-c.reloadTargets:
 			c.mut.RLock()
 			var (
@@ -411,11 +423,19 @@ func (c *Component) Run(ctx context.Context) error {
 			)
 			c.mut.RUnlock()
 
+			statusRefresh = statusState.apply(args.TargetStatus)
+			if statusRefresh == nil {
+				// Clear any previously exported status and write staleness
+				// markers for the up series of dropped targets.
+				c.refreshTargetStatus(ctx, &statusState)
+			}
+
 			if args.JobName != "" {
 				jobName = c.args.JobName
 			}
 
 			newTargetGroups, movedTargets := c.distributeTargets(targets, jobName, args)
+			newTargetGroups = statusState.limitTargets(args, jobName, newTargetGroups)
 
 			// Make sure the targets that moved to another instance are NOT marked as stale. This is specific to how
 			// Prometheus handles marking series as stale: it is the client's responsibility to inject the
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	prometheus_client "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
 	"github.com/prometheus/prometheus/model/exemplar"
 	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/model/labels"
@@ -1016,3 +1018,143 @@ func TestScrapingAllMetricTypes(t *testing.T) {
 
 	t.Logf("Successfully scraped %d samples with %d metadata entries and %d histograms", len(actualSamples), len(actualMetadata), len(actualHistograms))
 }
+
+func TestTargetStatusExports(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	reg := prometheus_client.NewRegistry()
+	srv := httptest.NewServer(promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	defer srv.Close()
+
+	// Reserve an address with nothing listening on it for the down target.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	downAddr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	upAddr := strings.TrimPrefix(srv.URL, "http://")
+
+	exports := make(chan Exports, 10)
+	opts := component.Options{
+		Logger:     util.TestAlloyLogger(t),
+		Registerer: prometheus_client.NewRegistry(),
+		OnStateChange: func(e component.Exports) {
+			exports <- e.(Exports)
+		},
+		GetServiceData: func(name string) (interface{}, error) {
+			switch name {
+			case http_service.ServiceName:
+				return http_service.Data{
+					HTTPListenAddr:   "localhost:12345",
+					MemoryListenAddr: "alloy.internal:1245",
+					BaseHTTPPath:     "/",
+					DialFunc:         (&net.Dialer{}).DialContext,
+				}, nil
+			case cluster.ServiceName:
+				return cluster.Mock(), nil
+			case labelstore.ServiceName:
+				return labelstore.New(nil, prometheus_client.DefaultRegisterer), nil
+			case livedebugging.ServiceName:
+				return livedebugging.NewLiveDebugging(), nil
+			default:
+				return nil, fmt.Errorf("service %q does not exist", name)
+			}
+		},
+	}
+
+	var args Arguments
+	args.SetToDefault()
+	args.Targets = []discovery.Target{
+		discovery.NewTargetFromMap(map[string]string{"__address__": upAddr}),
+		discovery.NewTargetFromMap(map[string]string{"__address__": downAddr}),
+	}
+	args.JobName = "test_job"
+	args.ScrapeInterval = 50 * time.Millisecond
+	args.ScrapeTimeout = 25 * time.Millisecond
+	args.TargetStatus.Enabled = true
+	args.TargetStatus.RefreshInterval = 50 * time.Millisecond
+	require.NoError(t, args.Validate())
+
+	c, err := New(opts, args)
+	require.NoError(t, err)
+	go c.Run(ctx)
+
+	var last Exports
+	require.Eventually(t, func() bool {
+		for {
+			select {
+			case last = <-exports:
+			default:
+				return len(last.UpTargets) == 1 && len(last.DownTargets) == 1
+			}
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+
+	up := last.UpTargets[0].AsMap()
+	require.Equal(t, upAddr, up["instance"])
+	require.Equal(t, "test_job", up["job"])
+	require.Equal(t, "up", up[healthLabel])
+	require.Equal(t, srv.URL+"/metrics", up[scrapeURLLabel])
+	require.NotContains(t, up, lastErrorLabel)
+
+	down := last.DownTargets[0].AsMap()
+	require.Equal(t, downAddr, down["instance"])
+	require.Equal(t, "down", down[healthLabel])
+	require.NotEmpty(t, down[lastErrorLabel])
+}
+
+func TestBuildDroppedSeries(t *testing.T) {
+	sc := getPromScrapeConfigs("test_job", Arguments{})
+	targets := map[string][]*scrape.Target{
+		"test_job": {
+			scrape.NewTarget(labels.EmptyLabels(), sc, model.LabelSet{
+				"__address__": "localhost:9090",
+				"__meta_foo":  "bar",
+				"team":        "a",
+			}, nil),
+		},
+	}
+
+	series := buildDroppedSeries(targets)
+	require.Equal(t, []labels.Labels{
+		labels.FromStrings("__name__", "up", "instance", "localhost:9090", "job", "test_job", "team", "a"),
+	}, series)
+}
+
+func TestLimitTargets(t *testing.T) {
+	groups := map[string][]*targetgroup.Group{
+		"test_job": {{
+			Targets: []model.LabelSet{
+				{"__address__": "localhost:9090"},
+				{"__address__": "localhost:9091", "instance": "custom"},
+			},
+			Labels: model.LabelSet{"team": "a", "__meta_foo": "bar"},
+		}},
+	}
+
+	var args Arguments
+	args.SetToDefault()
+	args.TargetStatus.Enabled = true
+	args.TargetStatus.ReportDroppedTargets = true
+
+	// Targets within the limit are passed to the scrape manager.
+	var state targetStatusState
+	args.TargetLimit = 2
+	require.Equal(t, groups, state.limitTargets(args, "test_job", groups))
+	require.Empty(t, state.limitedSeries)
+
+	// Targets exceeding the limit are withheld and reported as dropped.
+	args.TargetLimit = 1
+	require.Equal(t, map[string][]*targetgroup.Group{"test_job": {}}, state.limitTargets(args, "test_job", groups))
+	require.Equal(t, []labels.Labels{
+		labels.FromStrings("__name__", "up", "instance", "localhost:9090", "job", "test_job", "team", "a"),
+		labels.FromStrings("__name__", "up", "instance", "custom", "job", "test_job", "team", "a"),
+	}, state.limitedSeries)
+
+	// Targets are scraped with a forced error if dropped targets aren't
+	// reported.
+	args.TargetStatus.ReportDroppedTargets = false
+	require.Equal(t, groups, state.limitTargets(args, "test_job", groups))
+	require.Empty(t, state.limitedSeries)
+}
@@ -0,0 +1,310 @@
+package scrape
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/scrape"
+
+	"github.com/grafana/alloy/internal/component/discovery"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// Labels added to the targets exported by prometheus.scrape to describe the
+// status of their latest scrape.
+const (
+	healthLabel         = "__scrape_health__"
+	lastErrorLabel      = "__scrape_last_error__"
+	scrapeURLLabel      = "__scrape_url__"
+	droppedUpMetricName = "up"
+)
+
+// TargetStatusArguments configures the target status exports of the
+// prometheus.scrape component.
+type TargetStatusArguments struct {
+	// Whether to export the status of scrape targets.
+	Enabled bool `alloy:"enabled,attr,optional"`
+	// How often the exported target status is refreshed.
+	RefreshInterval time.Duration `alloy:"refresh_interval,attr,optional"`
+	// Whether to write an up series with a value of 0 for targets which were
+	// dropped before being scraped.
+	ReportDroppedTargets bool `alloy:"report_dropped_targets,attr,optional"`
+}
+
+// DefaultTargetStatusArguments holds the default settings for the
+// target_status block.
+var DefaultTargetStatusArguments = TargetStatusArguments{
+	Enabled:         false,
+	RefreshInterval: 1 * time.Minute,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *TargetStatusArguments) SetToDefault() {
+	*args = DefaultTargetStatusArguments
+}
+
+// Validate implements syntax.Validator.
+func (args *TargetStatusArguments) Validate() error {
+	if args.RefreshInterval <= 0 {
+		return fmt.Errorf("target_status refresh_interval must be greater than 0")
+	}
+	return nil
+}
+
+// Exports holds values which are exported by the prometheus.scrape component.
+type Exports struct {
+	UpTargets   []discovery.Target `alloy:"up_targets,attr"`
+	DownTargets []discovery.Target `alloy:"down_targets,attr"`
+}
+
+// targetStatusState tracks the state of the target status exports between
+// refreshes. It's only accessed from the Run goroutine.
+type targetStatusState struct {
+	ticker   *time.Ticker
+	interval time.Duration
+	exports  Exports
+
+	// droppedSeries holds the label sets of the up series written for
+	// dropped targets during the last refresh, keyed by their hash.
+	droppedSeries map[uint64]labels.Labels
+	// limitedSeries holds the label sets of the up series to write for
+	// targets which were withheld from the scrape manager because they
+	// exceeded target_limit.
+	limitedSeries []labels.Labels
+}
+
+// apply updates the refresh ticker for the given settings and returns the
+// channel on which refreshes should happen, or nil if target status exports
+// are disabled.
+func (s *targetStatusState) apply(args TargetStatusArguments) <-chan time.Time {
+	if !args.Enabled {
+		if s.ticker != nil {
+			s.ticker.Stop()
+			s.ticker = nil
+			s.interval = 0
+		}
+		return nil
+	}
+
+	switch {
+	case s.ticker == nil:
+		s.ticker = time.NewTicker(args.RefreshInterval)
+	case s.interval != args.RefreshInterval:
+		// Only reset the ticker when the interval changes; resetting it on
+		// every target update would delay refreshes indefinitely for
+		// frequently changing targets.
+		s.ticker.Reset(args.RefreshInterval)
+	}
+	s.interval = args.RefreshInterval
+	return s.ticker.C
+}
+
+// limitTargets withholds the targets of job from the scrape manager if
+// dropped targets are reported and the number of targets exceeds
+// target_limit, so that they're reported as dropped instead of being
+// scraped with a forced error.
+func (s *targetStatusState) limitTargets(args Arguments, job string, groups map[string][]*targetgroup.Group) map[string][]*targetgroup.Group {
+	s.limitedSeries = nil
+	if !args.TargetStatus.Enabled || !args.TargetStatus.ReportDroppedTargets || args.TargetLimit == 0 {
+		return groups
+	}
+
+	var count uint
+	for _, g := range groups[job] {
+		count += uint(len(g.Targets))
+	}
+	if count <= args.TargetLimit {
+		return groups
+	}
+
+	lb := labels.NewBuilder(labels.EmptyLabels())
+	for _, g := range groups[job] {
+		for _, t := range g.Targets {
+			lb.Reset(labels.EmptyLabels())
+			for name, value := range g.Labels {
+				lb.Set(string(name), string(value))
+			}
+			for name, value := range t {
+				lb.Set(string(name), string(value))
+			}
+			s.limitedSeries = append(s.limitedSeries, buildUpSeries(job, lb.Labels(), lb))
+		}
+	}
+	return map[string][]*targetgroup.Group{job: {}}
+}
+
+func (s *targetStatusState) stop() {
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+}
+
+// refreshTargetStatus updates the exports of the component with the status of
+// the active targets, and writes up series for dropped targets if enabled.
+func (c *Component) refreshTargetStatus(ctx context.Context, state *targetStatusState) {
+	c.mut.RLock()
+	args := c.args.TargetStatus
+	c.mut.RUnlock()
+
+	var exports Exports
+	if args.Enabled {
+		exports = buildTargetStatusExports(c.scraper.TargetsActive())
+	}
+	if !exportsEqual(state.exports, exports) {
+		state.exports = exports
+		c.opts.OnStateChange(exports)
+	}
+
+	var dropped []labels.Labels
+	if args.Enabled && args.ReportDroppedTargets {
+		dropped = append(buildDroppedSeries(c.scraper.TargetsDropped()), state.limitedSeries...)
+	}
+	if err := c.writeDroppedSeries(ctx, state, dropped); err != nil {
+		level.Warn(c.opts.Logger).Log("msg", "failed to write up series for dropped targets", "err", err)
+	}
+}
+
+// buildTargetStatusExports splits the active targets by the health of their
+// latest scrape. Targets which haven't been scraped yet are omitted.
+func buildTargetStatusExports(targets map[string][]*scrape.Target) Exports {
+	var up, down []labels.Labels
+
+	lb := labels.NewBuilder(labels.EmptyLabels())
+	for _, stt := range targets {
+		for _, st := range stt {
+			if st == nil {
+				continue
+			}
+
+			health := st.Health()
+			if health == scrape.HealthUnknown {
+				continue
+			}
+
+			lb.Reset(st.Labels(lb))
+			lb.Set(healthLabel, string(health))
+			lb.Set(scrapeURLLabel, st.URL().String())
+			if err := st.LastError(); err != nil {
+				lb.Set(lastErrorLabel, err.Error())
+			}
+
+			if health == scrape.HealthGood {
+				up = append(up, lb.Labels())
+			} else {
+				down = append(down, lb.Labels())
+			}
+		}
+	}
+
+	return Exports{
+		UpTargets:   toSortedTargets(up),
+		DownTargets: toSortedTargets(down),
+	}
+}
+
+// buildDroppedSeries returns the label sets of the up series to write for
+// targets which were dropped before being scraped.
+func buildDroppedSeries(targets map[string][]*scrape.Target) []labels.Labels {
+	var res []labels.Labels
+
+	lb := labels.NewBuilder(labels.EmptyLabels())
+	for job, stt := range targets {
+		for _, st := range stt {
+			if st == nil {
+				continue
+			}
+
+			res = append(res, buildUpSeries(job, st.DiscoveredLabels(lb), lb))
+		}
+	}
+	return res
+}
+
+// buildUpSeries returns the label set of the up series for a target of job
+// with the given discovered labels. Labels starting with a double underscore
+// are removed, and the instance label defaults to the address of the target.
+func buildUpSeries(job string, discovered labels.Labels, lb *labels.Builder) labels.Labels {
+	lb.Reset(labels.EmptyLabels())
+	discovered.Range(func(l labels.Label) {
+		if !strings.HasPrefix(l.Name, model.ReservedLabelPrefix) {
+			lb.Set(l.Name, l.Value)
+		}
+	})
+	lb.Set(labels.MetricName, droppedUpMetricName)
+	lb.Set(model.JobLabel, job)
+	if addr := discovered.Get(model.AddressLabel); addr != "" && lb.Get(model.InstanceLabel) == "" {
+		lb.Set(model.InstanceLabel, addr)
+	}
+	return lb.Labels()
+}
+
+// writeDroppedSeries writes an up series with a value of 0 for each of the
+// dropped label sets, and a staleness marker for the series written during the
+// previous refresh which are no longer dropped.
+func (c *Component) writeDroppedSeries(ctx context.Context, state *targetStatusState, dropped []labels.Labels) error {
+	if len(dropped) == 0 && len(state.droppedSeries) == 0 {
+		return nil
+	}
+
+	var (
+		ts      = time.Now().UnixMilli()
+		current = make(map[uint64]labels.Labels, len(dropped))
+		app     = c.appendable.Appender(ctx)
+	)
+	for _, l := range dropped {
+		current[l.Hash()] = l
+		if _, err := app.Append(0, l, ts, 0); err != nil {
+			_ = app.Rollback()
+			return err
+		}
+	}
+	for hash, l := range state.droppedSeries {
+		if _, ok := current[hash]; ok {
+			continue
+		}
+		if _, err := app.Append(0, l, ts, math.Float64frombits(value.StaleNaN)); err != nil {
+			_ = app.Rollback()
+			return err
+		}
+	}
+	if err := app.Commit(); err != nil {
+		return err
+	}
+
+	if len(current) == 0 {
+		current = nil
+	}
+	state.droppedSeries = current
+	return nil
+}
+
+func toSortedTargets(ls []labels.Labels) []discovery.Target {
+	if len(ls) == 0 {
+		return nil
+	}
+	slices.SortFunc(ls, labels.Compare)
+
+	res := make([]discovery.Target, 0, len(ls))
+	for _, l := range ls {
+		res = append(res, discovery.NewTargetFromMap(l.Map()))
+	}
+	return res
+}
+
+func exportsEqual(a, b Exports) bool {
+	return targetsEqual(a.UpTargets, b.UpTargets) && targetsEqual(a.DownTargets, b.DownTargets)
+}
+
+func targetsEqual(a, b []discovery.Target) bool {
+	return slices.EqualFunc(a, b, func(x, y discovery.Target) bool {
+		return x.EqualsTarget(&y)
+	})
+}
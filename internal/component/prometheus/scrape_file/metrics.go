@@ -0,0 +1,36 @@
+package scrape_file
+
+import (
+	"github.com/grafana/alloy/internal/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	files             prometheus.Gauge
+	samplesTotal      prometheus.Counter
+	scrapeErrorsTotal prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	var m metrics
+
+	m.files = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "prometheus_scrape_file_files",
+		Help: "Number of files this component is configured to scrape.",
+	})
+	m.samplesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "prometheus_scrape_file_samples_total",
+		Help: "Total number of samples read from files.",
+	})
+	m.scrapeErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "prometheus_scrape_file_errors_total",
+		Help: "Total number of files which could not be read or parsed.",
+	})
+
+	if r != nil {
+		m.files = util.MustRegisterOrGet(r, m.files).(prometheus.Gauge)
+		m.samplesTotal = util.MustRegisterOrGet(r, m.samplesTotal).(prometheus.Counter)
+		m.scrapeErrorsTotal = util.MustRegisterOrGet(r, m.scrapeErrorsTotal).(prometheus.Counter)
+	}
+	return &m
+}
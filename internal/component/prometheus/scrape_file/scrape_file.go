@@ -0,0 +1,226 @@
+package scrape_file
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/discovery"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service/labelstore"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "prometheus.scrape_file",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// pathLabel is the label holding the path of a file target.
+const pathLabel = "__path__"
+
+// Arguments holds values which are used to configure the
+// prometheus.scrape_file component.
+type Arguments struct {
+	Targets   []discovery.Target   `alloy:"targets,attr"`
+	ForwardTo []storage.Appendable `alloy:"forward_to,attr"`
+
+	// The job name to set the job label to.
+	JobName string `alloy:"job_name,attr,optional"`
+	// How frequently to read the files.
+	ScrapeInterval time.Duration `alloy:"scrape_interval,attr,optional"`
+	// The format of the files.
+	Format string `alloy:"format,attr,optional"`
+	// Indicator whether the labels in the files should take precedence over
+	// the target labels.
+	HonorLabels bool `alloy:"honor_labels,attr,optional"`
+	// Indicator whether the timestamps in the files should be respected.
+	HonorTimestamps bool `alloy:"honor_timestamps,attr,optional"`
+	// Whether to keep classic histograms that are also exposed as native
+	// histograms, or converted to native histograms with custom buckets.
+	ScrapeClassicHistograms bool `alloy:"scrape_classic_histograms,attr,optional"`
+	// Whether to convert classic histograms to native histograms with custom
+	// buckets (NHCB).
+	ConvertClassicHistogramsToNHCB bool `alloy:"convert_classic_histograms_to_nhcb,attr,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (args *Arguments) SetToDefault() {
+	*args = Arguments{
+		ScrapeInterval:  1 * time.Minute,
+		Format:          FormatAuto,
+		HonorTimestamps: true,
+	}
+}
+
+// Validate implements syntax.Validator.
+func (args *Arguments) Validate() error {
+	if args.ScrapeInterval <= 0 {
+		return fmt.Errorf("scrape_interval must be greater than 0")
+	}
+	switch args.Format {
+	case FormatAuto, FormatPrometheus, FormatOpenMetrics, FormatProtobuf:
+	default:
+		return fmt.Errorf("invalid format %q: must be one of %q, %q, %q or %q", args.Format, FormatAuto, FormatPrometheus, FormatOpenMetrics, FormatProtobuf)
+	}
+	return nil
+}
+
+func (args *Arguments) scrapeOptions() scrapeOptions {
+	return scrapeOptions{
+		format:                  args.Format,
+		honorLabels:             args.HonorLabels,
+		honorTimestamps:         args.HonorTimestamps,
+		scrapeClassicHistograms: args.ScrapeClassicHistograms,
+		convertClassicToNHCB:    args.ConvertClassicHistogramsToNHCB,
+	}
+}
+
+// Component implements the prometheus.scrape_file component.
+type Component struct {
+	opts    component.Options
+	fanout  *alloyprom.Fanout
+	scraper *scraper
+
+	reload chan struct{}
+
+	mut  sync.RWMutex
+	args Arguments
+}
+
+var _ component.Component = (*Component)(nil)
+
+// New creates a new prometheus.scrape_file component.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	service, err := opts.GetServiceData(labelstore.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	ls := service.(labelstore.LabelStore)
+
+	fanout := alloyprom.NewFanout(args.ForwardTo, opts.ID, opts.Registerer, ls, alloyprom.NoopMetadataStore{})
+	c := &Component{
+		opts:    opts,
+		fanout:  fanout,
+		scraper: newScraper(opts.Logger, fanout, newMetrics(opts.Registerer)),
+		reload:  make(chan struct{}, 1),
+	}
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	c.mut.RLock()
+	interval := c.args.ScrapeInterval
+	c.mut.RUnlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Write staleness markers for every series on shutdown, the same way
+	// prometheus.scrape does when its scrape loops stop.
+	defer func() {
+		c.scraper.stop(context.Background(), time.Now().UnixMilli())
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.reload:
+			c.mut.RLock()
+			newInterval := c.args.ScrapeInterval
+			c.mut.RUnlock()
+
+			if newInterval != interval {
+				interval = newInterval
+				ticker.Reset(interval)
+			}
+			c.scrape(ctx)
+		case <-ticker.C:
+			c.scrape(ctx)
+		}
+	}
+}
+
+func (c *Component) scrape(ctx context.Context) {
+	c.mut.RLock()
+	var (
+		targets = c.fileTargets()
+		opts    = c.args.scrapeOptions()
+	)
+	c.mut.RUnlock()
+
+	c.scraper.scrapeAll(ctx, targets, opts, time.Now().UnixMilli())
+}
+
+// fileTargets converts the targets from the arguments into the files to
+// scrape. The mut lock must be held when calling it.
+func (c *Component) fileTargets() []fileTarget {
+	jobName := c.args.JobName
+	if jobName == "" {
+		jobName = c.opts.ID
+	}
+
+	res := make([]fileTarget, 0, len(c.args.Targets))
+	for _, t := range c.args.Targets {
+		path, ok := t.Get(pathLabel)
+		if !ok || path == "" {
+			level.Warn(c.opts.Logger).Log("msg", "ignoring target without a __path__ label", "target", t.String())
+			continue
+		}
+
+		lb := labels.NewBuilder(labels.EmptyLabels())
+		for name, value := range t.NonReservedLabelSet() {
+			lb.Set(string(name), string(value))
+		}
+		if lb.Get(model.JobLabel) == "" {
+			lb.Set(model.JobLabel, jobName)
+		}
+		res = append(res, fileTarget{path: path, labels: lb.Labels()})
+	}
+
+	// Sort targets so files are always read in the same order.
+	slices.SortFunc(res, func(a, b fileTarget) int {
+		return cmp.Or(strings.Compare(a.path, b.path), labels.Compare(a.labels, b.labels))
+	})
+	return res
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	c.mut.Lock()
+	c.args = newArgs
+	c.mut.Unlock()
+
+	c.fanout.UpdateChildren(newArgs.ForwardTo)
+
+	select {
+	case c.reload <- struct{}{}:
+	default:
+	}
+	return nil
+}
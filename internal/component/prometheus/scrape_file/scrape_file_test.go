@@ -0,0 +1,173 @@
+package scrape_file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/discovery"
+	alloyprom "github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+)
+
+type testSample struct {
+	val float64
+	l   labels.Labels
+}
+
+func TestArguments(t *testing.T) {
+	cfg := `
+		targets    = [{ __path__ = "/var/lib/node_exporter/batch.prom" }]
+		forward_to = []
+	`
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+	require.Equal(t, time.Minute, args.ScrapeInterval)
+	require.Equal(t, FormatAuto, args.Format)
+	require.True(t, args.HonorTimestamps)
+
+	cfg = `
+		targets    = []
+		forward_to = []
+		format     = "json"
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), `invalid format "json"`)
+
+	cfg = `
+		targets         = []
+		forward_to      = []
+		scrape_interval = "0s"
+	`
+	require.ErrorContains(t, syntax.Unmarshal([]byte(cfg), &args), "scrape_interval must be greater than 0")
+}
+
+func TestForwardsMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.prom")
+	require.NoError(t, os.WriteFile(path, []byte("batch_records_total 42\n"), 0o644))
+
+	actualSamples := make(chan testSample, 100)
+
+	var args Arguments
+	args.SetToDefault()
+	args.Targets = []discovery.Target{
+		discovery.NewTargetFromMap(map[string]string{"__path__": path, "team": "a"}),
+	}
+	args.ForwardTo = testAppendable(actualSamples)
+
+	comp, err := New(testOptions(t), args)
+	require.NoError(t, err)
+	go func() {
+		require.NoError(t, comp.Run(t.Context()))
+	}()
+
+	select {
+	case actual := <-actualSamples:
+		require.Equal(t, testSample{
+			val: 42,
+			l:   labels.FromStrings("__name__", "batch_records_total", "job", "prometheus.scrape_file.test", "team", "a"),
+		}, actual)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sample")
+	}
+}
+
+func TestStalenessMarkersOnShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.prom")
+	require.NoError(t, os.WriteFile(path, []byte("batch_records_total 42\n"), 0o644))
+
+	type sample struct {
+		ts  int64
+		val float64
+	}
+	samples := make(chan sample, 100)
+	hookFn := func(ref storage.SeriesRef, _ labels.Labels, ts int64, val float64, _ storage.Appender) (storage.SeriesRef, error) {
+		samples <- sample{ts: ts, val: val}
+		return ref, nil
+	}
+
+	var args Arguments
+	args.SetToDefault()
+	args.Targets = []discovery.Target{
+		discovery.NewTargetFromMap(map[string]string{"__path__": path}),
+	}
+	args.ForwardTo = []storage.Appendable{alloyprom.NewInterceptor(
+		nil,
+		labelstore.New(nil, prometheus.DefaultRegisterer),
+		alloyprom.WithAppendHook(hookFn),
+	)}
+
+	comp, err := New(testOptions(t), args)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, comp.Run(ctx))
+	}()
+
+	select {
+	case s := <-samples:
+		require.Equal(t, 42.0, s.val)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sample")
+	}
+
+	// The staleness markers must be timestamped when the component stops,
+	// not when it starts.
+	time.Sleep(100 * time.Millisecond)
+	stoppedAt := time.Now().UnixMilli()
+	cancel()
+	<-done
+
+	select {
+	case s := <-samples:
+		require.True(t, value.IsStaleNaN(s.val))
+		require.GreaterOrEqual(t, s.ts, stoppedAt)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for staleness marker")
+	}
+}
+
+func testAppendable(actualSamples chan testSample) []storage.Appendable {
+	hookFn := func(
+		ref storage.SeriesRef,
+		l labels.Labels,
+		ts int64,
+		val float64,
+		next storage.Appender,
+	) (storage.SeriesRef, error) {
+
+		actualSamples <- testSample{val: val, l: l}
+		return ref, nil
+	}
+
+	ls := labelstore.New(nil, prometheus.DefaultRegisterer)
+	return []storage.Appendable{alloyprom.NewInterceptor(
+		nil,
+		ls,
+		alloyprom.WithAppendHook(
+			hookFn))}
+}
+
+func testOptions(t *testing.T) component.Options {
+	return component.Options{
+		ID:         "prometheus.scrape_file.test",
+		Logger:     util.TestAlloyLogger(t),
+		Registerer: prometheus.NewRegistry(),
+		GetServiceData: func(name string) (interface{}, error) {
+			return labelstore.New(nil, prometheus.DefaultRegisterer), nil
+		},
+	}
+}
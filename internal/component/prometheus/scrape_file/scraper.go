@@ -0,0 +1,288 @@
+package scrape_file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/textparse"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// Supported values of the format argument.
+const (
+	FormatAuto        = "auto"
+	FormatPrometheus  = "prometheus"
+	FormatOpenMetrics = "openmetrics"
+	FormatProtobuf    = "protobuf"
+)
+
+var contentTypes = map[string]string{
+	FormatPrometheus:  "text/plain",
+	FormatOpenMetrics: "application/openmetrics-text",
+	FormatProtobuf:    "application/vnd.google.protobuf",
+}
+
+// fileTarget is a single file to scrape.
+type fileTarget struct {
+	path string
+	// labels are added to every series read from the file.
+	labels labels.Labels
+}
+
+// scrapeOptions controls how files are parsed.
+type scrapeOptions struct {
+	format                  string
+	honorLabels             bool
+	honorTimestamps         bool
+	scrapeClassicHistograms bool
+	convertClassicToNHCB    bool
+}
+
+// scraper reads metrics from files and tracks the series written for each
+// file, so that staleness markers can be written once a series or a file
+// disappears. A scraper isn't safe for concurrent use.
+type scraper struct {
+	logger     log.Logger
+	appendable storage.Appendable
+	metrics    *metrics
+
+	// series holds the series written during the last successful scrape of
+	// each file, keyed by path and then by label hash.
+	series map[string]map[uint64]labels.Labels
+}
+
+func newScraper(logger log.Logger, appendable storage.Appendable, m *metrics) *scraper {
+	return &scraper{
+		logger:     logger,
+		appendable: appendable,
+		metrics:    m,
+		series:     make(map[string]map[uint64]labels.Labels),
+	}
+}
+
+// scrapeAll scrapes every target. Series of files which are no longer
+// targeted are marked as stale.
+func (s *scraper) scrapeAll(ctx context.Context, targets []fileTarget, opts scrapeOptions, ts int64) {
+	seen := make(map[string]struct{}, len(targets))
+	for _, t := range targets {
+		if _, ok := seen[t.path]; ok {
+			continue
+		}
+		seen[t.path] = struct{}{}
+
+		if err := s.scrapeFile(ctx, t, opts, ts); err != nil {
+			s.metrics.scrapeErrorsTotal.Inc()
+			level.Warn(s.logger).Log("msg", "failed to scrape file", "path", t.path, "err", err)
+		}
+	}
+	s.metrics.files.Set(float64(len(seen)))
+
+	for path := range s.series {
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		if err := s.markStale(ctx, path, ts); err != nil {
+			level.Warn(s.logger).Log("msg", "failed to write staleness markers", "path", path, "err", err)
+		}
+	}
+}
+
+// stop marks the series of every file as stale.
+func (s *scraper) stop(ctx context.Context, ts int64) {
+	for path := range s.series {
+		if err := s.markStale(ctx, path, ts); err != nil {
+			level.Warn(s.logger).Log("msg", "failed to write staleness markers", "path", path, "err", err)
+		}
+	}
+}
+
+func (s *scraper) scrapeFile(ctx context.Context, t fileTarget, opts scrapeOptions, ts int64) error {
+	b, err := os.ReadFile(t.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// The file was removed before the targets were updated.
+		level.Debug(s.logger).Log("msg", "file no longer exists", "path", t.path)
+		return s.markStale(ctx, t.path, ts)
+	} else if err != nil {
+		return err
+	}
+
+	p, err := newParser(t.path, b, opts)
+	if err != nil {
+		return err
+	}
+
+	app := s.appendable.Appender(ctx)
+	written, err := s.appendAll(app, p, t, opts, ts)
+	if err != nil {
+		_ = app.Rollback()
+		return err
+	}
+	for hash, lset := range s.series[t.path] {
+		if _, ok := written[hash]; ok {
+			continue
+		}
+		if _, err := app.Append(0, lset, ts, math.Float64frombits(value.StaleNaN)); err != nil {
+			_ = app.Rollback()
+			return err
+		}
+	}
+	if err := app.Commit(); err != nil {
+		return err
+	}
+
+	s.series[t.path] = written
+	return nil
+}
+
+// appendAll appends every sample read by p to app and returns the series
+// which were written.
+func (s *scraper) appendAll(app storage.Appender, p textparse.Parser, t fileTarget, opts scrapeOptions, defTime int64) (map[uint64]labels.Labels, error) {
+	var (
+		written = make(map[uint64]labels.Labels)
+		lset    labels.Labels
+		lb      = labels.NewBuilder(labels.EmptyLabels())
+		e       exemplar.Exemplar
+	)
+
+	for {
+		et, err := p.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch et {
+		case textparse.EntryType, textparse.EntryHelp, textparse.EntryUnit, textparse.EntryComment:
+			continue
+		}
+
+		var (
+			parsedTimestamp *int64
+			val             float64
+			h               *histogram.Histogram
+			fh              *histogram.FloatHistogram
+		)
+		isHistogram := et == textparse.EntryHistogram
+		if isHistogram {
+			_, parsedTimestamp, h, fh = p.Histogram()
+		} else {
+			_, parsedTimestamp, val = p.Series()
+		}
+
+		ts := defTime
+		if opts.honorTimestamps && parsedTimestamp != nil {
+			ts = *parsedTimestamp
+		}
+
+		p.Labels(&lset)
+		lset = applyTargetLabels(lb, lset, t.labels, opts.honorLabels)
+
+		var ref storage.SeriesRef
+		if isHistogram {
+			ref, err = app.AppendHistogram(0, lset, ts, h, fh)
+		} else {
+			ref, err = app.Append(0, lset, ts, val)
+		}
+		if err != nil {
+			return nil, err
+		}
+		written[lset.Hash()] = lset
+		s.metrics.samplesTotal.Inc()
+
+		for p.Exemplar(&e) {
+			if !e.HasTs {
+				e.Ts = ts
+			}
+			if _, err := app.AppendExemplar(ref, lset, e); err != nil {
+				level.Debug(s.logger).Log("msg", "failed to append exemplar", "series", lset, "err", err)
+			}
+			e = exemplar.Exemplar{}
+		}
+	}
+	return written, nil
+}
+
+// markStale writes staleness markers for every series written for the file
+// at path and forgets about them.
+func (s *scraper) markStale(ctx context.Context, path string, ts int64) error {
+	series := s.series[path]
+	delete(s.series, path)
+	if len(series) == 0 {
+		return nil
+	}
+
+	app := s.appendable.Appender(ctx)
+	for _, lset := range series {
+		if _, err := app.Append(0, lset, ts, math.Float64frombits(value.StaleNaN)); err != nil {
+			_ = app.Rollback()
+			return err
+		}
+	}
+	return app.Commit()
+}
+
+// applyTargetLabels adds the target labels to lset. Conflicting labels in
+// lset are kept when honorLabels is true, and renamed to exported_<name>
+// otherwise.
+func applyTargetLabels(lb *labels.Builder, lset, target labels.Labels, honorLabels bool) labels.Labels {
+	lb.Reset(lset)
+	target.Range(func(l labels.Label) {
+		existing := lset.Get(l.Name)
+		switch {
+		case existing == "":
+			lb.Set(l.Name, l.Value)
+		case !honorLabels:
+			lb.Set("exported_"+l.Name, existing)
+			lb.Set(l.Name, l.Value)
+		}
+	})
+	return lb.Labels()
+}
+
+// newParser returns a parser for the contents of the file at path.
+func newParser(path string, b []byte, opts scrapeOptions) (textparse.Parser, error) {
+	format := opts.format
+	if format == FormatAuto {
+		format = detectFormat(path, b)
+	}
+
+	st := labels.NewSymbolTable()
+	p, err := textparse.New(b, contentTypes[format], "", opts.scrapeClassicHistograms, false, st)
+	if p == nil {
+		return nil, fmt.Errorf("failed to create %s parser: %w", format, err)
+	}
+	if opts.convertClassicToNHCB {
+		p = textparse.NewNHCBParser(p, st, opts.scrapeClassicHistograms)
+	}
+	return p, nil
+}
+
+// detectFormat guesses the format of a file from its extension, falling back
+// to looking for the OpenMetrics "# EOF" terminator.
+func detectFormat(path string, b []byte) string {
+	switch filepath.Ext(path) {
+	case ".pb":
+		return FormatProtobuf
+	case ".om":
+		return FormatOpenMetrics
+	}
+	if bytes.HasSuffix(bytes.TrimSpace(b), []byte("# EOF")) {
+		return FormatOpenMetrics
+	}
+	return FormatPrometheus
+}
@@ -0,0 +1,269 @@
+package scrape_file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/util"
+)
+
+func TestScrapeFile_Formats(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		contents string
+		opts     scrapeOptions
+		expect   map[string]float64
+	}{
+		{
+			name: "prometheus text",
+			file: "batch.prom",
+			contents: `# HELP batch_last_success_timestamp_seconds Last success.
+# TYPE batch_last_success_timestamp_seconds gauge
+batch_last_success_timestamp_seconds{job="nightly"} 1.7e+09
+batch_records_total 42
+`,
+			opts: scrapeOptions{format: FormatAuto},
+			expect: map[string]float64{
+				`{__name__="batch_last_success_timestamp_seconds", exported_job="nightly", job="test"}`: 1.7e+09,
+				`{__name__="batch_records_total", job="test"}`:                                          42,
+			},
+		},
+		{
+			name: "honor labels",
+			file: "batch.prom",
+			contents: `batch_last_success_timestamp_seconds{job="nightly"} 1.7e+09
+`,
+			opts: scrapeOptions{format: FormatPrometheus, honorLabels: true},
+			expect: map[string]float64{
+				`{__name__="batch_last_success_timestamp_seconds", job="nightly"}`: 1.7e+09,
+			},
+		},
+		{
+			name: "openmetrics",
+			file: "batch.txt",
+			contents: `# TYPE requests counter
+requests_total 5
+# EOF
+`,
+			opts: scrapeOptions{format: FormatAuto},
+			expect: map[string]float64{
+				`{__name__="requests_total", job="test"}`: 5,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o644))
+
+			app := newRecordingAppendable()
+			s := newScraper(util.TestLogger(t), app, newMetrics(prometheus.NewRegistry()))
+			s.scrapeAll(t.Context(), []fileTarget{{path: path, labels: labels.FromStrings("job", "test")}}, tc.opts, 1000)
+
+			require.Equal(t, tc.expect, app.latestValues())
+		})
+	}
+}
+
+func TestScrapeFile_Exemplars(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.om")
+	require.NoError(t, os.WriteFile(path, []byte(`# TYPE requests counter
+requests_total 5 # {trace_id="abc"} 1.0 123.456
+# EOF
+`), 0o644))
+
+	app := newRecordingAppendable()
+	s := newScraper(util.TestLogger(t), app, newMetrics(prometheus.NewRegistry()))
+	s.scrapeAll(t.Context(), []fileTarget{{path: path, labels: labels.EmptyLabels()}}, scrapeOptions{format: FormatAuto, honorTimestamps: true}, 1000)
+
+	require.Len(t, app.exemplars, 1)
+	require.Equal(t, labels.FromStrings("trace_id", "abc"), app.exemplars[0].Labels)
+	require.Equal(t, 1.0, app.exemplars[0].Value)
+	require.Equal(t, int64(123456), app.exemplars[0].Ts)
+}
+
+func TestScrapeFile_NativeHistograms(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "latency.prom")
+	require.NoError(t, os.WriteFile(path, []byte(`# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 3
+latency_seconds_bucket{le="+Inf"} 4
+latency_seconds_sum 6.5
+latency_seconds_count 4
+`), 0o644))
+
+	app := newRecordingAppendable()
+	s := newScraper(util.TestLogger(t), app, newMetrics(prometheus.NewRegistry()))
+	s.scrapeAll(t.Context(), []fileTarget{{path: path, labels: labels.EmptyLabels()}}, scrapeOptions{format: FormatAuto, convertClassicToNHCB: true}, 1000)
+
+	require.Empty(t, app.latestValues(), "classic histogram series should not be kept")
+	require.Len(t, app.histograms, 1)
+	h := app.histograms[`{__name__="latency_seconds"}`]
+	require.NotNil(t, h)
+	require.Equal(t, uint64(4), h.Count)
+	require.Equal(t, 6.5, h.Sum)
+	require.Equal(t, []float64{0.1, 1}, h.CustomValues)
+}
+
+func TestScrapeFile_Staleness(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "batch.prom")
+	require.NoError(t, os.WriteFile(path, []byte("a 1\nb 2\n"), 0o644))
+
+	var (
+		app     = newRecordingAppendable()
+		s       = newScraper(util.TestLogger(t), app, newMetrics(prometheus.NewRegistry()))
+		targets = []fileTarget{{path: path, labels: labels.EmptyLabels()}}
+		opts    = scrapeOptions{format: FormatAuto}
+	)
+
+	s.scrapeAll(t.Context(), targets, opts, 1000)
+	require.Equal(t, map[string]float64{`{__name__="a"}`: 1, `{__name__="b"}`: 2}, app.latestValues())
+
+	// Series which disappear from the file are marked as stale.
+	require.NoError(t, os.WriteFile(path, []byte("a 3\n"), 0o644))
+	s.scrapeAll(t.Context(), targets, opts, 2000)
+	require.Equal(t, map[string]float64{`{__name__="a"}`: 3}, app.latestValues())
+	require.True(t, app.isStale(`{__name__="b"}`))
+
+	// All series are marked as stale once the file is removed.
+	require.NoError(t, os.Remove(path))
+	s.scrapeAll(t.Context(), targets, opts, 3000)
+	require.Empty(t, app.latestValues())
+	require.True(t, app.isStale(`{__name__="a"}`))
+
+	// Series of files which are no longer targeted are marked as stale.
+	require.NoError(t, os.WriteFile(path, []byte("c 1\n"), 0o644))
+	s.scrapeAll(t.Context(), targets, opts, 4000)
+	require.Equal(t, map[string]float64{`{__name__="c"}`: 1}, app.latestValues())
+	s.scrapeAll(t.Context(), nil, opts, 5000)
+	require.True(t, app.isStale(`{__name__="c"}`))
+}
+
+func TestScrapeFile_InvalidFileKeepsSeries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.prom")
+	require.NoError(t, os.WriteFile(path, []byte("a 1\n"), 0o644))
+
+	var (
+		reg     = prometheus.NewRegistry()
+		app     = newRecordingAppendable()
+		s       = newScraper(util.TestLogger(t), app, newMetrics(reg))
+		targets = []fileTarget{{path: path, labels: labels.EmptyLabels()}}
+	)
+	s.scrapeAll(t.Context(), targets, scrapeOptions{format: FormatAuto}, 1000)
+
+	// A file which is being rewritten may be invalid; the series of the last
+	// valid read are kept rather than marked as stale.
+	require.NoError(t, os.WriteFile(path, []byte("a{ 1\n"), 0o644))
+	s.scrapeAll(t.Context(), targets, scrapeOptions{format: FormatAuto}, 2000)
+	require.Equal(t, map[string]float64{`{__name__="a"}`: 1}, app.latestValues())
+	require.Len(t, s.series[path], 1)
+}
+
+func TestDetectFormat(t *testing.T) {
+	require.Equal(t, FormatProtobuf, detectFormat("metrics.pb", nil))
+	require.Equal(t, FormatOpenMetrics, detectFormat("metrics.om", nil))
+	require.Equal(t, FormatOpenMetrics, detectFormat("metrics.prom", []byte("a 1\n# EOF\n")))
+	require.Equal(t, FormatPrometheus, detectFormat("metrics.prom", []byte("a 1\n")))
+}
+
+// recordingAppendable records the latest value of every series written to
+// it.
+type recordingAppendable struct {
+	mut        sync.Mutex
+	samples    map[string]float64
+	histograms map[string]*histogram.Histogram
+	exemplars  []exemplar.Exemplar
+}
+
+func newRecordingAppendable() *recordingAppendable {
+	return &recordingAppendable{
+		samples:    make(map[string]float64),
+		histograms: make(map[string]*histogram.Histogram),
+	}
+}
+
+func (r *recordingAppendable) Appender(_ context.Context) storage.Appender {
+	return &recordingAppender{parent: r}
+}
+
+// latestValues returns the latest value of every series which isn't stale.
+func (r *recordingAppendable) latestValues() map[string]float64 {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	res := make(map[string]float64)
+	for k, v := range r.samples {
+		if !value.IsStaleNaN(v) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func (r *recordingAppendable) isStale(series string) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	v, ok := r.samples[series]
+	return ok && value.IsStaleNaN(v)
+}
+
+type recordingAppender struct {
+	storage.Appender
+
+	parent     *recordingAppendable
+	samples    map[string]float64
+	histograms map[string]*histogram.Histogram
+	exemplars  []exemplar.Exemplar
+}
+
+func (a *recordingAppender) Append(_ storage.SeriesRef, l labels.Labels, _ int64, v float64) (storage.SeriesRef, error) {
+	if a.samples == nil {
+		a.samples = make(map[string]float64)
+	}
+	a.samples[l.String()] = v
+	return 0, nil
+}
+
+func (a *recordingAppender) AppendHistogram(_ storage.SeriesRef, l labels.Labels, _ int64, h *histogram.Histogram, _ *histogram.FloatHistogram) (storage.SeriesRef, error) {
+	if a.histograms == nil {
+		a.histograms = make(map[string]*histogram.Histogram)
+	}
+	a.histograms[l.String()] = h
+	return 0, nil
+}
+
+func (a *recordingAppender) AppendExemplar(_ storage.SeriesRef, _ labels.Labels, e exemplar.Exemplar) (storage.SeriesRef, error) {
+	a.exemplars = append(a.exemplars, e)
+	return 0, nil
+}
+
+func (a *recordingAppender) Commit() error {
+	a.parent.mut.Lock()
+	defer a.parent.mut.Unlock()
+
+	for k, v := range a.samples {
+		a.parent.samples[k] = v
+	}
+	for k, h := range a.histograms {
+		a.parent.histograms[k] = h
+	}
+	a.parent.exemplars = append(a.parent.exemplars, a.exemplars...)
+	return nil
+}
+
+func (a *recordingAppender) Rollback() error { return nil }
@@ -0,0 +1,151 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	otelcomponent "go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// sample is a Prometheus sample. Histograms, exemplars and metadata are
+// transformed as samples without a value, so that only their name and labels
+// are changed. Statements and conditions see a value of 0 for them.
+type sample struct {
+	labels   labels.Labels
+	t        int64
+	v        float64
+	hasValue bool
+}
+
+// program runs OTTL statements and drop conditions against samples, using the
+// datapoint context of the OpenTelemetry transform processor.
+//
+// A sample is converted to a gauge data point:
+//   - The __name__ label is the metric name.
+//   - The other labels are the data point attributes.
+//   - The value is the double value of the data point.
+//   - The timestamp is the data point time.
+type program struct {
+	statements ottl.StatementSequence[ottldatapoint.TransformContext]
+	drop       *ottl.ConditionSequence[ottldatapoint.TransformContext]
+}
+
+func newProgram(args Arguments, settings otelcomponent.TelemetrySettings) (*program, error) {
+	parser, err := ottldatapoint.NewParser(ottlfuncs.StandardFuncs[ottldatapoint.TransformContext](), settings, ottldatapoint.EnablePathContextNames())
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := parser.ParseStatements(args.Statements)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statements: %w", err)
+	}
+
+	p := &program{
+		statements: ottldatapoint.NewStatementSequence(statements, settings, ottldatapoint.WithStatementSequenceErrorMode(args.ErrorMode)),
+	}
+
+	if len(args.DropConditions) > 0 {
+		conditions, err := parser.ParseConditions(args.DropConditions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop conditions: %w", err)
+		}
+		drop := ottldatapoint.NewConditionSequence(conditions, settings, ottldatapoint.WithConditionSequenceErrorMode(args.ErrorMode))
+		p.drop = &drop
+	}
+
+	return p, nil
+}
+
+// process runs the statements against s and returns the transformed sample.
+// It returns false if the sample matches a drop condition, or if it has no
+// name left.
+func (p *program) process(ctx context.Context, s sample) (sample, bool, error) {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	sm := rm.ScopeMetrics().AppendEmpty()
+	m := sm.Metrics().AppendEmpty()
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+
+	s.labels.Range(func(l labels.Label) {
+		if l.Name == model.MetricNameLabel {
+			m.SetName(l.Value)
+			return
+		}
+		dp.Attributes().PutStr(l.Name, l.Value)
+	})
+	dp.SetTimestamp(pcommon.Timestamp(s.t * 1e6))
+	if s.hasValue {
+		dp.SetDoubleValue(s.v)
+	}
+
+	tCtx := ottldatapoint.NewTransformContext(dp, m, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm)
+	if err := p.statements.Execute(ctx, tCtx); err != nil {
+		return sample{}, false, err
+	}
+	if p.drop != nil {
+		drop, err := p.drop.Eval(ctx, tCtx)
+		if err != nil {
+			return sample{}, false, err
+		}
+		if drop {
+			return sample{}, false, nil
+		}
+	}
+
+	if m.Name() == "" {
+		return sample{}, false, nil
+	}
+	return toSample(s, m, dp), true, nil
+}
+
+// toSample converts a data point back to a sample. Attributes which aren't
+// valid label names, or which have an empty value, are dropped from the
+// labels. The timestamp of the original sample is kept if the time of the
+// data point has been removed, and the value of staleness markers is never
+// changed.
+func toSample(original sample, m pmetric.Metric, dp pmetric.NumberDataPoint) sample {
+	b := labels.NewScratchBuilder(dp.Attributes().Len() + 1)
+	b.Add(model.MetricNameLabel, m.Name())
+	dp.Attributes().Range(func(k string, v pcommon.Value) bool {
+		name, value := model.LabelName(k), v.AsString()
+		if name.IsValid() && value != "" && k != model.MetricNameLabel {
+			b.Add(k, value)
+		}
+		return true
+	})
+	b.Sort()
+
+	result := sample{
+		labels:   b.Labels(),
+		t:        original.t,
+		hasValue: original.hasValue,
+	}
+	if dp.Timestamp() != 0 {
+		result.t = int64(dp.Timestamp()) / 1e6
+	}
+	if !original.hasValue {
+		return result
+	}
+
+	switch {
+	case value.IsStaleNaN(original.v):
+		result.v = original.v
+	case dp.ValueType() == pmetric.NumberDataPointValueTypeInt:
+		result.v = float64(dp.IntValue())
+	case dp.ValueType() == pmetric.NumberDataPointValueTypeDouble:
+		result.v = dp.DoubleValue()
+	default:
+		result.v = math.NaN()
+	}
+	return result
+}
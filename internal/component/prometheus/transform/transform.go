@@ -0,0 +1,277 @@
+// Package transform provides the prometheus.transform component.
+package transform
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	prometheus_client "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/metadata"
+	"github.com/prometheus/prometheus/storage"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/util/zapadapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	otelcomponent "go.opentelemetry.io/collector/component"
+)
+
+const name = "prometheus.transform"
+
+func init() {
+	component.Register(component.Registration{
+		Name:      name,
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments holds values which are used to configure the prometheus.transform
+// component.
+type Arguments struct {
+	// Where the transformed metrics should be forwarded to.
+	ForwardTo []storage.Appendable `alloy:"forward_to,attr"`
+
+	// How to react to errors raised by statements and conditions.
+	ErrorMode ottl.ErrorMode `alloy:"error_mode,attr,optional"`
+
+	// The OTTL statements to run against each sample.
+	Statements []string `alloy:"statements,attr,optional"`
+
+	// The OTTL conditions which drop a sample when any of them matches.
+	DropConditions []string `alloy:"drop_conditions,attr,optional"`
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (arg *Arguments) SetToDefault() {
+	*arg = Arguments{
+		ErrorMode: ottl.PropagateError,
+	}
+}
+
+// Validate implements syntax.Validator.
+func (arg *Arguments) Validate() error {
+	_, err := newProgram(*arg, otelcomponent.TelemetrySettings{Logger: zap.NewNop()})
+	return err
+}
+
+// Exports holds values which are exported by the prometheus.transform
+// component.
+type Exports struct {
+	Receiver storage.Appendable `alloy:"receiver,attr"`
+}
+
+// Component implements the prometheus.transform component.
+type Component struct {
+	mut              sync.RWMutex
+	opts             component.Options
+	program          *program
+	receiver         *prometheus.Interceptor
+	metricsProcessed prometheus_client.Counter
+	metricsOutgoing  prometheus_client.Counter
+	metricsDropped   prometheus_client.Counter
+	errors           prometheus_client.Counter
+	fanout           *prometheus.Fanout
+	exited           atomic.Bool
+
+	debugDataPublisher livedebugging.DebugDataPublisher
+}
+
+var (
+	_ component.Component     = (*Component)(nil)
+	_ component.LiveDebugging = (*Component)(nil)
+)
+
+// New creates a new prometheus.transform component.
+func New(o component.Options, args Arguments) (*Component, error) {
+	debugDataPublisher, err := o.GetServiceData(livedebugging.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := o.GetServiceData(labelstore.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	ls := data.(labelstore.LabelStore)
+	c := &Component{
+		opts:               o,
+		debugDataPublisher: debugDataPublisher.(livedebugging.DebugDataPublisher),
+	}
+	c.metricsProcessed = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "alloy_prometheus_transform_metrics_processed_total",
+		Help: "Total number of metrics processed",
+	})
+	c.metricsOutgoing = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "alloy_prometheus_transform_metrics_written_total",
+		Help: "Total number of metrics written",
+	})
+	c.metricsDropped = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "alloy_prometheus_transform_metrics_dropped_total",
+		Help: "Total number of metrics dropped by a drop condition",
+	})
+	c.errors = prometheus_client.NewCounter(prometheus_client.CounterOpts{
+		Name: "alloy_prometheus_transform_errors_total",
+		Help: "Total number of errors while running a statement or a condition",
+	})
+
+	for _, metric := range []prometheus_client.Collector{c.metricsProcessed, c.metricsOutgoing, c.metricsDropped, c.errors} {
+		err = o.Registerer.Register(metric)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.fanout = prometheus.NewFanout(args.ForwardTo, o.ID, o.Registerer, ls, prometheus.NoopMetadataStore{})
+	c.receiver = prometheus.NewInterceptor(
+		c.fanout,
+		ls,
+		prometheus.WithComponentID(c.opts.ID),
+		prometheus.WithAppendHook(func(ref storage.SeriesRef, l labels.Labels, t int64, v float64, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", o.ID)
+			}
+
+			s, keep, err := c.transform(sample{labels: l, t: t, v: v, hasValue: true})
+			if err != nil || !keep {
+				return 0, err
+			}
+			c.metricsOutgoing.Inc()
+
+			// Since SeriesRefs are tied to the labels, we send zero to indicate the seriesRef should be recalculated downstream.
+			return next.Append(0, s.labels, s.t, s.v)
+		}),
+		prometheus.WithExemplarHook(func(ref storage.SeriesRef, l labels.Labels, e exemplar.Exemplar, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", o.ID)
+			}
+
+			s, keep, err := c.transform(sample{labels: l, t: e.Ts})
+			if err != nil || !keep {
+				return 0, err
+			}
+
+			// Since SeriesRefs are tied to the labels, we send zero to indicate the seriesRef should be recalculated downstream.
+			return next.AppendExemplar(0, s.labels, e)
+		}),
+		prometheus.WithMetadataHook(func(ref storage.SeriesRef, l labels.Labels, m metadata.Metadata, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", o.ID)
+			}
+
+			s, keep, err := c.transform(sample{labels: l})
+			if err != nil || !keep {
+				return 0, err
+			}
+
+			// Since SeriesRefs are tied to the labels, we send zero to indicate the seriesRef should be recalculated downstream.
+			return next.UpdateMetadata(0, s.labels, m)
+		}),
+		prometheus.WithHistogramHook(func(ref storage.SeriesRef, l labels.Labels, t int64, h *histogram.Histogram, fh *histogram.FloatHistogram, next storage.Appender) (storage.SeriesRef, error) {
+			if c.exited.Load() {
+				return 0, fmt.Errorf("%s has exited", o.ID)
+			}
+
+			s, keep, err := c.transform(sample{labels: l, t: t})
+			if err != nil || !keep {
+				return 0, err
+			}
+			c.metricsOutgoing.Inc()
+
+			// Since SeriesRefs are tied to the labels, we send zero to indicate the seriesRef should be recalculated downstream.
+			return next.AppendHistogram(0, s.labels, s.t, h, fh)
+		}),
+	)
+
+	// Immediately export the receiver which remains the same for the component
+	// lifetime.
+	o.OnStateChange(Exports{Receiver: c.receiver})
+
+	// Call to Update() to set the statements once at the start.
+	if err = c.Update(args); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Run implements component.Component.
+func (c *Component) Run(ctx context.Context) error {
+	defer c.exited.Store(true)
+
+	<-ctx.Done()
+	return nil
+}
+
+// Update implements component.Component.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	program, err := newProgram(newArgs, otelcomponent.TelemetrySettings{
+		Logger: zapadapter.New(c.opts.Logger),
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.program = program
+	c.fanout.UpdateChildren(newArgs.ForwardTo)
+
+	return nil
+}
+
+// transform runs the statements against s. It returns false if the sample
+// must be dropped.
+func (c *Component) transform(s sample) (sample, bool, error) {
+	c.mut.RLock()
+	program := c.program
+	c.mut.RUnlock()
+
+	c.metricsProcessed.Inc()
+
+	transformed, keep, err := program.process(context.Background(), s)
+	if err != nil {
+		c.errors.Inc()
+		return sample{}, false, err
+	}
+	if !keep {
+		c.metricsDropped.Inc()
+	}
+
+	count := uint64(1)
+	if !keep {
+		count = 0 // the count is not incremented because the metric will be filtered out
+	}
+	componentID := livedebugging.ComponentID(c.opts.ID)
+	c.debugDataPublisher.PublishIfActive(livedebugging.NewData(
+		componentID,
+		livedebugging.PrometheusMetric,
+		count,
+		func() string {
+			if !keep {
+				return fmt.Sprintf("%s => dropped", s.labels.String())
+			}
+			return fmt.Sprintf("%s => %s", s.labels.String(), transformed.labels.String())
+		},
+	))
+
+	return transformed, keep, nil
+}
+
+// LiveDebugging implements component.LiveDebugging.
+func (c *Component) LiveDebugging() {}
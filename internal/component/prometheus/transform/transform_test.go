@@ -0,0 +1,138 @@
+package transform
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/prometheus"
+	"github.com/grafana/alloy/internal/service/labelstore"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+)
+
+type appended struct {
+	labels labels.Labels
+	t      int64
+	v      float64
+}
+
+func TestTransform(t *testing.T) {
+	appendable, received := generateTransform(t, `
+		statements = [
+			`+"`"+`set(metric.name, Concat(["app", metric.name], "_"))`+"`"+`,
+			`+"`"+`set(datapoint.attributes["env"], "prod")`+"`"+`,
+			`+"`"+`delete_key(datapoint.attributes, "pod")`+"`"+`,
+			`+"`"+`set(datapoint.value_double, datapoint.value_double / 1000) where IsMatch(metric.name, "_milliseconds$")`+"`"+`,
+			`+"`"+`replace_pattern(metric.name, "_milliseconds$", "_seconds")`+"`"+`,
+		]
+		drop_conditions = [`+"`"+`datapoint.attributes["debug"] == "true"`+"`"+`]
+		forward_to = []
+	`)
+
+	now := time.Now().UnixMilli()
+	app := appendable.Appender(t.Context())
+	_, err := app.Append(0, labels.FromStrings("__name__", "latency_milliseconds", "pod", "a", "job", "x"), now, 1500)
+	require.NoError(t, err)
+	_, err = app.Append(0, labels.FromStrings("__name__", "latency_milliseconds", "job", "x", "debug", "true"), now, 10)
+	require.NoError(t, err)
+	_, err = app.Append(0, labels.FromStrings("__name__", "latency_milliseconds", "pod", "a", "job", "x"), now, math.Float64frombits(value.StaleNaN))
+	require.NoError(t, err)
+	require.NoError(t, app.Commit())
+
+	require.Len(t, *received, 2)
+	require.Equal(t, labels.FromStrings("__name__", "app_latency_seconds", "env", "prod", "job", "x"), (*received)[0].labels)
+	require.Equal(t, now, (*received)[0].t)
+	require.Equal(t, 1.5, (*received)[0].v)
+
+	// Staleness markers keep their value.
+	require.Equal(t, labels.FromStrings("__name__", "app_latency_seconds", "env", "prod", "job", "x"), (*received)[1].labels)
+	require.True(t, value.IsStaleNaN((*received)[1].v))
+}
+
+func TestErrorMode(t *testing.T) {
+	statement := `set(datapoint.attributes["x"], Int(datapoint.attributes["missing"]) * 2)`
+
+	appendable, _ := generateTransform(t, `
+		statements = [`+"`"+statement+"`"+`]
+		forward_to = []
+	`)
+	app := appendable.Appender(t.Context())
+	_, err := app.Append(0, labels.FromStrings("__name__", "up"), time.Now().UnixMilli(), 1)
+	require.Error(t, err)
+	require.NoError(t, app.Rollback())
+
+	appendable, received := generateTransform(t, `
+		error_mode = "ignore"
+		statements = [`+"`"+statement+"`"+`]
+		forward_to = []
+	`)
+	app = appendable.Appender(t.Context())
+	_, err = app.Append(0, labels.FromStrings("__name__", "up"), time.Now().UnixMilli(), 1)
+	require.NoError(t, err)
+	require.NoError(t, app.Commit())
+	require.Len(t, *received, 1)
+	require.Equal(t, labels.FromStrings("__name__", "up"), (*received)[0].labels)
+}
+
+func TestValidate(t *testing.T) {
+	var args Arguments
+	err := syntax.Unmarshal([]byte(`
+		statements = ["set(name, \"x\")"]
+		forward_to = []
+	`), &args)
+	require.ErrorContains(t, err, "invalid statements")
+
+	err = syntax.Unmarshal([]byte(`
+		drop_conditions = ["Nope(metric.name)"]
+		forward_to = []
+	`), &args)
+	require.ErrorContains(t, err, "invalid drop conditions")
+}
+
+func generateTransform(t *testing.T, cfg string) (storage.Appendable, *[]appended) {
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	var received []appended
+	ls := labelstore.New(nil, prom.DefaultRegisterer)
+	args.ForwardTo = []storage.Appendable{prometheus.NewInterceptor(nil, ls,
+		prometheus.WithAppendHook(func(ref storage.SeriesRef, l labels.Labels, ts int64, v float64, _ storage.Appender) (storage.SeriesRef, error) {
+			received = append(received, appended{labels: l, t: ts, v: v})
+			return ref, nil
+		}),
+	)}
+
+	var appendable storage.Appendable
+	_, err := New(component.Options{
+		ID:     "1",
+		Logger: util.TestAlloyLogger(t),
+		OnStateChange: func(e component.Exports) {
+			appendable = e.(Exports).Receiver
+		},
+		Registerer:     prom.NewRegistry(),
+		GetServiceData: getServiceData,
+	}, args)
+	require.NoError(t, err)
+	return appendable, &received
+}
+
+func getServiceData(name string) (interface{}, error) {
+	switch name {
+	case labelstore.ServiceName:
+		return labelstore.New(nil, prom.DefaultRegisterer), nil
+	case livedebugging.ServiceName:
+		return livedebugging.NewLiveDebugging(), nil
+	default:
+		return nil, fmt.Errorf("service not found %s", name)
+	}
+}
@@ -0,0 +1,236 @@
+package aws_secrets_manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/go-kit/log"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/remote/internal/cloudsecret"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "remote.aws_secrets_manager",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures remote.aws_secrets_manager.
+type Arguments struct {
+	// SecretID is the name or ARN of the secret.
+	SecretID     string `alloy:"secret_id,attr"`
+	VersionID    string `alloy:"version_id,attr,optional"`
+	VersionStage string `alloy:"version_stage,attr,optional"`
+
+	RereadFrequency time.Duration `alloy:"reread_frequency,attr,optional"`
+
+	Client Client `alloy:"client,block,optional"`
+}
+
+// Client configures the AWS client. Settings which aren't set are read from
+// the default AWS credential chain and shared configuration.
+type Client struct {
+	AccessKey string            `alloy:"key,attr,optional"`
+	Secret    alloytypes.Secret `alloy:"secret,attr,optional"`
+	Region    string            `alloy:"region,attr,optional"`
+	Endpoint  string            `alloy:"endpoint,attr,optional"`
+}
+
+// DefaultArguments holds default settings for Arguments.
+var DefaultArguments = Arguments{
+	RereadFrequency: 10 * time.Minute,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (a *Arguments) SetToDefault() {
+	*a = DefaultArguments
+}
+
+// Validate implements syntax.Validator.
+func (a *Arguments) Validate() error {
+	if a.SecretID == "" {
+		return fmt.Errorf("secret_id must not be empty")
+	}
+	if a.VersionID != "" && a.VersionStage != "" {
+		return fmt.Errorf("at most one of version_id and version_stage can be set")
+	}
+	if a.RereadFrequency < 0 {
+		return fmt.Errorf("reread_frequency must not be negative")
+	}
+	if (a.Client.AccessKey == "") != (a.Client.Secret == "") {
+		return fmt.Errorf("client: key and secret must be set together")
+	}
+	return nil
+}
+
+// client creates a Secrets Manager client from the arguments.
+func (a *Arguments) client() (*secretsmanager.Client, error) {
+	var opts []func(*aws_config.LoadOptions) error
+	if a.Client.Region != "" {
+		opts = append(opts, aws_config.WithRegion(a.Client.Region))
+	}
+	if a.Client.AccessKey != "" {
+		opts = append(opts, aws_config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(a.Client.AccessKey, string(a.Client.Secret), ""),
+		))
+	}
+
+	cfg, err := aws_config.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS configuration: %w", err)
+	}
+	return secretsmanager.NewFromConfig(cfg, func(o *secretsmanager.Options) {
+		if a.Client.Endpoint != "" {
+			o.BaseEndpoint = aws.String(a.Client.Endpoint)
+		}
+	}), nil
+}
+
+// Exports is the values exported by remote.aws_secrets_manager.
+type Exports struct {
+	// Value holds the value of the secret.
+	Value alloytypes.Secret `alloy:"value,attr"`
+	// Data holds the fields of the secret if its value is a JSON object.
+	Data map[string]alloytypes.Secret `alloy:"data,attr"`
+}
+
+// Component implements the remote.aws_secrets_manager component.
+type Component struct {
+	opts    component.Options
+	log     log.Logger
+	metrics *metrics
+
+	mut    sync.RWMutex
+	args   Arguments
+	client *secretsmanager.Client
+
+	refresher *cloudsecret.Refresher
+}
+
+var (
+	_ component.Component       = (*Component)(nil)
+	_ component.HealthComponent = (*Component)(nil)
+	_ component.DebugComponent  = (*Component)(nil)
+)
+
+// New creates a new remote.aws_secrets_manager component. It will try to
+// immediately read the secret and return an error if it can't be read.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	c := &Component{
+		opts:    opts,
+		log:     opts.Logger,
+		metrics: newMetrics(opts.Registerer),
+	}
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run runs the remote.aws_secrets_manager component, rereading the secret as
+// necessary.
+func (c *Component) Run(ctx context.Context) error {
+	c.refresher.Run(ctx)
+	return nil
+}
+
+// Update updates the remote.aws_secrets_manager component and rereads the
+// secret.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	newClient, err := newArgs.client()
+	if err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	c.args = newArgs
+	c.client = newClient
+	c.mut.Unlock()
+
+	if c.refresher == nil {
+		r, err := cloudsecret.NewRefresher(cloudsecret.Options{
+			Log:             c.log,
+			Getter:          c.getSecret,
+			RereadFrequency: newArgs.RereadFrequency,
+
+			ReadCounter:  c.metrics.secretReadTotal,
+			ErrorCounter: c.metrics.secretReadErrorsTotal,
+		})
+		if err != nil {
+			return err
+		}
+		c.refresher = r
+	} else {
+		c.refresher.Update(newArgs.RereadFrequency)
+	}
+	return nil
+}
+
+func (c *Component) getSecret(ctx context.Context) (cloudsecret.Result, error) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(c.args.SecretID)}
+	if c.args.VersionID != "" {
+		input.VersionId = aws.String(c.args.VersionID)
+	}
+	if c.args.VersionStage != "" {
+		input.VersionStage = aws.String(c.args.VersionStage)
+	}
+	out, err := c.client.GetSecretValue(ctx, input)
+	if err != nil {
+		return cloudsecret.Result{}, err
+	}
+
+	value := out.SecretBinary
+	if out.SecretString != nil {
+		value = []byte(*out.SecretString)
+	}
+	c.opts.OnStateChange(Exports{
+		Value: alloytypes.Secret(value),
+		Data:  cloudsecret.Data(value),
+	})
+
+	res := cloudsecret.Result{Version: aws.ToString(out.VersionId)}
+
+	// The rotation schedule is only used to reread the secret as soon as it
+	// rotates, so failing to retrieve it doesn't fail the read.
+	desc, err := c.client.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(c.args.SecretID)})
+	if err != nil {
+		level.Warn(c.log).Log("msg", "failed to get rotation schedule of secret", "err", err)
+	} else if desc.RotationEnabled != nil && *desc.RotationEnabled {
+		res.NextRotation = aws.ToTime(desc.NextRotationDate)
+	}
+	return res, nil
+}
+
+// CurrentHealth returns the current health of the component. It is healthy
+// as long as the latest read of the secret was successful.
+func (c *Component) CurrentHealth() component.Health {
+	return c.refresher.CurrentHealth()
+}
+
+// DebugInfo returns non-sensitive metadata about the current secret.
+func (c *Component) DebugInfo() interface{} {
+	return c.refresher.DebugInfo()
+}
@@ -0,0 +1,217 @@
+package aws_secrets_manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component/remote/internal/cloudsecret"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func Test_GetSecret(t *testing.T) {
+	var (
+		ctx = componenttest.TestContext(t)
+		l   = util.TestLogger(t)
+	)
+
+	srv := newFakeSecretsManager(t)
+	srv.setSecret("v1", `{"username": "admin", "password": "hunter2", "port": 5432}`)
+	nextRotation := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.nextRotation = &nextRotation
+
+	cfg := fmt.Sprintf(`
+		secret_id = "db-credentials"
+
+		client {
+			key      = "AKIDEXAMPLE"
+			secret   = "secret"
+			region   = "us-east-1"
+			endpoint = "%s"
+		}
+	`, srv.URL)
+
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "remote.aws_secrets_manager")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Minute))
+	require.NoError(t, ctrl.WaitExports(time.Minute))
+
+	require.Equal(t, Exports{
+		Value: `{"username": "admin", "password": "hunter2", "port": 5432}`,
+		Data: map[string]alloytypes.Secret{
+			"username": "admin",
+			"password": "hunter2",
+			"port":     "5432",
+		},
+	}, ctrl.Exports())
+
+	info := debugInfo(t, ctrl)
+	require.Equal(t, "v1", info.Version)
+	require.True(t, nextRotation.Equal(info.NextRotationTime))
+}
+
+func Test_RereadSecret(t *testing.T) {
+	var (
+		ctx = componenttest.TestContext(t)
+		l   = util.TestLogger(t)
+	)
+
+	srv := newFakeSecretsManager(t)
+	srv.setSecret("v1", "hunter2")
+
+	cfg := fmt.Sprintf(`
+		secret_id        = "password"
+		version_stage    = "AWSCURRENT"
+		reread_frequency = "100ms"
+
+		client {
+			key      = "AKIDEXAMPLE"
+			secret   = "secret"
+			region   = "us-east-1"
+			endpoint = "%s"
+		}
+	`, srv.URL)
+
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "remote.aws_secrets_manager")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Minute))
+	require.NoError(t, ctrl.WaitExports(time.Minute))
+	require.Equal(t, Exports{Value: "hunter2", Data: map[string]alloytypes.Secret{}}, ctrl.Exports())
+
+	srv.setSecret("v2", "correct horse battery staple")
+
+	require.Eventually(t, func() bool {
+		return ctrl.Exports().(Exports).Value == "correct horse battery staple"
+	}, time.Minute, 10*time.Millisecond)
+	require.Equal(t, "v2", debugInfo(t, ctrl).Version)
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  string
+		err  string
+	}{
+		{
+			name: "valid",
+			cfg:  `secret_id = "password"`,
+		},
+		{
+			name: "version id and stage",
+			cfg: `
+				secret_id     = "password"
+				version_id    = "v1"
+				version_stage = "AWSCURRENT"
+			`,
+			err: "at most one of version_id and version_stage can be set",
+		},
+		{
+			name: "key without secret",
+			cfg: `
+				secret_id = "password"
+				client {
+					key = "AKIDEXAMPLE"
+				}
+			`,
+			err: "client: key and secret must be set together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func debugInfo(t *testing.T, ctrl *componenttest.Controller) cloudsecret.Info {
+	t.Helper()
+	c, err := ctrl.GetComponent()
+	require.NoError(t, err)
+	return c.(*Component).DebugInfo().(cloudsecret.Info)
+}
+
+// fakeSecretsManager implements the GetSecretValue and DescribeSecret
+// operations of the AWS Secrets Manager API for a single secret.
+type fakeSecretsManager struct {
+	*httptest.Server
+
+	mut          sync.Mutex
+	versionID    string
+	value        string
+	nextRotation *time.Time
+}
+
+func newFakeSecretsManager(t *testing.T) *fakeSecretsManager {
+	f := &fakeSecretsManager{}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeSecretsManager) setSecret(versionID, value string) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	f.versionID, f.value = versionID, value
+}
+
+func (f *fakeSecretsManager) handle(w http.ResponseWriter, r *http.Request) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	var resp map[string]any
+	switch r.Header.Get("X-Amz-Target") {
+	case "secretsmanager.GetSecretValue":
+		resp = map[string]any{
+			"Name":          "secret",
+			"SecretString":  f.value,
+			"VersionId":     f.versionID,
+			"VersionStages": []string{"AWSCURRENT"},
+		}
+	case "secretsmanager.DescribeSecret":
+		resp = map[string]any{
+			"Name":            "secret",
+			"RotationEnabled": f.nextRotation != nil,
+		}
+		if f.nextRotation != nil {
+			resp["NextRotationDate"] = f.nextRotation.Unix()
+		}
+	default:
+		http.Error(w, "unsupported operation", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(resp)
+}
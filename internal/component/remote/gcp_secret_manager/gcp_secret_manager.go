@@ -0,0 +1,245 @@
+package gcp_secret_manager
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"google.golang.org/api/option"
+	"google.golang.org/api/secretmanager/v1"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/component/remote/internal/cloudsecret"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func init() {
+	component.Register(component.Registration{
+		Name:      "remote.gcp_secret_manager",
+		Stability: featuregate.StabilityExperimental,
+		Args:      Arguments{},
+		Exports:   Exports{},
+
+		Build: func(opts component.Options, args component.Arguments) (component.Component, error) {
+			return New(opts, args.(Arguments))
+		},
+	})
+}
+
+// Arguments configures remote.gcp_secret_manager.
+type Arguments struct {
+	// Secret is either the ID of the secret in Project or the resource name of
+	// the secret.
+	Secret  string `alloy:"secret,attr"`
+	Project string `alloy:"project,attr,optional"`
+	Version string `alloy:"version,attr,optional"`
+
+	RereadFrequency time.Duration `alloy:"reread_frequency,attr,optional"`
+
+	// Endpoint overrides the Secret Manager API endpoint.
+	Endpoint string `alloy:"endpoint,attr,optional"`
+	// Emulator disables authentication for Endpoint, which must then be a
+	// local emulator.
+	Emulator bool `alloy:"emulator,attr,optional"`
+}
+
+// DefaultArguments holds default settings for Arguments.
+var DefaultArguments = Arguments{
+	Version:         "latest",
+	RereadFrequency: 10 * time.Minute,
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (a *Arguments) SetToDefault() {
+	*a = DefaultArguments
+}
+
+// Validate implements syntax.Validator.
+func (a *Arguments) Validate() error {
+	switch {
+	case a.Secret == "":
+		return fmt.Errorf("secret must not be empty")
+	case a.Project == "" && !strings.HasPrefix(a.Secret, "projects/"):
+		return fmt.Errorf("project must be set if secret isn't a resource name")
+	case a.Project != "" && strings.HasPrefix(a.Secret, "projects/"):
+		return fmt.Errorf("project must not be set if secret is a resource name")
+	case a.Version == "":
+		return fmt.Errorf("version must not be empty")
+	case a.RereadFrequency < 0:
+		return fmt.Errorf("reread_frequency must not be negative")
+	case a.Emulator && a.Endpoint == "":
+		return fmt.Errorf("endpoint must be set if emulator is true")
+	case !a.Emulator && strings.HasPrefix(a.Endpoint, "http://"):
+		// Credentials would be sent in plain text.
+		return fmt.Errorf("endpoint must use https unless emulator is true")
+	}
+	return nil
+}
+
+// secretName returns the resource name of the secret.
+func (a *Arguments) secretName() string {
+	if strings.HasPrefix(a.Secret, "projects/") {
+		return a.Secret
+	}
+	return fmt.Sprintf("projects/%s/secrets/%s", a.Project, a.Secret)
+}
+
+// service creates a Secret Manager client from the arguments. Credentials are
+// found with Application Default Credentials, unless Emulator is set.
+func (a *Arguments) service() (*secretmanager.Service, error) {
+	var opts []option.ClientOption
+	if a.Endpoint != "" {
+		endpoint := a.Endpoint
+		if !strings.HasSuffix(endpoint, "/") {
+			endpoint += "/"
+		}
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+	if a.Emulator {
+		opts = append(opts, option.WithoutAuthentication())
+	}
+
+	svc, err := secretmanager.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating Secret Manager client: %w", err)
+	}
+	return svc, nil
+}
+
+// Exports is the values exported by remote.gcp_secret_manager.
+type Exports struct {
+	// Value holds the payload of the secret version.
+	Value alloytypes.Secret `alloy:"value,attr"`
+	// Data holds the fields of the payload if it is a JSON object.
+	Data map[string]alloytypes.Secret `alloy:"data,attr"`
+}
+
+// Component implements the remote.gcp_secret_manager component.
+type Component struct {
+	opts    component.Options
+	log     log.Logger
+	metrics *metrics
+
+	mut  sync.RWMutex
+	args Arguments
+	svc  *secretmanager.Service
+
+	refresher *cloudsecret.Refresher
+}
+
+var (
+	_ component.Component       = (*Component)(nil)
+	_ component.HealthComponent = (*Component)(nil)
+	_ component.DebugComponent  = (*Component)(nil)
+)
+
+// New creates a new remote.gcp_secret_manager component. It will try to
+// immediately read the secret and return an error if it can't be read.
+func New(opts component.Options, args Arguments) (*Component, error) {
+	c := &Component{
+		opts:    opts,
+		log:     opts.Logger,
+		metrics: newMetrics(opts.Registerer),
+	}
+
+	if err := c.Update(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run runs the remote.gcp_secret_manager component, rereading the secret as
+// necessary.
+func (c *Component) Run(ctx context.Context) error {
+	c.refresher.Run(ctx)
+	return nil
+}
+
+// Update updates the remote.gcp_secret_manager component and rereads the
+// secret.
+func (c *Component) Update(args component.Arguments) error {
+	newArgs := args.(Arguments)
+
+	newSvc, err := newArgs.service()
+	if err != nil {
+		return err
+	}
+
+	c.mut.Lock()
+	c.args = newArgs
+	c.svc = newSvc
+	c.mut.Unlock()
+
+	if c.refresher == nil {
+		r, err := cloudsecret.NewRefresher(cloudsecret.Options{
+			Log:             c.log,
+			Getter:          c.getSecret,
+			RereadFrequency: newArgs.RereadFrequency,
+
+			ReadCounter:  c.metrics.secretReadTotal,
+			ErrorCounter: c.metrics.secretReadErrorsTotal,
+		})
+		if err != nil {
+			return err
+		}
+		c.refresher = r
+	} else {
+		c.refresher.Update(newArgs.RereadFrequency)
+	}
+	return nil
+}
+
+func (c *Component) getSecret(ctx context.Context) (cloudsecret.Result, error) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	secretName := c.args.secretName()
+	resp, err := c.svc.Projects.Secrets.Versions.Access(secretName + "/versions/" + c.args.Version).Context(ctx).Do()
+	if err != nil {
+		return cloudsecret.Result{}, err
+	}
+	var value []byte
+	if resp.Payload != nil {
+		value, err = base64.StdEncoding.DecodeString(resp.Payload.Data)
+		if err != nil {
+			return cloudsecret.Result{}, fmt.Errorf("decoding payload: %w", err)
+		}
+	}
+	c.opts.OnStateChange(Exports{
+		Value: alloytypes.Secret(value),
+		Data:  cloudsecret.Data(value),
+	})
+
+	res := cloudsecret.Result{Version: path.Base(resp.Name)}
+
+	// The rotation schedule is only used to reread the secret as soon as it
+	// rotates, so failing to retrieve it doesn't fail the read.
+	secret, err := c.svc.Projects.Secrets.Get(secretName).Context(ctx).Do()
+	if err != nil {
+		level.Warn(c.log).Log("msg", "failed to get rotation schedule of secret", "err", err)
+	} else if secret.Rotation != nil && secret.Rotation.NextRotationTime != "" {
+		res.NextRotation, err = time.Parse(time.RFC3339Nano, secret.Rotation.NextRotationTime)
+		if err != nil {
+			level.Warn(c.log).Log("msg", "failed to parse next rotation time of secret", "err", err)
+		}
+	}
+	return res, nil
+}
+
+// CurrentHealth returns the current health of the component. It is healthy
+// as long as the latest read of the secret was successful.
+func (c *Component) CurrentHealth() component.Health {
+	return c.refresher.CurrentHealth()
+}
+
+// DebugInfo returns non-sensitive metadata about the current secret.
+func (c *Component) DebugInfo() interface{} {
+	return c.refresher.DebugInfo()
+}
@@ -0,0 +1,243 @@
+package gcp_secret_manager
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component/remote/internal/cloudsecret"
+	"github.com/grafana/alloy/internal/runtime/componenttest"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func Test_GetSecret(t *testing.T) {
+	var (
+		ctx = componenttest.TestContext(t)
+		l   = util.TestLogger(t)
+	)
+
+	srv := newFakeSecretManager(t, "projects/my-project/secrets/db-credentials")
+	srv.addVersion(`{"username": "admin", "password": "hunter2"}`)
+	srv.nextRotationTime = "2030-01-01T00:00:00Z"
+
+	cfg := fmt.Sprintf(`
+		project  = "my-project"
+		secret   = "db-credentials"
+		endpoint = "%s"
+		emulator = true
+	`, srv.URL)
+
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "remote.gcp_secret_manager")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Minute))
+	require.NoError(t, ctrl.WaitExports(time.Minute))
+
+	require.Equal(t, Exports{
+		Value: `{"username": "admin", "password": "hunter2"}`,
+		Data: map[string]alloytypes.Secret{
+			"username": "admin",
+			"password": "hunter2",
+		},
+	}, ctrl.Exports())
+
+	info := debugInfo(t, ctrl)
+	require.Equal(t, "1", info.Version)
+	require.True(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Equal(info.NextRotationTime))
+}
+
+func Test_RereadSecret(t *testing.T) {
+	var (
+		ctx = componenttest.TestContext(t)
+		l   = util.TestLogger(t)
+	)
+
+	srv := newFakeSecretManager(t, "projects/my-project/secrets/password")
+	srv.addVersion("hunter2")
+
+	cfg := fmt.Sprintf(`
+		secret           = "projects/my-project/secrets/password"
+		reread_frequency = "100ms"
+		endpoint         = "%s"
+		emulator         = true
+	`, srv.URL)
+
+	var args Arguments
+	require.NoError(t, syntax.Unmarshal([]byte(cfg), &args))
+
+	ctrl, err := componenttest.NewControllerFromID(l, "remote.gcp_secret_manager")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, ctrl.Run(ctx, args))
+	}()
+
+	require.NoError(t, ctrl.WaitRunning(time.Minute))
+	require.NoError(t, ctrl.WaitExports(time.Minute))
+	require.Equal(t, Exports{Value: "hunter2", Data: map[string]alloytypes.Secret{}}, ctrl.Exports())
+
+	srv.addVersion("correct horse battery staple")
+
+	require.Eventually(t, func() bool {
+		return ctrl.Exports().(Exports).Value == "correct horse battery staple"
+	}, time.Minute, 10*time.Millisecond)
+	require.Equal(t, "2", debugInfo(t, ctrl).Version)
+
+	// Pinning a version reads it on update.
+	args.Version = "1"
+	require.NoError(t, ctrl.Update(args))
+	require.Equal(t, Exports{Value: "hunter2", Data: map[string]alloytypes.Secret{}}, ctrl.Exports())
+}
+
+func TestArguments_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  string
+		err  string
+	}{
+		{
+			name: "secret id",
+			cfg: `
+				project = "my-project"
+				secret  = "password"
+			`,
+		},
+		{
+			name: "resource name",
+			cfg:  `secret = "projects/my-project/secrets/password"`,
+		},
+		{
+			name: "missing project",
+			cfg:  `secret = "password"`,
+			err:  "project must be set if secret isn't a resource name",
+		},
+		{
+			name: "project and resource name",
+			cfg: `
+				project = "my-project"
+				secret  = "projects/my-project/secrets/password"
+			`,
+			err: "project must not be set if secret is a resource name",
+		},
+		{
+			name: "emulator",
+			cfg: `
+				secret   = "projects/my-project/secrets/password"
+				endpoint = "http://localhost:8080"
+				emulator = true
+			`,
+		},
+		{
+			name: "emulator without endpoint",
+			cfg: `
+				secret   = "projects/my-project/secrets/password"
+				emulator = true
+			`,
+			err: "endpoint must be set if emulator is true",
+		},
+		{
+			name: "http endpoint without emulator",
+			cfg: `
+				secret   = "projects/my-project/secrets/password"
+				endpoint = "http://localhost:8080"
+			`,
+			err: "endpoint must use https unless emulator is true",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func debugInfo(t *testing.T, ctrl *componenttest.Controller) cloudsecret.Info {
+	t.Helper()
+	c, err := ctrl.GetComponent()
+	require.NoError(t, err)
+	return c.(*Component).DebugInfo().(cloudsecret.Info)
+}
+
+// fakeSecretManager implements the secrets.get and secrets.versions.access
+// methods of the GCP Secret Manager REST API for a single secret.
+type fakeSecretManager struct {
+	*httptest.Server
+	name string
+
+	mut              sync.Mutex
+	versions         []string
+	nextRotationTime string
+}
+
+func newFakeSecretManager(t *testing.T, name string) *fakeSecretManager {
+	f := &fakeSecretManager{name: name}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeSecretManager) addVersion(payload string) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	f.versions = append(f.versions, payload)
+}
+
+func (f *fakeSecretManager) handle(w http.ResponseWriter, r *http.Request) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	var resp map[string]any
+	switch path := strings.TrimPrefix(r.URL.Path, "/v1/"); {
+	case path == f.name:
+		resp = map[string]any{"name": f.name}
+		if f.nextRotationTime != "" {
+			resp["rotation"] = map[string]any{"nextRotationTime": f.nextRotationTime}
+		}
+	case strings.HasPrefix(path, f.name+"/versions/") && strings.HasSuffix(path, ":access"):
+		version := strings.TrimSuffix(strings.TrimPrefix(path, f.name+"/versions/"), ":access")
+		if version == "latest" {
+			version = fmt.Sprint(len(f.versions))
+		}
+		var idx int
+		if _, err := fmt.Sscan(version, &idx); err != nil || idx < 1 || idx > len(f.versions) {
+			http.Error(w, "version not found", http.StatusNotFound)
+			return
+		}
+		resp = map[string]any{
+			"name": f.name + "/versions/" + version,
+			"payload": map[string]any{
+				"data": base64.StdEncoding.EncodeToString([]byte(f.versions[idx-1])),
+			},
+		}
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
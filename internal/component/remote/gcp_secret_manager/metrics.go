@@ -0,0 +1,30 @@
+package gcp_secret_manager
+
+import (
+	"github.com/grafana/alloy/internal/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	secretReadTotal       prometheus.Counter
+	secretReadErrorsTotal prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	var m metrics
+
+	m.secretReadTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "remote_gcp_secret_manager_secret_reads_total",
+		Help: "Total number of times the secret was read from GCP Secret Manager",
+	})
+	m.secretReadErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "remote_gcp_secret_manager_secret_read_errors_total",
+		Help: "Total number of times reading the secret from GCP Secret Manager failed",
+	})
+
+	if r != nil {
+		m.secretReadTotal = util.MustRegisterOrGet(r, m.secretReadTotal).(prometheus.Counter)
+		m.secretReadErrorsTotal = util.MustRegisterOrGet(r, m.secretReadErrorsTotal).(prometheus.Counter)
+	}
+	return &m
+}
@@ -0,0 +1,148 @@
+package cloudsecret
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+func TestRefresher_NextRead(t *testing.T) {
+	lastRead := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		rereadFrequency time.Duration
+		nextRotation    time.Time
+		expect          time.Time
+	}{
+		{
+			name: "never",
+		},
+		{
+			name:            "reread frequency",
+			rereadFrequency: time.Hour,
+			expect:          lastRead.Add(time.Hour),
+		},
+		{
+			name:            "rotation before reread",
+			rereadFrequency: time.Hour,
+			nextRotation:    lastRead.Add(time.Minute),
+			expect:          lastRead.Add(time.Minute),
+		},
+		{
+			name:            "rotation after reread",
+			rereadFrequency: time.Hour,
+			nextRotation:    lastRead.Add(2 * time.Hour),
+			expect:          lastRead.Add(time.Hour),
+		},
+		{
+			name:         "rotation only",
+			nextRotation: lastRead.Add(2 * time.Hour),
+			expect:       lastRead.Add(2 * time.Hour),
+		},
+		{
+			name:         "pending rotation",
+			nextRotation: lastRead.Add(-time.Minute),
+			expect:       lastRead.Add(pendingRotationRetry),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Refresher{
+				rereadFrequency: tc.rereadFrequency,
+				lastRead:        lastRead,
+				info:            Info{NextRotationTime: tc.nextRotation},
+			}
+			next, ok := r.nextRead()
+			require.Equal(t, !tc.expect.IsZero(), ok)
+			require.Equal(t, tc.expect, next)
+		})
+	}
+}
+
+func TestRefresher(t *testing.T) {
+	var (
+		reads, errs = prometheus.NewCounter(prometheus.CounterOpts{}), prometheus.NewCounter(prometheus.CounterOpts{})
+		version     = "v1"
+		readErr     error
+	)
+	getter := func(context.Context) (Result, error) {
+		return Result{Version: version}, readErr
+	}
+
+	readErr = errors.New("access denied")
+	_, err := NewRefresher(Options{Log: log.NewNopLogger(), Getter: getter, ReadCounter: reads, ErrorCounter: errs})
+	require.EqualError(t, err, "failed to read secret: access denied")
+
+	readErr = nil
+	r, err := NewRefresher(Options{Log: log.NewNopLogger(), Getter: getter, ReadCounter: reads, ErrorCounter: errs})
+	require.NoError(t, err)
+	require.Equal(t, component.HealthTypeHealthy, r.CurrentHealth().Health)
+	require.Equal(t, "v1", r.DebugInfo().Version)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go r.Run(ctx)
+
+	// A failed update keeps the debug info of the last successful read.
+	version, readErr = "v2", errors.New("access denied")
+	r.Update(0)
+	require.Equal(t, component.HealthTypeUnhealthy, r.CurrentHealth().Health)
+	require.Equal(t, "failed to read secret: access denied", r.CurrentHealth().Message)
+	require.Equal(t, "v1", r.DebugInfo().Version)
+
+	readErr = nil
+	r.Update(10 * time.Millisecond)
+	require.Equal(t, component.HealthTypeHealthy, r.CurrentHealth().Health)
+	require.Equal(t, "v2", r.DebugInfo().Version)
+
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(reads) >= 4
+	}, time.Minute, 10*time.Millisecond)
+	require.Equal(t, 2.0, testutil.ToFloat64(errs))
+}
+
+func TestData(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		expect map[string]alloytypes.Secret
+	}{
+		{
+			name:   "not json",
+			value:  "hunter2",
+			expect: map[string]alloytypes.Secret{},
+		},
+		{
+			name:   "json array",
+			value:  `["hunter2"]`,
+			expect: map[string]alloytypes.Secret{},
+		},
+		{
+			name:  "json object",
+			value: `{"password": "hunter2", "port": 5432, "tls": {"enabled": true}, "empty": null}`,
+			expect: map[string]alloytypes.Secret{
+				"password": "hunter2",
+				"port":     "5432",
+				"tls":      `{"enabled":true}`,
+				"empty":    "",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect, Data([]byte(tc.value)))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package cloudsecret
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/grafana/alloy/syntax/alloytypes"
+)
+
+// Data returns the fields of value if it holds a JSON object, which is how
+// cloud secret managers commonly store several values in a single secret.
+// String fields are used as-is, null fields are empty, and other fields are
+// kept as JSON. Data returns an empty map if value isn't a JSON object.
+func Data(value []byte) map[string]alloytypes.Secret {
+	data := make(map[string]alloytypes.Secret)
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(value, &fields); err != nil {
+		return data
+	}
+	for key, raw := range fields {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			data[key] = alloytypes.Secret(s)
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			buf.Reset()
+			buf.Write(raw)
+		}
+		data[key] = alloytypes.Secret(buf.String())
+	}
+	return data
+}
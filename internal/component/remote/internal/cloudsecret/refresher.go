@@ -0,0 +1,223 @@
+// Package cloudsecret implements the logic shared by the components which read
+// secrets from cloud secret managers.
+package cloudsecret
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+const (
+	// readTimeout is the maximum time a single read of a secret can take.
+	readTimeout = time.Minute
+
+	// pendingRotationRetry is how often to reread a secret whose scheduled
+	// rotation time passed, but whose rotation wasn't observed yet.
+	pendingRotationRetry = 5 * time.Minute
+)
+
+// Result describes a secret read by a Getter.
+type Result struct {
+	// Version identifies the version of the secret which was read.
+	Version string
+	// NextRotation is the time at which the secret is next scheduled to be
+	// rotated, or the zero time if the secret isn't rotated.
+	NextRotation time.Time
+}
+
+// Getter reads a secret and exports it. Getters aren't called concurrently.
+type Getter func(ctx context.Context) (Result, error)
+
+// Options configures a Refresher.
+type Options struct {
+	Log    log.Logger
+	Getter Getter
+
+	ReadCounter, ErrorCounter prometheus.Counter
+
+	// RereadFrequency is how often to reread the secret. Rereads on an interval
+	// are disabled if RereadFrequency is 0.
+	RereadFrequency time.Duration
+}
+
+// A Refresher reads a secret and rereads it periodically and after its
+// scheduled rotations.
+type Refresher struct {
+	log          log.Logger
+	getter       Getter
+	readCounter  prometheus.Counter
+	errorCounter prometheus.Counter
+	updateCh     chan struct{} // Written to when the schedule must be recomputed.
+
+	// readMut serializes reads so that the latest read is always exported last.
+	readMut sync.Mutex
+
+	mut             sync.RWMutex
+	rereadFrequency time.Duration
+	lastRead        time.Time
+	health          component.Health
+	info            Info
+}
+
+// Info holds non-sensitive metadata about the latest successful read of a
+// secret.
+type Info struct {
+	Version          string    `alloy:"version,attr"`
+	LastUpdateTime   time.Time `alloy:"last_update_time,attr"`
+	NextRotationTime time.Time `alloy:"next_rotation_time,attr,optional"`
+}
+
+// NewRefresher creates a new, unstarted Refresher. It returns an error if the
+// initial read of the secret fails.
+func NewRefresher(opts Options) (*Refresher, error) {
+	r := &Refresher{
+		log:          opts.Log,
+		getter:       opts.Getter,
+		readCounter:  opts.ReadCounter,
+		errorCounter: opts.ErrorCounter,
+		updateCh:     make(chan struct{}, 1),
+
+		rereadFrequency: opts.RereadFrequency,
+	}
+	if err := r.read(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to read secret: %w", err)
+	}
+	return r, nil
+}
+
+// read reads the secret, updating the health and debug info of r.
+func (r *Refresher) read(ctx context.Context) error {
+	r.readMut.Lock()
+	defer r.readMut.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.getter(ctx)
+	now := time.Now()
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	r.lastRead = now
+	if err != nil {
+		level.Error(r.log).Log("msg", "failed to read secret", "err", err)
+		r.errorCounter.Inc()
+		r.health = component.Health{
+			Health:     component.HealthTypeUnhealthy,
+			Message:    fmt.Sprintf("failed to read secret: %s", err),
+			UpdateTime: now,
+		}
+		return err
+	}
+
+	r.readCounter.Inc()
+	r.health = component.Health{
+		Health:     component.HealthTypeHealthy,
+		Message:    "read secret",
+		UpdateTime: now,
+	}
+	r.info = Info{
+		Version:          res.Version,
+		LastUpdateTime:   now,
+		NextRotationTime: res.NextRotation,
+	}
+	return nil
+}
+
+// Run runs the Refresher, blocking until the provided context is canceled.
+func (r *Refresher) Run(ctx context.Context) {
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		// Schedule the next read, if any.
+		var timerCh <-chan time.Time
+		if next, ok := r.nextRead(); ok {
+			if timer == nil {
+				timer = time.NewTimer(time.Until(next))
+			} else {
+				timer.Reset(time.Until(next))
+			}
+			timerCh = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timerCh:
+			level.Info(r.log).Log("msg", "rereading secret")
+			// Error is handled via setting health and debug info.
+			_ = r.read(ctx)
+		case <-r.updateCh:
+		}
+	}
+}
+
+// nextRead returns when the secret should be read next, which is either after
+// the reread frequency elapsed or when the secret is scheduled to be rotated.
+// ok is false if the secret doesn't need to be reread.
+func (r *Refresher) nextRead() (next time.Time, ok bool) {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+
+	if r.rereadFrequency > 0 {
+		next = r.lastRead.Add(r.rereadFrequency)
+	}
+
+	if rotation := r.info.NextRotationTime; !rotation.IsZero() {
+		if !rotation.After(r.lastRead) {
+			// The rotation is due but the last read didn't observe it yet, so the
+			// secret may still be rotating.
+			rotation = r.lastRead.Add(pendingRotationRetry)
+		}
+		if next.IsZero() || rotation.Before(next) {
+			next = rotation
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// Update sets the reread frequency of the Refresher and forces a new read of
+// the secret, which is expected after the configuration of the Getter
+// changed.
+func (r *Refresher) Update(rereadFrequency time.Duration) {
+	r.mut.Lock()
+	r.rereadFrequency = rereadFrequency
+	r.mut.Unlock()
+
+	// Error is handled via setting health and debug info.
+	_ = r.read(context.Background())
+
+	select {
+	case r.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+// CurrentHealth returns the health of the Refresher. It is healthy as long as
+// the latest read was successful.
+func (r *Refresher) CurrentHealth() component.Health {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	return r.health
+}
+
+// DebugInfo returns metadata about the latest successful read.
+func (r *Refresher) DebugInfo() Info {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	return r.info
+}
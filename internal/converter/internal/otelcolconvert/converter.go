@@ -65,6 +65,10 @@ type State struct {
 	// extensionLookup maps OTel extensions to Alloy component IDs.
 	extensionLookup map[component.ID]componentID
 
+	// afterPipelines holds the functions to call once the pipelines are
+	// converted. It's shared by the states of a single conversion.
+	afterPipelines *[]func(components []string)
+
 	componentID          componentstatus.InstanceID // ID of the current component being converted.
 	componentConfig      component.Config           // Config of the current component being converted.
 	componentLabelPrefix string                     // Prefix for the label of the current component being converted.
@@ -156,8 +160,32 @@ func (state *State) alloyLabelForComponent(c groupedInstanceID) string {
 // Next returns the set of Alloy component IDs for a given data type that the
 // current component being converted should forward data to.
 func (state *State) Next(c componentstatus.InstanceID, signal pipeline.Signal) []componentID {
-	instances := state.nextInstances(c, signal)
+	return state.alloyIDs(state.nextInstances(c, signal))
+}
+
+// NextInPipeline returns the set of Alloy component IDs that the current
+// component being converted should forward data to in the pipeline with the
+// given ID. It's meant for connectors which send data to specific pipelines
+// rather than to every pipeline they're a receiver of.
+func (state *State) NextInPipeline(c componentstatus.InstanceID, id pipeline.ID) []componentID {
+	cfg, ok := state.cfg.Service.Pipelines[id]
+	if !ok {
+		return nil
+	}
 
+	var instances []groupedInstanceID
+	for _, next := range nextInPipeline(cfg, c) {
+		instances = append(instances, groupedInstanceID{
+			InstanceID: next,
+			groupName:  id.Name(),
+		})
+	}
+	return state.alloyIDs(instances)
+}
+
+// alloyIDs returns the Alloy component IDs which receive data for the given
+// OpenTelemetry Collector component instances.
+func (state *State) alloyIDs(instances []groupedInstanceID) []componentID {
 	var ids []componentID
 
 	for _, instance := range instances {
@@ -230,6 +258,14 @@ func (state *State) nextInstances(c componentstatus.InstanceID, signal pipeline.
 	return ids
 }
 
+// AfterPipelines registers fn to be called with the IDs of the Alloy
+// components converted from the pipelines once every pipeline is converted.
+// It's meant for extensions which report on the components of the pipelines,
+// since extensions are converted before the pipelines.
+func (state *State) AfterPipelines(fn func(components []string)) {
+	*state.afterPipelines = append(*state.afterPipelines, fn)
+}
+
 func (state *State) LookupExtension(id component.ID) componentID {
 	cid, ok := state.extensionLookup[id]
 	if !ok {
@@ -0,0 +1,131 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/connector/count"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, countConnectorConverter{})
+}
+
+type countConnectorConverter struct{}
+
+// defaultCountProfiles is the metric which the upstream connector emits for
+// profiles by default.
+var defaultCountProfiles = []count.MetricInfo{{
+	Name:        "profile.count",
+	Description: "The number of profiles observed.",
+}}
+
+func (countConnectorConverter) Factory() component.Factory {
+	return countconnector.NewFactory()
+}
+
+func (countConnectorConverter) InputComponentName() string {
+	return "otelcol.connector.count"
+}
+
+func (countConnectorConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	args := toCountConnector(state, id, cfg.(*countconnector.Config))
+	block := common.NewBlockWithOverride([]string{"otelcol", "connector", "count"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	if !isDefaultCountMetrics(cfg.(*countconnector.Config).Profiles, defaultCountProfiles) {
+		diags.Add(
+			diag.SeverityLevelWarn,
+			fmt.Sprintf("%s: profiles aren't supported by otelcol.connector.count and are ignored", StringifyInstanceID(id)),
+		)
+	}
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toCountConnector(state *State, id componentstatus.InstanceID, cfg *countconnector.Config) *count.Arguments {
+	if cfg == nil {
+		return nil
+	}
+	var (
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+	)
+
+	return &count.Arguments{
+		Spans:      toCountMetricInfos(cfg.Spans, count.DefaultSpans),
+		SpanEvents: toCountMetricInfos(cfg.SpanEvents, count.DefaultSpanEvents),
+		Metrics:    toCountMetricInfos(cfg.Metrics, count.DefaultMetrics),
+		DataPoints: toCountMetricInfos(cfg.DataPoints, count.DefaultDataPoints),
+		Logs:       toCountMetricInfos(cfg.Logs, count.DefaultLogs),
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+		},
+		DebugMetrics: common.DefaultValue[count.Arguments]().DebugMetrics,
+	}
+}
+
+// toCountMetricInfos converts the metrics of a telemetry type, sorted by name.
+// It returns nil if in only holds the default metrics, so that the default
+// isn't written out explicitly.
+func toCountMetricInfos(in map[string]countconnector.MetricInfo, defaults []count.MetricInfo) []count.MetricInfo {
+	if isDefaultCountMetrics(in, defaults) {
+		return nil
+	}
+
+	res := make([]count.MetricInfo, 0, len(in))
+	for _, name := range slices.Sorted(maps.Keys(in)) {
+		info := in[name]
+
+		var attrs []count.AttributeConfig
+		for _, attr := range info.Attributes {
+			attrs = append(attrs, count.AttributeConfig{
+				Key:          attr.Key,
+				DefaultValue: attr.DefaultValue,
+			})
+		}
+
+		res = append(res, count.MetricInfo{
+			Name:        name,
+			Description: info.Description,
+			Conditions:  info.Conditions,
+			Attributes:  attrs,
+		})
+	}
+	return res
+}
+
+// isDefaultCountMetrics returns true if in holds exactly the metrics in
+// defaults, or if in is empty.
+func isDefaultCountMetrics(in map[string]countconnector.MetricInfo, defaults []count.MetricInfo) bool {
+	if len(in) == 0 {
+		return true
+	}
+	if len(in) != len(defaults) {
+		return false
+	}
+	for _, def := range defaults {
+		info, ok := in[def.Name]
+		if !ok || !reflect.DeepEqual(info, countconnector.MetricInfo{Description: def.Description}) {
+			return false
+		}
+	}
+	return true
+}
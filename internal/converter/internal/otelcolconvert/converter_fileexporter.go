@@ -0,0 +1,80 @@
+package otelcolconvert
+
+import (
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component/otelcol/exporter/file"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+func init() {
+	converters = append(converters, fileExporterConverter{})
+}
+
+type fileExporterConverter struct{}
+
+func (fileExporterConverter) Factory() component.Factory {
+	return fileexporter.NewFactory()
+}
+
+func (fileExporterConverter) InputComponentName() string {
+	return "otelcol.exporter.file"
+}
+
+func (fileExporterConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	fileCfg := cfg.(*fileexporter.Config)
+	if fileCfg.Encoding != nil {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: encoding extensions are not supported by otelcol.exporter.file", StringifyInstanceID(id)),
+		)
+	}
+
+	args := toFileExporter(fileCfg)
+	block := common.NewBlockWithOverride([]string{"otelcol", "exporter", "file"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toFileExporter(cfg *fileexporter.Config) *file.Arguments {
+	args := &file.Arguments{
+		Path:          cfg.Path,
+		Append:        cfg.Append,
+		Format:        cfg.FormatType,
+		Compression:   cfg.Compression,
+		FlushInterval: cfg.FlushInterval,
+		DebugMetrics:  common.DefaultValue[file.Arguments]().DebugMetrics,
+	}
+
+	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+		// Rotation is ignored upstream when group_by is enabled, so it's
+		// dropped rather than converted.
+		args.GroupBy = &file.GroupByArguments{
+			ResourceAttribute: cfg.GroupBy.ResourceAttribute,
+			MaxOpenFiles:      cfg.GroupBy.MaxOpenFiles,
+		}
+	} else if cfg.Rotation != nil {
+		args.Rotation = &file.RotationArguments{
+			MaxMegabytes: cfg.Rotation.MaxMegabytes,
+			MaxDays:      cfg.Rotation.MaxDays,
+			MaxBackups:   cfg.Rotation.MaxBackups,
+			LocalTime:    cfg.Rotation.LocalTime,
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,93 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/grafana/alloy/internal/component/otelcol/extension/health_check"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+func init() {
+	converters = append(converters, healthCheckExtensionConverter{})
+}
+
+type healthCheckExtensionConverter struct{}
+
+func (healthCheckExtensionConverter) Factory() component.Factory {
+	return healthcheckextension.NewFactory()
+}
+
+func (healthCheckExtensionConverter) InputComponentName() string {
+	return "otelcol.extension.health_check"
+}
+
+func (healthCheckExtensionConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	hcCfg := cfg.(*healthcheckextension.Config)
+	if hcCfg.TLS.HasValue() {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: tls is not supported by otelcol.extension.health_check", StringifyInstanceID(id)),
+		)
+	}
+	if hcCfg.Auth.HasValue() {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: auth is not supported by otelcol.extension.health_check", StringifyInstanceID(id)),
+		)
+	}
+	if hcCfg.CORS.HasValue() {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: cors is not supported by otelcol.extension.health_check", StringifyInstanceID(id)),
+		)
+	}
+	if hcCfg.CheckCollectorPipeline.Enabled {
+		diags.Add(
+			diag.SeverityLevelWarn,
+			fmt.Sprintf("%s: check_collector_pipeline is not supported by otelcol.extension.health_check, which always reports the health of the components of every pipeline", StringifyInstanceID(id)),
+		)
+	}
+
+	args := toHealthCheckExtension(hcCfg)
+	block := common.NewBlockWithOverride([]string{"otelcol", "extension", "health_check"}, label, args)
+
+	// Extensions are converted before the pipelines, so the components to
+	// check are only known once the pipelines are converted.
+	state.AfterPipelines(func(components []string) {
+		args.Components = slices.Sorted(slices.Values(components))
+		*block = *common.NewBlockWithOverride([]string{"otelcol", "extension", "health_check"}, label, args)
+	})
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toHealthCheckExtension(cfg *healthcheckextension.Config) *health_check.Arguments {
+	var responseBody *health_check.ResponseBodyArguments
+	if cfg.ResponseBody != nil {
+		responseBody = &health_check.ResponseBodyArguments{
+			Healthy:   cfg.ResponseBody.Healthy,
+			Unhealthy: cfg.ResponseBody.Unhealthy,
+		}
+	}
+
+	return &health_check.Arguments{
+		Endpoint:     cfg.Endpoint,
+		Path:         cfg.Path,
+		ResponseBody: responseBody,
+	}
+}
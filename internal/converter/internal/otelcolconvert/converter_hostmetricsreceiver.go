@@ -0,0 +1,338 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/hostmetrics"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, hostmetricsReceiverConverter{})
+}
+
+type hostmetricsReceiverConverter struct{}
+
+func (hostmetricsReceiverConverter) Factory() component.Factory {
+	return hostmetricsreceiver.NewFactory()
+}
+
+func (hostmetricsReceiverConverter) InputComponentName() string { return "" }
+
+func (hostmetricsReceiverConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	args, convDiags := toHostmetricsReceiver(state, id, cfg.(*hostmetricsreceiver.Config))
+	diags.AddAll(convDiags)
+	block := common.NewBlockWithOverride([]string{"otelcol", "receiver", "hostmetrics"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toHostmetricsReceiver(state *State, id componentstatus.InstanceID, cfg *hostmetricsreceiver.Config) (*hostmetrics.Arguments, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+		nextLogs    = state.Next(id, pipeline.SignalLogs)
+	)
+
+	if len(nextLogs) > 0 {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: logs are not supported by otelcol.receiver.hostmetrics", StringifyInstanceID(id)),
+		)
+	}
+
+	args := &hostmetrics.Arguments{
+		RootPath: cfg.RootPath,
+
+		ScraperControllerArguments: otelcol.ScraperControllerArguments{
+			CollectionInterval: cfg.CollectionInterval,
+			InitialDelay:       cfg.InitialDelay,
+			Timeout:            cfg.Timeout,
+		},
+
+		DebugMetrics: common.DefaultValue[hostmetrics.Arguments]().DebugMetrics,
+
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+		},
+	}
+
+	for typ, scraperCfg := range cfg.Scrapers {
+		scraper := encodeMapstruct(scraperCfg)
+
+		switch typ.String() {
+		case "cpu":
+			args.CPU = &hostmetrics.CPUScraperArguments{
+				Metrics: toHostmetricsCPUMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "disk":
+			args.Disk = &hostmetrics.DiskScraperArguments{
+				Include: toHostmetricsDeviceMatchArguments(encodeMapstruct(scraper["include"])),
+				Exclude: toHostmetricsDeviceMatchArguments(encodeMapstruct(scraper["exclude"])),
+				Metrics: toHostmetricsDiskMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "filesystem":
+			args.Filesystem = &hostmetrics.FilesystemScraperArguments{
+				IncludeVirtualFS:   scraper["include_virtual_filesystems"].(bool),
+				IncludeDevices:     toHostmetricsDeviceMatchArguments(encodeMapstruct(scraper["include_devices"])),
+				ExcludeDevices:     toHostmetricsDeviceMatchArguments(encodeMapstruct(scraper["exclude_devices"])),
+				IncludeFSTypes:     toHostmetricsFSTypeMatchArguments(encodeMapstruct(scraper["include_fs_types"])),
+				ExcludeFSTypes:     toHostmetricsFSTypeMatchArguments(encodeMapstruct(scraper["exclude_fs_types"])),
+				IncludeMountPoints: toHostmetricsMountPointMatchArguments(encodeMapstruct(scraper["include_mount_points"])),
+				ExcludeMountPoints: toHostmetricsMountPointMatchArguments(encodeMapstruct(scraper["exclude_mount_points"])),
+				Metrics:            toHostmetricsFilesystemMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "load":
+			args.Load = &hostmetrics.LoadScraperArguments{
+				CPUAverage: scraper["cpu_average"].(bool),
+				Metrics:    toHostmetricsLoadMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "memory":
+			args.Memory = &hostmetrics.MemoryScraperArguments{
+				Metrics: toHostmetricsMemoryMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "network":
+			args.Network = &hostmetrics.NetworkScraperArguments{
+				Include: toHostmetricsInterfaceMatchArguments(encodeMapstruct(scraper["include"])),
+				Exclude: toHostmetricsInterfaceMatchArguments(encodeMapstruct(scraper["exclude"])),
+				Metrics: toHostmetricsNetworkMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "paging":
+			args.Paging = &hostmetrics.PagingScraperArguments{
+				Metrics: toHostmetricsPagingMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "processes":
+			args.Processes = &hostmetrics.ProcessesScraperArguments{
+				Metrics: toHostmetricsProcessesMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		case "process":
+			args.Process = &hostmetrics.ProcessScraperArguments{
+				Include:                toHostmetricsNameMatchArguments(encodeMapstruct(scraper["include"])),
+				Exclude:                toHostmetricsNameMatchArguments(encodeMapstruct(scraper["exclude"])),
+				MuteProcessAllErrors:   hostmetricsFlag(scraper, "mute_process_all_errors"),
+				MuteProcessNameError:   hostmetricsFlag(scraper, "mute_process_name_error"),
+				MuteProcessIOError:     hostmetricsFlag(scraper, "mute_process_io_error"),
+				MuteProcessCgroupError: hostmetricsFlag(scraper, "mute_process_cgroup_error"),
+				MuteProcessExeError:    hostmetricsFlag(scraper, "mute_process_exe_error"),
+				MuteProcessUserError:   hostmetricsFlag(scraper, "mute_process_user_error"),
+				ScrapeProcessDelay:     scraper["scrape_process_delay"].(time.Duration),
+				Metrics:                toHostmetricsProcessMetricsArguments(encodeMapstruct(scraper["metrics"])),
+				ResourceAttributes:     toHostmetricsProcessResourceAttributesArguments(encodeMapstruct(scraper["resource_attributes"])),
+			}
+		case "system":
+			args.System = &hostmetrics.SystemScraperArguments{
+				Metrics: toHostmetricsSystemMetricsArguments(encodeMapstruct(scraper["metrics"])),
+			}
+		default:
+			diags.Add(
+				diag.SeverityLevelError,
+				fmt.Sprintf("%s: the %s scraper is not supported by otelcol.receiver.hostmetrics", StringifyInstanceID(id), typ),
+			)
+		}
+	}
+
+	return args, diags
+}
+
+// hostmetricsFlag returns the value of a boolean setting which is omitted
+// from cfg when it's false.
+func hostmetricsFlag(cfg map[string]any, key string) bool {
+	v, _ := cfg[key].(bool)
+	return v
+}
+
+// toHostmetricsMatch returns the match type and the values of an upstream
+// filter. The match type defaults to strict when it's unset.
+func toHostmetricsMatch(cfg map[string]any, key string) (string, []string) {
+	matchType := fmt.Sprint(cfg["match_type"])
+	if matchType == "" {
+		matchType = hostmetrics.MatchTypeStrict
+	}
+	values, _ := cfg[key].([]string)
+	return matchType, values
+}
+
+func toHostmetricsDeviceMatchArguments(cfg map[string]any) *hostmetrics.DeviceMatchArguments {
+	matchType, devices := toHostmetricsMatch(cfg, "devices")
+	if len(devices) == 0 {
+		return nil
+	}
+	return &hostmetrics.DeviceMatchArguments{Devices: devices, MatchType: matchType}
+}
+
+func toHostmetricsFSTypeMatchArguments(cfg map[string]any) *hostmetrics.FSTypeMatchArguments {
+	matchType, fsTypes := toHostmetricsMatch(cfg, "fs_types")
+	if len(fsTypes) == 0 {
+		return nil
+	}
+	return &hostmetrics.FSTypeMatchArguments{FSTypes: fsTypes, MatchType: matchType}
+}
+
+func toHostmetricsMountPointMatchArguments(cfg map[string]any) *hostmetrics.MountPointMatchArguments {
+	matchType, mountPoints := toHostmetricsMatch(cfg, "mount_points")
+	if len(mountPoints) == 0 {
+		return nil
+	}
+	return &hostmetrics.MountPointMatchArguments{MountPoints: mountPoints, MatchType: matchType}
+}
+
+func toHostmetricsInterfaceMatchArguments(cfg map[string]any) *hostmetrics.InterfaceMatchArguments {
+	matchType, interfaces := toHostmetricsMatch(cfg, "interfaces")
+	if len(interfaces) == 0 {
+		return nil
+	}
+	return &hostmetrics.InterfaceMatchArguments{Interfaces: interfaces, MatchType: matchType}
+}
+
+func toHostmetricsNameMatchArguments(cfg map[string]any) *hostmetrics.NameMatchArguments {
+	matchType, names := toHostmetricsMatch(cfg, "names")
+	if len(names) == 0 {
+		return nil
+	}
+	return &hostmetrics.NameMatchArguments{Names: names, MatchType: matchType}
+}
+
+func toHostmetricsMetricArguments(cfg map[string]any) hostmetrics.MetricArguments {
+	return hostmetrics.MetricArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
+
+func toHostmetricsResourceAttributeArguments(cfg map[string]any) hostmetrics.ResourceAttributeArguments {
+	return hostmetrics.ResourceAttributeArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
+
+func toHostmetricsCPUMetricsArguments(cfg map[string]any) hostmetrics.CPUMetricsArguments {
+	return hostmetrics.CPUMetricsArguments{
+		SystemCPUFrequency:     toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.frequency"])),
+		SystemCPULogicalCount:  toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.logical.count"])),
+		SystemCPUPhysicalCount: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.physical.count"])),
+		SystemCPUTime:          toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.time"])),
+		SystemCPUUtilization:   toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.utilization"])),
+	}
+}
+
+func toHostmetricsDiskMetricsArguments(cfg map[string]any) hostmetrics.DiskMetricsArguments {
+	return hostmetrics.DiskMetricsArguments{
+		SystemDiskIO:                toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.io"])),
+		SystemDiskIOTime:            toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.io_time"])),
+		SystemDiskMerged:            toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.merged"])),
+		SystemDiskOperationTime:     toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.operation_time"])),
+		SystemDiskOperations:        toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.operations"])),
+		SystemDiskPendingOperations: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.pending_operations"])),
+		SystemDiskWeightedIOTime:    toHostmetricsMetricArguments(encodeMapstruct(cfg["system.disk.weighted_io_time"])),
+	}
+}
+
+func toHostmetricsFilesystemMetricsArguments(cfg map[string]any) hostmetrics.FilesystemMetricsArguments {
+	return hostmetrics.FilesystemMetricsArguments{
+		SystemFilesystemInodesUsage: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.filesystem.inodes.usage"])),
+		SystemFilesystemUsage:       toHostmetricsMetricArguments(encodeMapstruct(cfg["system.filesystem.usage"])),
+		SystemFilesystemUtilization: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.filesystem.utilization"])),
+	}
+}
+
+func toHostmetricsLoadMetricsArguments(cfg map[string]any) hostmetrics.LoadMetricsArguments {
+	return hostmetrics.LoadMetricsArguments{
+		SystemCPULoadAverage15m: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.load_average.15m"])),
+		SystemCPULoadAverage1m:  toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.load_average.1m"])),
+		SystemCPULoadAverage5m:  toHostmetricsMetricArguments(encodeMapstruct(cfg["system.cpu.load_average.5m"])),
+	}
+}
+
+func toHostmetricsMemoryMetricsArguments(cfg map[string]any) hostmetrics.MemoryMetricsArguments {
+	return hostmetrics.MemoryMetricsArguments{
+		SystemLinuxMemoryAvailable: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.linux.memory.available"])),
+		SystemLinuxMemoryDirty:     toHostmetricsMetricArguments(encodeMapstruct(cfg["system.linux.memory.dirty"])),
+		SystemMemoryLimit:          toHostmetricsMetricArguments(encodeMapstruct(cfg["system.memory.limit"])),
+		SystemMemoryPageSize:       toHostmetricsMetricArguments(encodeMapstruct(cfg["system.memory.page_size"])),
+		SystemMemoryUsage:          toHostmetricsMetricArguments(encodeMapstruct(cfg["system.memory.usage"])),
+		SystemMemoryUtilization:    toHostmetricsMetricArguments(encodeMapstruct(cfg["system.memory.utilization"])),
+	}
+}
+
+func toHostmetricsNetworkMetricsArguments(cfg map[string]any) hostmetrics.NetworkMetricsArguments {
+	return hostmetrics.NetworkMetricsArguments{
+		SystemNetworkConnections:    toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.connections"])),
+		SystemNetworkConntrackCount: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.conntrack.count"])),
+		SystemNetworkConntrackMax:   toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.conntrack.max"])),
+		SystemNetworkDropped:        toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.dropped"])),
+		SystemNetworkErrors:         toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.errors"])),
+		SystemNetworkIO:             toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.io"])),
+		SystemNetworkPackets:        toHostmetricsMetricArguments(encodeMapstruct(cfg["system.network.packets"])),
+	}
+}
+
+func toHostmetricsPagingMetricsArguments(cfg map[string]any) hostmetrics.PagingMetricsArguments {
+	return hostmetrics.PagingMetricsArguments{
+		SystemPagingFaults:      toHostmetricsMetricArguments(encodeMapstruct(cfg["system.paging.faults"])),
+		SystemPagingOperations:  toHostmetricsMetricArguments(encodeMapstruct(cfg["system.paging.operations"])),
+		SystemPagingUsage:       toHostmetricsMetricArguments(encodeMapstruct(cfg["system.paging.usage"])),
+		SystemPagingUtilization: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.paging.utilization"])),
+	}
+}
+
+func toHostmetricsProcessesMetricsArguments(cfg map[string]any) hostmetrics.ProcessesMetricsArguments {
+	return hostmetrics.ProcessesMetricsArguments{
+		SystemProcessesCount:   toHostmetricsMetricArguments(encodeMapstruct(cfg["system.processes.count"])),
+		SystemProcessesCreated: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.processes.created"])),
+	}
+}
+
+func toHostmetricsProcessMetricsArguments(cfg map[string]any) hostmetrics.ProcessMetricsArguments {
+	return hostmetrics.ProcessMetricsArguments{
+		ProcessContextSwitches:     toHostmetricsMetricArguments(encodeMapstruct(cfg["process.context_switches"])),
+		ProcessCPUTime:             toHostmetricsMetricArguments(encodeMapstruct(cfg["process.cpu.time"])),
+		ProcessCPUUtilization:      toHostmetricsMetricArguments(encodeMapstruct(cfg["process.cpu.utilization"])),
+		ProcessDiskIO:              toHostmetricsMetricArguments(encodeMapstruct(cfg["process.disk.io"])),
+		ProcessDiskOperations:      toHostmetricsMetricArguments(encodeMapstruct(cfg["process.disk.operations"])),
+		ProcessHandles:             toHostmetricsMetricArguments(encodeMapstruct(cfg["process.handles"])),
+		ProcessMemoryUsage:         toHostmetricsMetricArguments(encodeMapstruct(cfg["process.memory.usage"])),
+		ProcessMemoryUtilization:   toHostmetricsMetricArguments(encodeMapstruct(cfg["process.memory.utilization"])),
+		ProcessMemoryVirtual:       toHostmetricsMetricArguments(encodeMapstruct(cfg["process.memory.virtual"])),
+		ProcessOpenFileDescriptors: toHostmetricsMetricArguments(encodeMapstruct(cfg["process.open_file_descriptors"])),
+		ProcessPagingFaults:        toHostmetricsMetricArguments(encodeMapstruct(cfg["process.paging.faults"])),
+		ProcessSignalsPending:      toHostmetricsMetricArguments(encodeMapstruct(cfg["process.signals_pending"])),
+		ProcessThreads:             toHostmetricsMetricArguments(encodeMapstruct(cfg["process.threads"])),
+		ProcessUptime:              toHostmetricsMetricArguments(encodeMapstruct(cfg["process.uptime"])),
+	}
+}
+
+func toHostmetricsProcessResourceAttributesArguments(cfg map[string]any) hostmetrics.ProcessResourceAttributesArguments {
+	return hostmetrics.ProcessResourceAttributesArguments{
+		ProcessCgroup:         toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.cgroup"])),
+		ProcessCommand:        toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.command"])),
+		ProcessCommandLine:    toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.command_line"])),
+		ProcessExecutableName: toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.executable.name"])),
+		ProcessExecutablePath: toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.executable.path"])),
+		ProcessOwner:          toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.owner"])),
+		ProcessParentPID:      toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.parent_pid"])),
+		ProcessPID:            toHostmetricsResourceAttributeArguments(encodeMapstruct(cfg["process.pid"])),
+	}
+}
+
+func toHostmetricsSystemMetricsArguments(cfg map[string]any) hostmetrics.SystemMetricsArguments {
+	return hostmetrics.SystemMetricsArguments{
+		SystemUptime: toHostmetricsMetricArguments(encodeMapstruct(cfg["system.uptime"])),
+	}
+}
@@ -0,0 +1,224 @@
+package otelcolconvert
+
+import (
+	"fmt"
+
+	commonk8s "github.com/grafana/alloy/internal/component/common/kubernetes"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/k8s_cluster"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, k8sClusterReceiverConverter{})
+}
+
+type k8sClusterReceiverConverter struct{}
+
+func (k8sClusterReceiverConverter) Factory() component.Factory {
+	return k8sclusterreceiver.NewFactory()
+}
+
+func (k8sClusterReceiverConverter) InputComponentName() string { return "" }
+
+func (k8sClusterReceiverConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	args, convDiags := toK8sClusterReceiver(state, id, cfg.(*k8sclusterreceiver.Config))
+	diags.AddAll(convDiags)
+	block := common.NewBlockWithOverride([]string{"otelcol", "receiver", "k8s_cluster"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toK8sClusterReceiver(state *State, id componentstatus.InstanceID, cfg *k8sclusterreceiver.Config) (*k8s_cluster.Arguments, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+		nextLogs    = state.Next(id, pipeline.SignalLogs)
+	)
+
+	if len(cfg.MetadataExporters) > 0 {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: metadata_exporters is not supported by otelcol.receiver.k8s_cluster", StringifyInstanceID(id)),
+		)
+	}
+	if cfg.K8sLeaderElector != nil {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: k8s_leader_elector is not supported by otelcol.receiver.k8s_cluster", StringifyInstanceID(id)),
+		)
+	}
+
+	diags.AddAll(checkKubernetesAPIAuthType(id, "otelcol.receiver.k8s_cluster", string(cfg.AuthType), cfg.Context))
+
+	metricsBuilder := encodeMapstruct(cfg.MetricsBuilderConfig)
+
+	args := &k8s_cluster.Arguments{
+		Client: commonk8s.DefaultClientArguments,
+
+		CollectionInterval:         cfg.CollectionInterval,
+		MetadataCollectionInterval: cfg.MetadataCollectionInterval,
+		NodeConditionsToReport:     cfg.NodeConditionTypesToReport,
+		AllocatableTypesToReport:   cfg.AllocatableTypesToReport,
+		Distribution:               cfg.Distribution,
+		Namespace:                  cfg.Namespace,
+
+		Metrics:            toK8sClusterMetricsArguments(encodeMapstruct(metricsBuilder["metrics"])),
+		ResourceAttributes: toK8sClusterResourceAttributesArguments(encodeMapstruct(metricsBuilder["resource_attributes"])),
+
+		DebugMetrics: common.DefaultValue[k8s_cluster.Arguments]().DebugMetrics,
+
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+			Logs:    ToTokenizedConsumers(nextLogs),
+		},
+	}
+
+	return args, diags
+}
+
+func toK8sClusterMetricsArguments(cfg map[string]any) k8s_cluster.MetricsArguments {
+	return k8s_cluster.MetricsArguments{
+		K8sContainerCPULimit:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.cpu_limit"])),
+		K8sContainerCPURequest:              toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.cpu_request"])),
+		K8sContainerEphemeralstorageLimit:   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.ephemeralstorage_limit"])),
+		K8sContainerEphemeralstorageRequest: toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.ephemeralstorage_request"])),
+		K8sContainerMemoryLimit:             toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.memory_limit"])),
+		K8sContainerMemoryRequest:           toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.memory_request"])),
+		K8sContainerReady:                   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.ready"])),
+		K8sContainerRestarts:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.restarts"])),
+		K8sContainerStatusState:             toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.status.state"])),
+		K8sContainerStorageLimit:            toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.storage_limit"])),
+		K8sContainerStorageRequest:          toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.container.storage_request"])),
+		K8sCronjobActiveJobs:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.cronjob.active_jobs"])),
+		K8sDaemonsetCurrentScheduledNodes:   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.daemonset.current_scheduled_nodes"])),
+		K8sDaemonsetDesiredScheduledNodes:   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.daemonset.desired_scheduled_nodes"])),
+		K8sDaemonsetMisscheduledNodes:       toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.daemonset.misscheduled_nodes"])),
+		K8sDaemonsetReadyNodes:              toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.daemonset.ready_nodes"])),
+		K8sDeploymentAvailable:              toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.deployment.available"])),
+		K8sDeploymentDesired:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.deployment.desired"])),
+		K8sHPACurrentReplicas:               toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.hpa.current_replicas"])),
+		K8sHPADesiredReplicas:               toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.hpa.desired_replicas"])),
+		K8sHPAMaxReplicas:                   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.hpa.max_replicas"])),
+		K8sHPAMinReplicas:                   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.hpa.min_replicas"])),
+		K8sJobActivePods:                    toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.job.active_pods"])),
+		K8sJobDesiredSuccessfulPods:         toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.job.desired_successful_pods"])),
+		K8sJobFailedPods:                    toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.job.failed_pods"])),
+		K8sJobMaxParallelPods:               toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.job.max_parallel_pods"])),
+		K8sJobSuccessfulPods:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.job.successful_pods"])),
+		K8sNamespacePhase:                   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.namespace.phase"])),
+		K8sNodeCondition:                    toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.node.condition"])),
+		K8sPodPhase:                         toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.pod.phase"])),
+		K8sPodStatusReason:                  toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.pod.status_reason"])),
+		K8sReplicasetAvailable:              toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.replicaset.available"])),
+		K8sReplicasetDesired:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.replicaset.desired"])),
+		K8sReplicationControllerAvailable:   toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.replication_controller.available"])),
+		K8sReplicationControllerDesired:     toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.replication_controller.desired"])),
+		K8sResourceQuotaHardLimit:           toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.resource_quota.hard_limit"])),
+		K8sResourceQuotaUsed:                toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.resource_quota.used"])),
+		K8sStatefulsetCurrentPods:           toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.statefulset.current_pods"])),
+		K8sStatefulsetDesiredPods:           toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.statefulset.desired_pods"])),
+		K8sStatefulsetReadyPods:             toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.statefulset.ready_pods"])),
+		K8sStatefulsetUpdatedPods:           toK8sClusterMetricArguments(encodeMapstruct(cfg["k8s.statefulset.updated_pods"])),
+		OpenshiftAppliedclusterquotaLimit:   toK8sClusterMetricArguments(encodeMapstruct(cfg["openshift.appliedclusterquota.limit"])),
+		OpenshiftAppliedclusterquotaUsed:    toK8sClusterMetricArguments(encodeMapstruct(cfg["openshift.appliedclusterquota.used"])),
+		OpenshiftClusterquotaLimit:          toK8sClusterMetricArguments(encodeMapstruct(cfg["openshift.clusterquota.limit"])),
+		OpenshiftClusterquotaUsed:           toK8sClusterMetricArguments(encodeMapstruct(cfg["openshift.clusterquota.used"])),
+	}
+}
+
+func toK8sClusterResourceAttributesArguments(cfg map[string]any) k8s_cluster.ResourceAttributesArguments {
+	return k8s_cluster.ResourceAttributesArguments{
+		ContainerID:                            toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["container.id"])),
+		ContainerImageName:                     toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["container.image.name"])),
+		ContainerImageTag:                      toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["container.image.tag"])),
+		ContainerRuntime:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["container.runtime"])),
+		ContainerRuntimeVersion:                toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["container.runtime.version"])),
+		K8sContainerName:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.container.name"])),
+		K8sContainerStatusLastTerminatedReason: toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.container.status.last_terminated_reason"])),
+		K8sCronjobName:                         toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.cronjob.name"])),
+		K8sCronjobUID:                          toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.cronjob.uid"])),
+		K8sDaemonsetName:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.daemonset.name"])),
+		K8sDaemonsetUID:                        toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.daemonset.uid"])),
+		K8sDeploymentName:                      toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.deployment.name"])),
+		K8sDeploymentUID:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.deployment.uid"])),
+		K8sHPAName:                             toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.hpa.name"])),
+		K8sHPAScaletargetrefApiversion:         toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.hpa.scaletargetref.apiversion"])),
+		K8sHPAScaletargetrefKind:               toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.hpa.scaletargetref.kind"])),
+		K8sHPAScaletargetrefName:               toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.hpa.scaletargetref.name"])),
+		K8sHPAUID:                              toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.hpa.uid"])),
+		K8sJobName:                             toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.job.name"])),
+		K8sJobUID:                              toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.job.uid"])),
+		K8sKubeletVersion:                      toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.kubelet.version"])),
+		K8sNamespaceName:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.namespace.name"])),
+		K8sNamespaceUID:                        toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.namespace.uid"])),
+		K8sNodeName:                            toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.node.name"])),
+		K8sNodeUID:                             toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.node.uid"])),
+		K8sPodName:                             toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.pod.name"])),
+		K8sPodQosClass:                         toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.pod.qos_class"])),
+		K8sPodUID:                              toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.pod.uid"])),
+		K8sReplicasetName:                      toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.replicaset.name"])),
+		K8sReplicasetUID:                       toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.replicaset.uid"])),
+		K8sReplicationcontrollerName:           toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.replicationcontroller.name"])),
+		K8sReplicationcontrollerUID:            toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.replicationcontroller.uid"])),
+		K8sResourcequotaName:                   toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.resourcequota.name"])),
+		K8sResourcequotaUID:                    toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.resourcequota.uid"])),
+		K8sStatefulsetName:                     toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.statefulset.name"])),
+		K8sStatefulsetUID:                      toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["k8s.statefulset.uid"])),
+		OpenshiftClusterquotaName:              toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["openshift.clusterquota.name"])),
+		OpenshiftClusterquotaUID:               toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["openshift.clusterquota.uid"])),
+		OSDescription:                          toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["os.description"])),
+		OSType:                                 toK8sClusterResourceAttributeArguments(encodeMapstruct(cfg["os.type"])),
+	}
+}
+
+func toK8sClusterMetricArguments(cfg map[string]any) k8s_cluster.MetricArguments {
+	return k8s_cluster.MetricArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
+
+func toK8sClusterResourceAttributeArguments(cfg map[string]any) k8s_cluster.ResourceAttributeArguments {
+	return k8s_cluster.ResourceAttributeArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
+
+// checkKubernetesAPIAuthType reports the authentication settings of the
+// Kubernetes API which can't be converted. Only the service account of the
+// Pod is supported, which is what the client block of the Kubernetes
+// components uses by default.
+func checkKubernetesAPIAuthType(id componentstatus.InstanceID, componentName string, authType string, context string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if authType != "serviceAccount" {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: auth_type %q is not supported by %s, configure the client block instead", StringifyInstanceID(id), authType, componentName),
+		)
+	}
+	if context != "" {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: context is not supported by %s, set kubeconfig_file in the client block instead", StringifyInstanceID(id), componentName),
+		)
+	}
+
+	return diags
+}
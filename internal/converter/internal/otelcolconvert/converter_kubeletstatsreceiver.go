@@ -0,0 +1,252 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	commoncfg "github.com/grafana/alloy/internal/component/common/config"
+	commonk8s "github.com/grafana/alloy/internal/component/common/kubernetes"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/kubeletstats"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, kubeletstatsReceiverConverter{})
+}
+
+type kubeletstatsReceiverConverter struct{}
+
+func (kubeletstatsReceiverConverter) Factory() component.Factory {
+	return kubeletstatsreceiver.NewFactory()
+}
+
+func (kubeletstatsReceiverConverter) InputComponentName() string { return "" }
+
+func (kubeletstatsReceiverConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	args, convDiags := toKubeletstatsReceiver(state, id, cfg.(*kubeletstatsreceiver.Config))
+	diags.AddAll(convDiags)
+	block := common.NewBlockWithOverride([]string{"otelcol", "receiver", "kubeletstats"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+// The files of the service account that Kubernetes provisions for each Pod.
+const (
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+func toKubeletstatsReceiver(state *State, id componentstatus.InstanceID, cfg *kubeletstatsreceiver.Config) (*kubeletstats.Arguments, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+	)
+
+	// Only the TLS files are supported to authenticate to the kubelet.
+	tls := cfg.ClientConfig.Config
+	supportedTLS := configtls.Config{
+		CAFile:   tls.CAFile,
+		CertFile: tls.CertFile,
+		KeyFile:  tls.KeyFile,
+	}
+	if !reflect.DeepEqual(tls, supportedTLS) {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: only the ca_file, cert_file and key_file TLS settings are supported by otelcol.receiver.kubeletstats", StringifyInstanceID(id)),
+		)
+	}
+
+	httpClient := commoncfg.DefaultHTTPClientConfig
+	httpClient.TLSConfig = commoncfg.TLSConfig{
+		CAFile:             tls.CAFile,
+		CertFile:           tls.CertFile,
+		KeyFile:            tls.KeyFile,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	scheme := "https://"
+	switch cfg.AuthType {
+	case "tls":
+	case "serviceAccount":
+		httpClient.BearerTokenFile = serviceAccountTokenPath
+		if httpClient.TLSConfig.CAFile == "" {
+			httpClient.TLSConfig.CAFile = serviceAccountCAPath
+		}
+	case "none":
+		scheme = "http://"
+	default:
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: auth_type %q is not supported by otelcol.receiver.kubeletstats, set node and configure the client block instead", StringifyInstanceID(id), cfg.AuthType),
+		)
+	}
+
+	endpoint := cfg.Endpoint
+	switch {
+	case endpoint == "":
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: otelcol.receiver.kubeletstats requires endpoint to be set", StringifyInstanceID(id)),
+		)
+	case !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://"):
+		endpoint = scheme + endpoint
+	}
+
+	metricsBuilder := encodeMapstruct(cfg.MetricsBuilderConfig)
+
+	args := &kubeletstats.Arguments{
+		ScraperControllerArguments: otelcol.ScraperControllerArguments{
+			CollectionInterval: cfg.CollectionInterval,
+			InitialDelay:       cfg.InitialDelay,
+			Timeout:            cfg.Timeout,
+		},
+
+		Endpoint:         endpoint,
+		HTTPClientConfig: httpClient,
+
+		Node: cfg.NodeName,
+
+		CollectAllNetworkInterfaces: kubeletstats.NetworkInterfacesArguments{
+			Pod:  cfg.NetworkCollectAllInterfaces.PodMetrics,
+			Node: cfg.NetworkCollectAllInterfaces.NodeMetrics,
+		},
+		Metrics:            toKubeletstatsMetricsArguments(encodeMapstruct(metricsBuilder["metrics"])),
+		ResourceAttributes: toKubeletstatsResourceAttributesArguments(encodeMapstruct(metricsBuilder["resource_attributes"])),
+
+		DebugMetrics: common.DefaultValue[kubeletstats.Arguments]().DebugMetrics,
+
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+		},
+	}
+
+	for _, label := range cfg.ExtraMetadataLabels {
+		args.ExtraMetadataLabels = append(args.ExtraMetadataLabels, string(label))
+	}
+	for _, group := range cfg.MetricGroupsToCollect {
+		args.MetricGroups = append(args.MetricGroups, string(group))
+	}
+	if cfg.K8sAPIConfig != nil {
+		diags.AddAll(checkKubernetesAPIAuthType(id, "otelcol.receiver.kubeletstats", string(cfg.K8sAPIConfig.AuthType), cfg.K8sAPIConfig.Context))
+		client := commonk8s.DefaultClientArguments
+		args.Client = &client
+	}
+
+	return args, diags
+}
+
+func toKubeletstatsMetricsArguments(cfg map[string]any) kubeletstats.MetricsArguments {
+	return kubeletstats.MetricsArguments{
+		ContainerCPUTime:                     toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.cpu.time"])),
+		ContainerCPUUsage:                    toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.cpu.usage"])),
+		ContainerCPUUtilization:              toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.cpu.utilization"])),
+		ContainerFilesystemAvailable:         toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.filesystem.available"])),
+		ContainerFilesystemCapacity:          toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.filesystem.capacity"])),
+		ContainerFilesystemUsage:             toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.filesystem.usage"])),
+		ContainerMemoryAvailable:             toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.available"])),
+		ContainerMemoryMajorPageFaults:       toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.major_page_faults"])),
+		ContainerMemoryPageFaults:            toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.page_faults"])),
+		ContainerMemoryRss:                   toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.rss"])),
+		ContainerMemoryUsage:                 toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.usage"])),
+		ContainerMemoryWorkingSet:            toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.memory.working_set"])),
+		ContainerUptime:                      toKubeletstatsMetricArguments(encodeMapstruct(cfg["container.uptime"])),
+		K8sContainerCPUNodeUtilization:       toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.cpu.node.utilization"])),
+		K8sContainerCPULimitUtilization:      toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.cpu_limit_utilization"])),
+		K8sContainerCPURequestUtilization:    toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.cpu_request_utilization"])),
+		K8sContainerMemoryNodeUtilization:    toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.memory.node.utilization"])),
+		K8sContainerMemoryLimitUtilization:   toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.memory_limit_utilization"])),
+		K8sContainerMemoryRequestUtilization: toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.container.memory_request_utilization"])),
+		K8sNodeCPUTime:                       toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.cpu.time"])),
+		K8sNodeCPUUsage:                      toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.cpu.usage"])),
+		K8sNodeCPUUtilization:                toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.cpu.utilization"])),
+		K8sNodeFilesystemAvailable:           toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.filesystem.available"])),
+		K8sNodeFilesystemCapacity:            toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.filesystem.capacity"])),
+		K8sNodeFilesystemUsage:               toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.filesystem.usage"])),
+		K8sNodeMemoryAvailable:               toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.available"])),
+		K8sNodeMemoryMajorPageFaults:         toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.major_page_faults"])),
+		K8sNodeMemoryPageFaults:              toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.page_faults"])),
+		K8sNodeMemoryRss:                     toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.rss"])),
+		K8sNodeMemoryUsage:                   toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.usage"])),
+		K8sNodeMemoryWorkingSet:              toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.memory.working_set"])),
+		K8sNodeNetworkErrors:                 toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.network.errors"])),
+		K8sNodeNetworkIO:                     toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.network.io"])),
+		K8sNodeUptime:                        toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.node.uptime"])),
+		K8sPodCPUNodeUtilization:             toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu.node.utilization"])),
+		K8sPodCPUTime:                        toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu.time"])),
+		K8sPodCPUUsage:                       toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu.usage"])),
+		K8sPodCPUUtilization:                 toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu.utilization"])),
+		K8sPodCPULimitUtilization:            toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu_limit_utilization"])),
+		K8sPodCPURequestUtilization:          toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.cpu_request_utilization"])),
+		K8sPodFilesystemAvailable:            toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.filesystem.available"])),
+		K8sPodFilesystemCapacity:             toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.filesystem.capacity"])),
+		K8sPodFilesystemUsage:                toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.filesystem.usage"])),
+		K8sPodMemoryAvailable:                toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.available"])),
+		K8sPodMemoryMajorPageFaults:          toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.major_page_faults"])),
+		K8sPodMemoryNodeUtilization:          toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.node.utilization"])),
+		K8sPodMemoryPageFaults:               toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.page_faults"])),
+		K8sPodMemoryRss:                      toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.rss"])),
+		K8sPodMemoryUsage:                    toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.usage"])),
+		K8sPodMemoryWorkingSet:               toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory.working_set"])),
+		K8sPodMemoryLimitUtilization:         toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory_limit_utilization"])),
+		K8sPodMemoryRequestUtilization:       toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.memory_request_utilization"])),
+		K8sPodNetworkErrors:                  toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.network.errors"])),
+		K8sPodNetworkIO:                      toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.network.io"])),
+		K8sPodUptime:                         toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.pod.uptime"])),
+		K8sVolumeAvailable:                   toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.volume.available"])),
+		K8sVolumeCapacity:                    toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.volume.capacity"])),
+		K8sVolumeInodes:                      toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.volume.inodes"])),
+		K8sVolumeInodesFree:                  toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.volume.inodes.free"])),
+		K8sVolumeInodesUsed:                  toKubeletstatsMetricArguments(encodeMapstruct(cfg["k8s.volume.inodes.used"])),
+	}
+}
+
+func toKubeletstatsResourceAttributesArguments(cfg map[string]any) kubeletstats.ResourceAttributesArguments {
+	return kubeletstats.ResourceAttributesArguments{
+		AWSVolumeID:                  toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["aws.volume.id"])),
+		ContainerID:                  toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["container.id"])),
+		FSType:                       toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["fs.type"])),
+		GCEPDName:                    toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["gce.pd.name"])),
+		GlusterfsEndpointsName:       toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["glusterfs.endpoints.name"])),
+		GlusterfsPath:                toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["glusterfs.path"])),
+		K8sContainerName:             toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.container.name"])),
+		K8sNamespaceName:             toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.namespace.name"])),
+		K8sNodeName:                  toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.node.name"])),
+		K8sPersistentvolumeclaimName: toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.persistentvolumeclaim.name"])),
+		K8sPodName:                   toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.pod.name"])),
+		K8sPodUID:                    toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.pod.uid"])),
+		K8sVolumeName:                toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.volume.name"])),
+		K8sVolumeType:                toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["k8s.volume.type"])),
+		Partition:                    toKubeletstatsResourceAttributeArguments(encodeMapstruct(cfg["partition"])),
+	}
+}
+
+func toKubeletstatsMetricArguments(cfg map[string]any) kubeletstats.MetricArguments {
+	return kubeletstats.MetricArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
+
+func toKubeletstatsResourceAttributeArguments(cfg map[string]any) kubeletstats.ResourceAttributeArguments {
+	return kubeletstats.ResourceAttributeArguments{
+		Enabled: cfg["enabled"].(bool),
+	}
+}
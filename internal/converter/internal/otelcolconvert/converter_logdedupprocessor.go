@@ -0,0 +1,64 @@
+package otelcolconvert
+
+import (
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/processor/logdedup"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, logDedupProcessorConverter{})
+}
+
+type logDedupProcessorConverter struct{}
+
+func (logDedupProcessorConverter) Factory() component.Factory {
+	return logdedupprocessor.NewFactory()
+}
+
+func (logDedupProcessorConverter) InputComponentName() string {
+	return "otelcol.processor.logdedup"
+}
+
+func (logDedupProcessorConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	args := toLogDedupProcessor(state, id, cfg.(*logdedupprocessor.Config))
+	block := common.NewBlockWithOverride([]string{"otelcol", "processor", "logdedup"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toLogDedupProcessor(state *State, id componentstatus.InstanceID, cfg *logdedupprocessor.Config) *logdedup.Arguments {
+	nextLogs := state.Next(id, pipeline.SignalLogs)
+
+	// The upstream defaults are empty, non-nil lists. Appending them to nil
+	// lists keeps them out of the converted config.
+	return &logdedup.Arguments{
+		LogCountAttribute: cfg.LogCountAttribute,
+		Interval:          cfg.Interval,
+		Timezone:          cfg.Timezone,
+		ExcludeFields:     append([]string(nil), cfg.ExcludeFields...),
+		IncludeFields:     append([]string(nil), cfg.IncludeFields...),
+		Conditions:        append([]string(nil), cfg.Conditions...),
+		Output: &otelcol.ConsumerArguments{
+			Logs: ToTokenizedConsumers(nextLogs),
+		},
+		DebugMetrics: common.DefaultValue[logdedup.Arguments]().DebugMetrics,
+	}
+}
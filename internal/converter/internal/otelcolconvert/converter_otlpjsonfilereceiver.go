@@ -0,0 +1,99 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/alecthomas/units"
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/extension"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/filelog"
+	"github.com/grafana/alloy/internal/component/otelcol/receiver/otlpjsonfile"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, otlpJSONFileReceiverConverter{})
+}
+
+type otlpJSONFileReceiverConverter struct{}
+
+func (otlpJSONFileReceiverConverter) Factory() component.Factory {
+	return otlpjsonfilereceiver.NewFactory()
+}
+
+func (otlpJSONFileReceiverConverter) InputComponentName() string {
+	return "otelcol.receiver.otlpjsonfile"
+}
+
+func (otlpJSONFileReceiverConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+	overrideHook := func(val interface{}) interface{} {
+		switch val.(type) {
+		case extension.ExtensionHandler:
+			ext := state.LookupExtension(*cfg.(*otlpjsonfilereceiver.Config).StorageID)
+			return common.CustomTokenizer{Expr: fmt.Sprintf("%s.%s.handler", strings.Join(ext.Name, "."), ext.Label)}
+		}
+		return common.GetAlloyTypesOverrideHook()(val)
+	}
+
+	args := toOTLPJSONFileReceiver(state, id, cfg.(*otlpjsonfilereceiver.Config))
+	block := common.NewBlockWithOverrideFn([]string{"otelcol", "receiver", "otlpjsonfile"}, label, args, overrideHook)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toOTLPJSONFileReceiver(state *State, id componentstatus.InstanceID, cfg *otlpjsonfilereceiver.Config) *otlpjsonfile.Arguments {
+	var (
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+		nextLogs    = state.Next(id, pipeline.SignalLogs)
+		nextTraces  = state.Next(id, pipeline.SignalTraces)
+	)
+
+	args := &otlpjsonfile.Arguments{
+		MatchCriteria:      *toOtelcolMatchCriteria(cfg.Criteria),
+		PollInterval:       cfg.PollInterval,
+		MaxConcurrentFiles: cfg.MaxConcurrentFiles,
+		MaxBatches:         cfg.MaxBatches,
+		StartAt:            cfg.StartAt,
+		FingerprintSize:    units.Base2Bytes(cfg.FingerprintSize),
+		MaxLogSize:         units.Base2Bytes(cfg.MaxLogSize),
+		DeleteAfterRead:    cfg.DeleteAfterRead,
+		Compression:        cfg.Compression,
+		ReplayFile:         cfg.ReplayFile,
+		Resolver:           filelog.Resolver(cfg.Resolver),
+		DebugMetrics:       common.DefaultValue[otlpjsonfile.Arguments]().DebugMetrics,
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+			Logs:    ToTokenizedConsumers(nextLogs),
+			Traces:  ToTokenizedConsumers(nextTraces),
+		},
+	}
+
+	// Leave out the ordering_criteria block if it wasn't configured.
+	if reflect.ValueOf(cfg.Criteria.OrderingCriteria).IsZero() {
+		args.MatchCriteria.OrderingCriteria = nil
+	}
+
+	if cfg.StorageID != nil {
+		args.Storage = &extension.ExtensionHandler{
+			ID: *cfg.StorageID,
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,55 @@
+package otelcolconvert
+
+import (
+	"fmt"
+
+	"github.com/grafana/alloy/internal/component/otelcol/extension/pprof"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+func init() {
+	converters = append(converters, pprofExtensionConverter{})
+}
+
+type pprofExtensionConverter struct{}
+
+func (pprofExtensionConverter) Factory() component.Factory {
+	return pprofextension.NewFactory()
+}
+
+func (pprofExtensionConverter) InputComponentName() string {
+	return "otelcol.extension.pprof"
+}
+
+func (pprofExtensionConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	pprofCfg := cfg.(*pprofextension.Config)
+	if pprofCfg.SaveToFile != "" {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: save_to_file is not supported by otelcol.extension.pprof", StringifyInstanceID(id)),
+		)
+	}
+
+	args := &pprof.Arguments{
+		Endpoint:             pprofCfg.TCPAddr.Endpoint,
+		BlockProfileFraction: pprofCfg.BlockProfileFraction,
+		MutexProfileFraction: pprofCfg.MutexProfileFraction,
+	}
+	block := common.NewBlockWithOverride([]string{"otelcol", "extension", "pprof"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
@@ -0,0 +1,86 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/processor/redaction"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, redactionProcessorConverter{})
+}
+
+type redactionProcessorConverter struct{}
+
+func (redactionProcessorConverter) Factory() component.Factory {
+	return redactionprocessor.NewFactory()
+}
+
+func (redactionProcessorConverter) InputComponentName() string {
+	return "otelcol.processor.redaction"
+}
+
+func (redactionProcessorConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	label := state.AlloyComponentLabel()
+
+	redactionCfg := cfg.(*redactionprocessor.Config)
+	if !reflect.ValueOf(redactionCfg.DBSanitizer).IsZero() {
+		diags.Add(
+			diag.SeverityLevelError,
+			fmt.Sprintf("%s: db_sanitizer is not supported by otelcol.processor.redaction", StringifyInstanceID(id)),
+		)
+	}
+
+	args := toRedactionProcessor(state, id, redactionCfg)
+	block := common.NewBlockWithOverride([]string{"otelcol", "processor", "redaction"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toRedactionProcessor(state *State, id componentstatus.InstanceID, cfg *redactionprocessor.Config) *redaction.Arguments {
+	var (
+		nextMetrics = state.Next(id, pipeline.SignalMetrics)
+		nextLogs    = state.Next(id, pipeline.SignalLogs)
+		nextTraces  = state.Next(id, pipeline.SignalTraces)
+	)
+
+	// An empty summary behaves the same as a silent one upstream.
+	summary := cfg.Summary
+	if summary == "" {
+		summary = redaction.SummarySilent
+	}
+
+	return &redaction.Arguments{
+		AllowAllKeys:       cfg.AllowAllKeys,
+		AllowedKeys:        cfg.AllowedKeys,
+		IgnoredKeys:        cfg.IgnoredKeys,
+		BlockedKeyPatterns: cfg.BlockedKeyPatterns,
+		BlockedValues:      cfg.BlockedValues,
+		AllowedValues:      cfg.AllowedValues,
+		RedactAllTypes:     cfg.RedactAllTypes,
+		HashFunction:       cfg.HashFunction.String(),
+		Summary:            summary,
+		Output: &otelcol.ConsumerArguments{
+			Metrics: ToTokenizedConsumers(nextMetrics),
+			Logs:    ToTokenizedConsumers(nextLogs),
+			Traces:  ToTokenizedConsumers(nextTraces),
+		},
+		DebugMetrics: common.DefaultValue[redaction.Arguments]().DebugMetrics,
+	}
+}
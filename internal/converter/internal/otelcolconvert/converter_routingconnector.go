@@ -0,0 +1,110 @@
+package otelcolconvert
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/grafana/alloy/internal/component/otelcol"
+	"github.com/grafana/alloy/internal/component/otelcol/connector/routing"
+	"github.com/grafana/alloy/internal/converter/diag"
+	"github.com/grafana/alloy/internal/converter/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func init() {
+	converters = append(converters, routingConnectorConverter{})
+}
+
+type routingConnectorConverter struct{}
+
+func (routingConnectorConverter) Factory() component.Factory {
+	return routingconnector.NewFactory()
+}
+
+func (routingConnectorConverter) InputComponentName() string {
+	return "otelcol.connector.routing"
+}
+
+func (routingConnectorConverter) ConvertAndAppend(state *State, id componentstatus.InstanceID, cfg component.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The routing connector sends data to the pipelines in its routing table
+	// rather than to the pipelines of the groups it's a receiver of. Only
+	// convert it for the groups where it receives data.
+	if !slices.Contains(state.group.Traces.Exporters, id.ComponentID()) &&
+		!slices.Contains(state.group.Metrics.Exporters, id.ComponentID()) &&
+		!slices.Contains(state.group.Logs.Exporters, id.ComponentID()) {
+		return diags
+	}
+
+	label := state.AlloyComponentLabel()
+
+	args := toRoutingConnector(state, id, cfg.(*routingconnector.Config))
+	block := common.NewBlockWithOverride([]string{"otelcol", "connector", "routing"}, label, args)
+
+	diags.Add(
+		diag.SeverityLevelInfo,
+		fmt.Sprintf("Converted %s into %s", StringifyInstanceID(id), StringifyBlock(block)),
+	)
+
+	state.Body().AppendBlock(block)
+	return diags
+}
+
+func toRoutingConnector(state *State, id componentstatus.InstanceID, cfg *routingconnector.Config) *routing.Arguments {
+	if cfg == nil {
+		return nil
+	}
+
+	table := make([]routing.Route, 0, len(cfg.Table))
+	for _, item := range cfg.Table {
+		context := item.Context
+		if context == "" {
+			context = "resource"
+		}
+
+		table = append(table, routing.Route{
+			Context:   context,
+			Statement: item.Statement,
+			Condition: item.Condition,
+			Output:    toRoutingOutput(state, id, item.Pipelines),
+		})
+	}
+
+	var defaultOutput *otelcol.ConsumerArguments
+	if len(cfg.DefaultPipelines) > 0 {
+		defaultOutput = toRoutingOutput(state, id, cfg.DefaultPipelines)
+	}
+
+	return &routing.Arguments{
+		ErrorMode:     cfg.ErrorMode,
+		Table:         table,
+		DefaultOutput: defaultOutput,
+		DebugMetrics:  common.DefaultValue[routing.Arguments]().DebugMetrics,
+	}
+}
+
+// toRoutingOutput returns the consumers of the given pipelines.
+func toRoutingOutput(state *State, id componentstatus.InstanceID, pipelineIDs []pipeline.ID) *otelcol.ConsumerArguments {
+	var nextMetrics, nextLogs, nextTraces []componentID
+	for _, pipelineID := range pipelineIDs {
+		next := state.NextInPipeline(id, pipelineID)
+		switch pipelineID.Signal() {
+		case pipeline.SignalMetrics:
+			nextMetrics = append(nextMetrics, next...)
+		case pipeline.SignalLogs:
+			nextLogs = append(nextLogs, next...)
+		case pipeline.SignalTraces:
+			nextTraces = append(nextTraces, next...)
+		}
+	}
+
+	return &otelcol.ConsumerArguments{
+		Metrics: ToTokenizedConsumers(nextMetrics),
+		Logs:    ToTokenizedConsumers(nextLogs),
+		Traces:  ToTokenizedConsumers(nextTraces),
+	}
+}
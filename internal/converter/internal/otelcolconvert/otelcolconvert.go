@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	_ "github.com/grafana/alloy/internal/component/all" // Register all components
@@ -207,6 +208,7 @@ func AppendConfig(file *builder.File, cfg *otelcol.Config, labelPrefix string, e
 	// Since there's no concept of multiple extensions per group or telemetry
 	// signal, we can build them before iterating over the groups.
 	extensionTable := make(map[component.ID]componentID, len(cfg.Service.Extensions))
+	var afterPipelines []func(components []string)
 
 	for _, ext := range cfg.Service.Extensions {
 		cidPtr := componentstatus.NewInstanceID(ext, component.KindExtension)
@@ -222,6 +224,7 @@ func AppendConfig(file *builder.File, cfg *otelcol.Config, labelPrefix string, e
 			group:  &pipelineGroup{},
 
 			converterLookup: converterTable,
+			afterPipelines:  &afterPipelines,
 
 			componentConfig:      cfg.Extensions,
 			componentID:          cid,
@@ -242,6 +245,7 @@ func AppendConfig(file *builder.File, cfg *otelcol.Config, labelPrefix string, e
 		}
 	}
 
+	pipelinesStart := len(file.Body().Nodes())
 	for _, group := range filteredGroups {
 		receiverIDs := filterIDs(group.Receivers(), connectorIDs)
 		processorIDs := group.Processors()
@@ -271,6 +275,7 @@ func AppendConfig(file *builder.File, cfg *otelcol.Config, labelPrefix string, e
 
 					converterLookup: converterTable,
 					extensionLookup: extensionTable,
+					afterPipelines:  &afterPipelines,
 
 					componentConfig:      componentSet.configLookup[id],
 					componentID:          componentID,
@@ -288,6 +293,18 @@ func AppendConfig(file *builder.File, cfg *otelcol.Config, labelPrefix string, e
 		}
 	}
 
+	if len(afterPipelines) > 0 {
+		var components []string
+		for _, node := range file.Body().Nodes()[pipelinesStart:] {
+			if block, ok := node.(*builder.Block); ok {
+				components = append(components, strings.Join(append(slices.Clone(block.Name), block.Label), "."))
+			}
+		}
+		for _, fn := range afterPipelines {
+			fn(components)
+		}
+	}
+
 	return diags
 }
 
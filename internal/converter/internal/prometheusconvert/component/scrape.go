@@ -90,6 +90,7 @@ func toScrapeArguments(scrapeConfig *prom_config.ScrapeConfig, forwardTo []stora
 		MetricNameEscapingScheme:       scrapeConfig.MetricNameEscapingScheme,
 		ScrapeFallbackProtocol:         fallbackProtocol,
 		Clustering:                     cluster.ComponentBlock{Enabled: false},
+		TargetStatus:                   scrape.DefaultTargetStatusArguments,
 	}
 	return alloyArgs
 }
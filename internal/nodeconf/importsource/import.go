@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/featuregate"
 	"github.com/grafana/alloy/syntax/vm"
 )
 
@@ -15,6 +16,7 @@ const (
 	String
 	Git
 	HTTP
+	OCI
 )
 
 const (
@@ -22,8 +24,12 @@ const (
 	BlockNameString = "import.string"
 	BlockNameHTTP   = "import.http"
 	BlockNameGit    = "import.git"
+	BlockNameOCI    = "import.oci"
 )
 
+// StabilityLevelOCI is the stability level of import.oci blocks.
+const StabilityLevelOCI = featuregate.StabilityExperimental
+
 const ModulePath = "module_path"
 
 // ImportSource retrieves a module from a source.
@@ -52,6 +58,8 @@ func NewImportSource(sourceType SourceType, managedOpts component.Options, eval
 		return NewImportHTTP(managedOpts, eval, onContentChange)
 	case Git:
 		return NewImportGit(managedOpts, eval, onContentChange)
+	case OCI:
+		return NewImportOCI(managedOpts, eval, onContentChange)
 	}
 	panic(fmt.Errorf("unsupported source type: %v", sourceType))
 }
@@ -67,6 +75,8 @@ func GetSourceType(fullName string) SourceType {
 		return HTTP
 	case BlockNameGit:
 		return Git
+	case BlockNameOCI:
+		return OCI
 	}
 	panic(fmt.Errorf("name does not map to a known source type: %v", fullName))
 }
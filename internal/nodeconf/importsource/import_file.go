@@ -64,6 +64,8 @@ type FileArguments struct {
 	Type filedetector.Detector `alloy:"detector,attr,optional"`
 	// PollFrequency determines the frequency to check for changes when Type is Poll.
 	PollFrequency time.Duration `alloy:"poll_frequency,attr,optional"`
+	// Verify configures the verification of the module before it's loaded.
+	Verify *VerifyArguments `alloy:"verify,block,optional"`
 }
 
 var DefaultFileArguments = FileArguments{
@@ -184,6 +186,22 @@ func (im *ImportFile) readFile() error {
 		fileContents[f] = string(bb)
 	}
 
+	readSignature := func(name string) ([]byte, error) {
+		if dir {
+			name = filepath.Join(im.args.Filename, name)
+		}
+		return os.ReadFile(name + SignatureSuffix)
+	}
+	if err := im.args.Verify.verifyModule(fileContents, readSignature); err != nil {
+		im.setHealth(component.Health{
+			Health:     component.HealthTypeUnhealthy,
+			Message:    fmt.Sprintf("failed to verify module: %s", err),
+			UpdateTime: time.Now(),
+		})
+		level.Error(im.managedOpts.Logger).Log("msg", "failed to verify module, keeping the previous module", "err", err)
+		return err
+	}
+
 	im.setHealth(component.Health{
 		Health:     component.HealthTypeHealthy,
 		Message:    "read file",
@@ -50,6 +50,35 @@ type GitArguments struct {
 	Path          string            `alloy:"path,attr"`
 	PullFrequency time.Duration     `alloy:"pull_frequency,attr,optional"`
 	GitAuthConfig vcs.GitAuthConfig `alloy:",squash"`
+	// Verify configures the verification of the module before it's loaded.
+	Verify *GitVerifyArguments `alloy:"verify,block,optional"`
+}
+
+// GitVerifyArguments configures the verification of modules imported from a
+// git repository.
+type GitVerifyArguments struct {
+	VerifyArguments VerifyArguments `alloy:",squash"`
+	// ArmoredKeyRing holds ASCII-armored OpenPGP public keys. The revision
+	// must be a commit or an annotated tag signed by one of the keys.
+	ArmoredKeyRing string `alloy:"armored_keyring,attr,optional"`
+}
+
+var _ syntax.Validator = (*GitVerifyArguments)(nil)
+
+// Validate implements syntax.Validator.
+func (args *GitVerifyArguments) Validate() error {
+	if args.ArmoredKeyRing == "" && args.VerifyArguments.SHA256 == "" && len(args.VerifyArguments.PublicKeys) == 0 {
+		return fmt.Errorf("at least one of sha256, public_keys and armored_keyring must be set")
+	}
+	return args.VerifyArguments.validate()
+}
+
+// content returns the arguments used to verify the content of the module.
+func (args *GitVerifyArguments) content() *VerifyArguments {
+	if args == nil {
+		return nil
+	}
+	return &args.VerifyArguments
 }
 
 var DefaultGitArguments = GitArguments{
@@ -256,14 +285,20 @@ func (im *ImportGit) pollFile(ctx context.Context, args GitArguments) error {
 		return err
 	}
 
+	if args.Verify != nil && args.Verify.ArmoredKeyRing != "" {
+		if err := im.repo.VerifyRevision(args.Verify.ArmoredKeyRing); err != nil {
+			return fmt.Errorf("failed to verify module: %w", err)
+		}
+	}
+
 	if info.IsDir() {
-		return im.handleDirectory(args.Path)
+		return im.handleDirectory(args.Path, args.Verify.content())
 	}
 
-	return im.handleFile(args.Path)
+	return im.handleFile(args.Path, args.Verify.content())
 }
 
-func (im *ImportGit) handleDirectory(path string) error {
+func (im *ImportGit) handleDirectory(path string, verify *VerifyArguments) error {
 	filesInfo, err := im.repo.ReadDir(path)
 	if err != nil {
 		return err
@@ -280,16 +315,31 @@ func (im *ImportGit) handleDirectory(path string) error {
 		}
 		content[fi.Name()] = string(bb)
 	}
+
+	readSignature := func(name string) ([]byte, error) {
+		return im.repo.ReadFile(filepath.Join(path, name+SignatureSuffix))
+	}
+	if err := verify.verifyModule(content, readSignature); err != nil {
+		return fmt.Errorf("failed to verify module: %w", err)
+	}
 	im.onContentChange(content)
 	return nil
 }
 
-func (im *ImportGit) handleFile(path string) error {
+func (im *ImportGit) handleFile(path string, verify *VerifyArguments) error {
 	bb, err := im.repo.ReadFile(path)
 	if err != nil {
 		return err
 	}
-	im.onContentChange(map[string]string{path: string(bb)})
+
+	content := map[string]string{path: string(bb)}
+	readSignature := func(name string) ([]byte, error) {
+		return im.repo.ReadFile(name + SignatureSuffix)
+	}
+	if err := verify.verifyModule(content, readSignature); err != nil {
+		return fmt.Errorf("failed to verify module: %w", err)
+	}
+	im.onContentChange(content)
 	return nil
 }
 
@@ -3,14 +3,21 @@ package importsource
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"path"
+	"strings"
+	"sync"
 	"time"
 
+	prom_config "github.com/prometheus/common/config"
+
 	"github.com/grafana/alloy/internal/component"
 	common_config "github.com/grafana/alloy/internal/component/common/config"
 	remote_http "github.com/grafana/alloy/internal/component/remote/http"
 	"github.com/grafana/alloy/internal/runtime/equality"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
 	"github.com/grafana/alloy/syntax/vm"
 )
 
@@ -20,19 +27,32 @@ type ImportHTTP struct {
 	arguments         HTTPArguments
 	managedOpts       component.Options
 	eval              *vm.Evaluator
+	onContentChange   func(map[string]string)
+
+	// remote.http removes the surrounding whitespace of the content it
+	// retrieves, so modules which need to be verified are retrieved again
+	// when remote.http reports new content.
+	verifyMut  sync.Mutex
+	verifyArgs HTTPArguments // Arguments of the latest update of remote.http.
+	content    string        // Latest content reported by remote.http.
+	verifySeq  uint64        // Incremented for each verification.
+	verifyErr  error         // Error of the latest verification.
+	verifyTime time.Time     // Time of the latest verification.
 }
 
 var _ ImportSource = (*ImportHTTP)(nil)
 
 func NewImportHTTP(managedOpts component.Options, eval *vm.Evaluator, onContentChange func(map[string]string)) *ImportHTTP {
+	im := &ImportHTTP{
+		eval:            eval,
+		onContentChange: onContentChange,
+	}
 	opts := managedOpts
 	opts.OnStateChange = func(e component.Exports) {
-		onContentChange(map[string]string{opts.ID: e.(remote_http.Exports).Content.Value})
-	}
-	return &ImportHTTP{
-		managedOpts: opts,
-		eval:        eval,
+		im.onRemoteContent(e.(remote_http.Exports).Content.Value)
 	}
+	im.managedOpts = opts
+	return im
 }
 
 // HTTPArguments holds values which are used to configure the remote.http component.
@@ -46,6 +66,9 @@ type HTTPArguments struct {
 	Body    string            `alloy:"body,attr,optional"`
 
 	Client common_config.HTTPClientConfig `alloy:"client,block,optional"`
+
+	// Verify configures the verification of the module before it's loaded.
+	Verify *VerifyArguments `alloy:"verify,block,optional"`
 }
 
 // DefaultHTTPArguments holds default settings for HTTPArguments.
@@ -75,6 +98,10 @@ func (im *ImportHTTP) Evaluate(scope *vm.Scope) error {
 		Body:          arguments.Body,
 		Client:        arguments.Client,
 	}
+	im.verifyMut.Lock()
+	im.verifyArgs = arguments
+	im.verifyMut.Unlock()
+
 	if im.managedRemoteHTTP == nil {
 		var err error
 		im.managedRemoteHTTP, err = remote_http.New(im.managedOpts, remoteHttpArguments)
@@ -82,6 +109,7 @@ func (im *ImportHTTP) Evaluate(scope *vm.Scope) error {
 			return fmt.Errorf("creating http component: %w", err)
 		}
 		im.arguments = arguments
+		return im.lastVerifyErr()
 	}
 
 	if equality.DeepEqual(im.arguments, arguments) {
@@ -92,8 +120,104 @@ func (im *ImportHTTP) Evaluate(scope *vm.Scope) error {
 	if err := im.managedRemoteHTTP.Update(remoteHttpArguments); err != nil {
 		return fmt.Errorf("updating component: %w", err)
 	}
+	if !equality.DeepEqual(im.arguments.Verify, arguments.Verify) {
+		// remote.http doesn't report content which didn't change, so verify it
+		// again with the new arguments.
+		im.verifyMut.Lock()
+		content := im.content
+		im.verifyMut.Unlock()
+		im.onRemoteContent(content)
+	}
 	im.arguments = arguments
-	return nil
+	return im.lastVerifyErr()
+}
+
+// onRemoteContent is called when remote.http reports new content. The content
+// is passed on if it passes verification.
+func (im *ImportHTTP) onRemoteContent(content string) {
+	im.verifyMut.Lock()
+	im.content = content
+	im.verifySeq++
+	seq, args := im.verifySeq, im.verifyArgs
+	im.verifyMut.Unlock()
+
+	// Retrieving the module may take up to the poll timeout, so verifyMut
+	// isn't held meanwhile.
+	var verifyErr error
+	if args.Verify != nil {
+		content, verifyErr = im.fetchVerified(args)
+	}
+
+	im.verifyMut.Lock()
+	defer im.verifyMut.Unlock()
+	if seq != im.verifySeq {
+		// Newer content or arguments were reported while the module was
+		// retrieved, so this result is outdated.
+		return
+	}
+	im.verifyErr, im.verifyTime = verifyErr, time.Now()
+	if verifyErr != nil {
+		level.Error(im.managedOpts.Logger).Log("msg", "failed to verify module, keeping the previous module", "err", verifyErr)
+		return
+	}
+	im.onContentChange(map[string]string{im.managedOpts.ID: content})
+}
+
+// fetchVerified retrieves the module and its signature and verifies them. It
+// returns the module with the surrounding whitespace removed, like
+// remote.http.
+func (im *ImportHTTP) fetchVerified(args HTTPArguments) (string, error) {
+	cli, err := prom_config.NewClientFromConfig(*args.Client.Convert(), im.managedOpts.ID)
+	if err != nil {
+		return "", err
+	}
+	defer cli.CloseIdleConnections()
+
+	fetch := func(method, url, body string) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), args.PollTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range args.Headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := cli.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status code %s from %s", resp.Status, url)
+		}
+		return io.ReadAll(resp.Body)
+	}
+
+	bb, err := fetch(args.Method, args.URL, args.Body)
+	if err != nil {
+		return "", err
+	}
+	readSignature := func(string) ([]byte, error) {
+		sigURL, err := url.Parse(args.URL)
+		if err != nil {
+			return nil, err
+		}
+		sigURL.Path += SignatureSuffix
+		return fetch(http.MethodGet, sigURL.String(), "")
+	}
+	files := map[string]string{args.URL: string(bb)}
+	if err := args.Verify.verifyModule(files, readSignature); err != nil {
+		return "", fmt.Errorf("failed to verify module: %w", err)
+	}
+	return strings.TrimSpace(string(bb)), nil
+}
+
+func (im *ImportHTTP) lastVerifyErr() error {
+	im.verifyMut.Lock()
+	defer im.verifyMut.Unlock()
+	return im.verifyErr
 }
 
 func (im *ImportHTTP) Run(ctx context.Context) error {
@@ -101,7 +225,18 @@ func (im *ImportHTTP) Run(ctx context.Context) error {
 }
 
 func (im *ImportHTTP) CurrentHealth() component.Health {
-	return im.managedRemoteHTTP.CurrentHealth()
+	health := im.managedRemoteHTTP.CurrentHealth()
+
+	im.verifyMut.Lock()
+	defer im.verifyMut.Unlock()
+	if im.verifyErr != nil {
+		health = component.LeastHealthy(health, component.Health{
+			Health:     component.HealthTypeUnhealthy,
+			Message:    im.verifyErr.Error(),
+			UpdateTime: im.verifyTime,
+		})
+	}
+	return health
 }
 
 // Update the evaluator.
@@ -0,0 +1,87 @@
+package importsource
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+func TestImportHTTP_VerifyOutdatedContent(t *testing.T) {
+	const (
+		oldModule = `declare "old" {}`
+		newModule = `declare "new" {}`
+	)
+
+	var (
+		requests atomic.Int64
+		received = make(chan struct{})
+		release  = make(chan struct{})
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			close(received)
+			<-release
+			_, _ = w.Write([]byte(oldModule))
+			return
+		}
+		_, _ = w.Write([]byte(newModule))
+	}))
+	defer srv.Close()
+	releaseOnce := sync.OnceFunc(func() { close(release) })
+	defer releaseOnce()
+
+	var (
+		contentMut sync.Mutex
+		contents   []string
+	)
+	im := &ImportHTTP{
+		managedOpts: component.Options{ID: "import.http.test", Logger: log.NewNopLogger()},
+		onContentChange: func(m map[string]string) {
+			contentMut.Lock()
+			defer contentMut.Unlock()
+			contents = append(contents, m["import.http.test"])
+		},
+	}
+	args := DefaultHTTPArguments
+	args.URL = srv.URL
+	// Only the new module passes verification.
+	args.Verify = &VerifyArguments{SHA256: fmt.Sprintf("%x", sha256.Sum256([]byte(newModule)))}
+	im.verifyArgs = args
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		im.onRemoteContent(oldModule)
+	}()
+	<-received
+
+	// The first verification is still retrieving the module, which must not
+	// block the result of the latest verification or newer content.
+	verified := make(chan error)
+	go func() { verified <- im.lastVerifyErr() }()
+	select {
+	case <-verified:
+	case <-time.After(5 * time.Second):
+		t.Fatal("retrieving the module blocks the import")
+	}
+	im.onRemoteContent(newModule)
+
+	releaseOnce()
+	<-done
+
+	// The outdated result of the first verification is discarded.
+	require.NoError(t, im.lastVerifyErr())
+	contentMut.Lock()
+	defer contentMut.Unlock()
+	require.Equal(t, []string{newModule}, contents)
+}
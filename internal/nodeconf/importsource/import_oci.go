@@ -0,0 +1,530 @@
+package importsource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	prom_config "github.com/prometheus/common/config"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+	"oras.land/oras-go/v2/registry/remote/retry"
+
+	"github.com/grafana/alloy/internal/component"
+	common_config "github.com/grafana/alloy/internal/component/common/config"
+	"github.com/grafana/alloy/internal/runtime/equality"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/alloy/syntax/vm"
+)
+
+// ImportOCI imports a module from an artifact stored in an OCI registry.
+// Each file of the module is a layer of the artifact whose title annotation
+// is the name of the file, which is the layout used by `oras push`.
+type ImportOCI struct {
+	opts            component.Options
+	log             log.Logger
+	eval            *vm.Evaluator
+	mut             sync.RWMutex
+	args            OCIArguments
+	repo            *remote.Repository
+	digest          digest.Digest // Digest of the manifest which was last loaded.
+	onContentChange func(map[string]string)
+
+	argsChanged chan struct{}
+
+	healthMut sync.RWMutex
+	health    component.Health
+}
+
+var (
+	_ ImportSource              = (*ImportOCI)(nil)
+	_ component.Component       = (*ImportOCI)(nil)
+	_ component.HealthComponent = (*ImportOCI)(nil)
+)
+
+// OCIArguments holds values which are used to configure an import.oci block.
+type OCIArguments struct {
+	Repository       string                   `alloy:"repository,attr"`
+	Reference        string                   `alloy:"reference,attr,optional"`
+	Path             string                   `alloy:"path,attr,optional"`
+	PullFrequency    time.Duration            `alloy:"pull_frequency,attr,optional"`
+	PullTimeout      time.Duration            `alloy:"pull_timeout,attr,optional"`
+	PlainHTTP        bool                     `alloy:"plain_http,attr,optional"`
+	DockerConfigFile string                   `alloy:"docker_config_file,attr,optional"`
+	BasicAuth        *common_config.BasicAuth `alloy:"basic_auth,block,optional"`
+	TLSConfig        common_config.TLSConfig  `alloy:"tls_config,block,optional"`
+	Verify           *VerifyArguments         `alloy:"verify,block,optional"`
+}
+
+// DefaultOCIArguments holds default settings for OCIArguments.
+var DefaultOCIArguments = OCIArguments{
+	Reference:     "latest",
+	PullFrequency: time.Minute,
+	PullTimeout:   30 * time.Second,
+}
+
+var (
+	_ syntax.Validator = (*OCIArguments)(nil)
+	_ syntax.Defaulter = (*OCIArguments)(nil)
+)
+
+// SetToDefault implements syntax.Defaulter.
+func (args *OCIArguments) SetToDefault() {
+	*args = DefaultOCIArguments
+}
+
+// Validate implements syntax.Validator.
+func (args *OCIArguments) Validate() error {
+	if args.Reference == "" {
+		return fmt.Errorf("reference must not be empty")
+	}
+	if args.PullTimeout <= 0 {
+		return fmt.Errorf("pull_timeout must be greater than 0")
+	}
+	if args.Path != "" && !filepath.IsLocal(args.Path) {
+		return fmt.Errorf("path %q must be a relative path inside the artifact", args.Path)
+	}
+	if args.BasicAuth != nil && args.DockerConfigFile != "" {
+		return fmt.Errorf("at most one of basic_auth and docker_config_file must be configured")
+	}
+	if err := args.BasicAuth.Validate(); err != nil {
+		return err
+	}
+	return args.TLSConfig.Validate()
+}
+
+// isDigest reports whether the reference is a digest, which always points to
+// the same content.
+func (args *OCIArguments) isDigest() bool {
+	_, err := digest.Parse(args.Reference)
+	return err == nil
+}
+
+func NewImportOCI(managedOpts component.Options, eval *vm.Evaluator, onContentChange func(map[string]string)) *ImportOCI {
+	return &ImportOCI{
+		opts:            managedOpts,
+		log:             managedOpts.Logger,
+		eval:            eval,
+		argsChanged:     make(chan struct{}, 1),
+		onContentChange: onContentChange,
+	}
+}
+
+func (im *ImportOCI) Evaluate(scope *vm.Scope) error {
+	var arguments OCIArguments
+	if err := im.eval.Evaluate(scope, &arguments); err != nil {
+		return fmt.Errorf("decoding configuration: %w", err)
+	}
+
+	if equality.DeepEqual(im.args, arguments) {
+		return nil
+	}
+
+	if err := im.Update(arguments); err != nil {
+		return fmt.Errorf("updating component: %w", err)
+	}
+	return nil
+}
+
+func (im *ImportOCI) Run(ctx context.Context) error {
+	var (
+		ticker  *time.Ticker
+		tickerC <-chan time.Time
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if ticker != nil {
+				ticker.Stop()
+			}
+			return nil
+
+		case <-im.argsChanged:
+			im.mut.Lock()
+			pullFrequency := im.args.PullFrequency
+			if im.args.isDigest() {
+				// The content of a digest never changes.
+				pullFrequency = 0
+			}
+			im.mut.Unlock()
+			ticker, tickerC = im.updateTicker(pullFrequency, ticker, tickerC)
+
+		case <-tickerC:
+			im.tickPull(ctx)
+		}
+	}
+}
+
+func (im *ImportOCI) updateTicker(pullFrequency time.Duration, ticker *time.Ticker, tickerC <-chan time.Time) (*time.Ticker, <-chan time.Time) {
+	if pullFrequency > 0 {
+		if ticker == nil {
+			ticker = time.NewTicker(pullFrequency)
+			tickerC = ticker.C
+		} else {
+			ticker.Reset(pullFrequency)
+		}
+		return ticker, tickerC
+	}
+
+	if ticker != nil {
+		ticker.Stop()
+	}
+	return nil, nil
+}
+
+func (im *ImportOCI) tickPull(ctx context.Context) {
+	im.mut.Lock()
+	err := im.pull(ctx, im.repo, im.args, im.digest)
+	im.mut.Unlock()
+
+	im.updateHealth(err)
+
+	if err != nil {
+		level.Error(im.log).Log("msg", "failed to pull module from registry", "err", err)
+	}
+}
+
+func (im *ImportOCI) updateHealth(err error) {
+	im.healthMut.Lock()
+	defer im.healthMut.Unlock()
+
+	if err != nil {
+		im.health = component.Health{
+			Health:     component.HealthTypeUnhealthy,
+			Message:    err.Error(),
+			UpdateTime: time.Now(),
+		}
+	} else {
+		im.health = component.Health{
+			Health:     component.HealthTypeHealthy,
+			Message:    "module updated",
+			UpdateTime: time.Now(),
+		}
+	}
+}
+
+// Update implements component.Component.
+// If the module can't be pulled but a copy of the same artifact was cached by
+// a previous pull, the cached copy is loaded and Update doesn't return an
+// error. The pull is retried on the next poll.
+func (im *ImportOCI) Update(args component.Arguments) (err error) {
+	// staleErr is reported in the health when the cached copy is loaded.
+	var staleErr error
+	defer func() {
+		if err != nil {
+			im.updateHealth(err)
+		} else {
+			im.updateHealth(staleErr)
+		}
+	}()
+	im.mut.Lock()
+	defer im.mut.Unlock()
+
+	newArgs := args.(OCIArguments)
+
+	// The client of the previous arguments is kept until the module was
+	// loaded from the new ones, so that polling continues to use it if the
+	// update fails.
+	repo, err := newOCIRepository(newArgs)
+	if err != nil {
+		return err
+	}
+
+	// The content is always reloaded from the new arguments.
+	if err := im.pull(context.Background(), repo, newArgs, ""); err != nil {
+		cached, cacheErr := im.readCache(newArgs)
+		if cacheErr != nil {
+			return err
+		}
+		if verifyErr := newArgs.Verify.verifyModule(cached.Files, cached.signature); verifyErr != nil {
+			level.Error(im.log).Log("msg", "failed to verify cached module", "digest", cached.Digest, "err", verifyErr)
+			return err
+		}
+		level.Error(im.log).Log("msg", "failed to pull module from registry, using cached copy", "digest", cached.Digest, "err", err)
+		im.digest = cached.Digest
+		im.onContentChange(cached.Files)
+		staleErr = fmt.Errorf("using cached copy of %s: %w", cached.Digest, err)
+	}
+
+	// Schedule an update for handling the changed arguments.
+	select {
+	case im.argsChanged <- struct{}{}:
+	default:
+	}
+
+	im.repo = repo
+	im.args = newArgs
+	return nil
+}
+
+// newOCIRepository returns a client for the repository configured in args.
+func newOCIRepository(args OCIArguments) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(args.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %q: %w", args.Repository, err)
+	}
+	repo.PlainHTTP = args.PlainHTTP
+
+	tlsConfig, err := prom_config.NewTLSConfig(args.TLSConfig.Convert())
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls_config: %w", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	client := &auth.Client{
+		Client: &http.Client{Transport: retry.NewTransport(transport)},
+		Cache:  auth.NewCache(),
+	}
+	client.SetUserAgent("Alloy")
+
+	switch {
+	case args.BasicAuth != nil:
+		password := string(args.BasicAuth.Password)
+		if args.BasicAuth.PasswordFile != "" {
+			bb, err := os.ReadFile(args.BasicAuth.PasswordFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading basic_auth password_file: %w", err)
+			}
+			password = strings.TrimSpace(string(bb))
+		}
+		client.Credential = auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username: args.BasicAuth.Username,
+			Password: password,
+		})
+	case args.DockerConfigFile != "":
+		store, err := credentials.NewStore(args.DockerConfigFile, credentials.StoreOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("reading docker_config_file: %w", err)
+		}
+		client.Credential = credentials.Credential(store)
+	}
+
+	repo.Client = client
+	return repo, nil
+}
+
+// pull fetches the artifact from repo and updates the controller if its
+// digest isn't lastDigest. The pull is canceled after args.PullTimeout. pull
+// must only be called with im.mut held.
+func (im *ImportOCI) pull(ctx context.Context, repo *remote.Repository, args OCIArguments, lastDigest digest.Digest) error {
+	ctx, cancel := context.WithTimeout(ctx, args.PullTimeout)
+	defer cancel()
+
+	desc, err := repo.Resolve(ctx, args.Reference)
+	if err != nil {
+		return fmt.Errorf("resolving %s:%s: %w", args.Repository, args.Reference, err)
+	}
+	if desc.Digest == lastDigest {
+		return nil
+	}
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return fmt.Errorf("unsupported manifest media type %q for %s:%s", desc.MediaType, args.Repository, args.Reference)
+	}
+
+	bb, err := content.FetchAll(ctx, repo, desc)
+	if err != nil {
+		return fmt.Errorf("fetching manifest %s: %w", desc.Digest, err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(bb, &manifest); err != nil {
+		return fmt.Errorf("decoding manifest %s: %w", desc.Digest, err)
+	}
+
+	layers := make(map[string]ocispec.Descriptor)
+	for _, layer := range manifest.Layers {
+		if name := layer.Annotations[ocispec.AnnotationTitle]; name != "" && filepath.IsLocal(name) {
+			layers[name] = layer
+		}
+	}
+
+	files := make(map[string]string)
+	for name, layer := range layers {
+		switch {
+		case args.Path != "" && filepath.Clean(name) != filepath.Clean(args.Path):
+			continue
+		case args.Path == "" && !strings.HasSuffix(name, ".alloy"):
+			continue
+		}
+
+		bb, err := content.FetchAll(ctx, repo.Blobs(), layer)
+		if err != nil {
+			return fmt.Errorf("fetching file %q of %s: %w", name, desc.Digest, err)
+		}
+		files[name] = string(bb)
+	}
+
+	switch {
+	case len(files) == 0 && args.Path != "":
+		return fmt.Errorf("file %q not found in %s:%s", args.Path, args.Repository, args.Reference)
+	case len(files) == 0:
+		return fmt.Errorf("no .alloy files found in %s:%s", args.Repository, args.Reference)
+	}
+
+	// Signatures are cached with the files so that the cached copy can be
+	// verified again.
+	signatures := make(map[string]string)
+	readSignature := func(name string) ([]byte, error) {
+		layer, ok := layers[name+SignatureSuffix]
+		if !ok {
+			return nil, fmt.Errorf("file %q not found in %s", name+SignatureSuffix, desc.Digest)
+		}
+		bb, err := content.FetchAll(ctx, repo.Blobs(), layer)
+		if err != nil {
+			return nil, err
+		}
+		signatures[name+SignatureSuffix] = string(bb)
+		return bb, nil
+	}
+	if err := args.Verify.verifyModule(files, readSignature); err != nil {
+		return fmt.Errorf("failed to verify module %s: %w", desc.Digest, err)
+	}
+
+	if err := im.writeCache(args, desc.Digest, files, signatures); err != nil {
+		// The module can still be loaded; it just won't be available if the
+		// registry can't be reached after a restart.
+		level.Warn(im.log).Log("msg", "failed to cache module", "err", err)
+	}
+
+	level.Info(im.log).Log("msg", "loaded module from registry", "repository", args.Repository, "reference", args.Reference, "digest", desc.Digest)
+	im.digest = desc.Digest
+	im.onContentChange(files)
+	return nil
+}
+
+// ociCache is the cached copy of the last artifact pulled from the registry.
+type ociCache struct {
+	Repository string            `json:"repository"`
+	Reference  string            `json:"reference"`
+	Path       string            `json:"path,omitempty"`
+	Digest     digest.Digest     `json:"digest"`
+	Files      map[string]string `json:"-"`
+	Signatures map[string]string `json:"-"`
+}
+
+// signature returns the cached signature of a file of the artifact.
+func (c *ociCache) signature(name string) ([]byte, error) {
+	sig, ok := c.Signatures[name+SignatureSuffix]
+	if !ok {
+		return nil, fmt.Errorf("file %q not found in the cache", name+SignatureSuffix)
+	}
+	return []byte(sig), nil
+}
+
+// cacheFile is the file which describes the cached artifact. The files of the
+// artifact are written to the module path next to it.
+func (im *ImportOCI) cacheFile() string {
+	return filepath.Join(im.opts.DataPath, "oci.json")
+}
+
+func (im *ImportOCI) writeCache(args OCIArguments, dgst digest.Digest, files, signatures map[string]string) error {
+	// Write the files to a temporary directory first so that a failure
+	// doesn't leave a mix of old and new files in the module path.
+	tmpDir := im.ModulePath() + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	all := maps.Clone(files)
+	maps.Copy(all, signatures)
+	for name, content := range all {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(content), 0640); err != nil {
+			return err
+		}
+	}
+	if err := os.RemoveAll(im.ModulePath()); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, im.ModulePath()); err != nil {
+		return err
+	}
+
+	bb, err := json.Marshal(ociCache{
+		Repository: args.Repository,
+		Reference:  args.Reference,
+		Path:       args.Path,
+		Digest:     dgst,
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(im.cacheFile(), bb, 0640)
+}
+
+// readCache returns the cached copy of the artifact configured in args.
+func (im *ImportOCI) readCache(args OCIArguments) (*ociCache, error) {
+	bb, err := os.ReadFile(im.cacheFile())
+	if err != nil {
+		return nil, err
+	}
+	var cached ociCache
+	if err := json.Unmarshal(bb, &cached); err != nil {
+		return nil, err
+	}
+	if cached.Repository != args.Repository || cached.Reference != args.Reference || cached.Path != args.Path {
+		return nil, errors.New("cached module doesn't match the arguments")
+	}
+
+	cached.Files = make(map[string]string)
+	cached.Signatures = make(map[string]string)
+	err = filepath.WalkDir(im.ModulePath(), func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(im.ModulePath(), path)
+		if err != nil {
+			return err
+		}
+		bb, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if strings.HasSuffix(name, SignatureSuffix) {
+			cached.Signatures[name] = string(bb)
+		} else {
+			cached.Files[name] = string(bb)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cached, nil
+}
+
+// CurrentHealth implements component.HealthComponent.
+func (im *ImportOCI) CurrentHealth() component.Health {
+	im.healthMut.RLock()
+	defer im.healthMut.RUnlock()
+	return im.health
+}
+
+// Update the evaluator.
+func (im *ImportOCI) SetEval(eval *vm.Evaluator) {
+	im.eval = eval
+}
+
+// ModulePath returns the directory where the files of the artifact are
+// cached.
+func (im *ImportOCI) ModulePath() string {
+	return filepath.Join(im.opts.DataPath, "oci")
+}
@@ -29,6 +29,8 @@ func NewImportString(eval *vm.Evaluator, onContentChange func(map[string]string)
 
 type StringArguments struct {
 	Content alloytypes.OptionalSecret `alloy:"content,attr"`
+	// Verify configures the verification of the module before it's loaded.
+	Verify *VerifyArguments `alloy:"verify,block,optional"`
 }
 
 func (im *ImportString) Evaluate(scope *vm.Scope) error {
@@ -40,12 +42,20 @@ func (im *ImportString) Evaluate(scope *vm.Scope) error {
 	if equality.DeepEqual(im.arguments, arguments) {
 		return nil
 	}
+
+	content := map[string]string{"import_string": arguments.Content.Value}
+	noSignatureFile := func(string) ([]byte, error) {
+		return nil, fmt.Errorf("the signature must be set in the verify block")
+	}
+	if err := arguments.Verify.verifyModule(content, noSignatureFile); err != nil {
+		return fmt.Errorf("failed to verify module: %w", err)
+	}
 	im.arguments = arguments
 
 	im.modulePath, _ = scope.Variables[ModulePath].(string)
 
 	// notifies that the content has changed
-	im.onContentChange(map[string]string{"import_string": arguments.Content.Value})
+	im.onContentChange(content)
 
 	return nil
 }
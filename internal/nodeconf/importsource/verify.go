@@ -0,0 +1,191 @@
+package importsource
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grafana/alloy/syntax"
+)
+
+// SignatureSuffix is appended to the name of a module file to find its
+// detached signature.
+const SignatureSuffix = ".sig"
+
+// VerifyArguments configures how the content of an imported module is
+// verified before it's loaded.
+type VerifyArguments struct {
+	// SHA256 is the expected hex-encoded SHA-256 checksum of the module.
+	SHA256 string `alloy:"sha256,attr,optional"`
+	// PublicKeys holds PEM-encoded ed25519 or ECDSA public keys. Each file of
+	// the module must have a detached signature made by one of the keys.
+	PublicKeys []string `alloy:"public_keys,attr,optional"`
+	// Signature is the detached signature of a module with a single file. It
+	// is used instead of the signature file of the source.
+	Signature string `alloy:"signature,attr,optional"`
+}
+
+var _ syntax.Validator = (*VerifyArguments)(nil)
+
+// Validate implements syntax.Validator.
+func (args *VerifyArguments) Validate() error {
+	if args.SHA256 == "" && len(args.PublicKeys) == 0 {
+		return errors.New("at least one of sha256 and public_keys must be set")
+	}
+	return args.validate()
+}
+
+// validate checks the arguments which are set without requiring any of them.
+func (args *VerifyArguments) validate() error {
+	if args.SHA256 != "" {
+		if bb, err := hex.DecodeString(args.SHA256); err != nil || len(bb) != sha256.Size {
+			return fmt.Errorf("sha256 must be a hex-encoded SHA-256 checksum")
+		}
+	}
+	if args.Signature != "" && len(args.PublicKeys) == 0 {
+		return errors.New("public_keys must be set to verify the signature")
+	}
+	_, err := parsePublicKeys(args.PublicKeys)
+	return err
+}
+
+// verifyModule checks the files of a module against args. signature returns
+// the detached signature of a file of the module. verifyModule doesn't do
+// anything if args is nil.
+func (args *VerifyArguments) verifyModule(files map[string]string, signature func(name string) ([]byte, error)) error {
+	if args == nil {
+		return nil
+	}
+	if len(files) == 0 {
+		return errors.New("module has no files to verify")
+	}
+
+	if args.SHA256 != "" {
+		if actual := moduleChecksum(files); !strings.EqualFold(actual, args.SHA256) {
+			return fmt.Errorf("checksum mismatch: expected sha256 %s, got %s", strings.ToLower(args.SHA256), actual)
+		}
+	}
+
+	if len(args.PublicKeys) == 0 {
+		return nil
+	}
+	keys, err := parsePublicKeys(args.PublicKeys)
+	if err != nil {
+		return err
+	}
+	if args.Signature != "" {
+		if len(files) != 1 {
+			return fmt.Errorf("signature can only be set for a module with a single file, found %d files", len(files))
+		}
+		signature = func(string) ([]byte, error) { return []byte(args.Signature), nil }
+	}
+	for name, content := range files {
+		sig, err := signature(name)
+		if err != nil {
+			return fmt.Errorf("reading signature of %q: %w", name, err)
+		}
+		if err := verifySignature(keys, []byte(content), sig); err != nil {
+			return fmt.Errorf("verifying signature of %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// moduleChecksum returns the hex-encoded SHA-256 checksum of a module. The
+// checksum of a module with a single file is the checksum of the file. The
+// checksum of a module with several files is the checksum of the output of
+// sha256sum for the files, sorted by name.
+func moduleChecksum(files map[string]string) string {
+	if len(files) == 1 {
+		for _, content := range files {
+			sum := sha256.Sum256([]byte(content))
+			return hex.EncodeToString(sum[:])
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sum := sha256.Sum256([]byte(files[name]))
+		fmt.Fprintf(&sb, "%x  %s\n", sum, name)
+	}
+	sum := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// parsePublicKeys parses PEM-encoded ed25519 and ECDSA public keys.
+func parsePublicKeys(keys []string) ([]crypto.PublicKey, error) {
+	res := make([]crypto.PublicKey, 0, len(keys))
+	for i, key := range keys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return nil, fmt.Errorf("public_keys[%d] is not PEM-encoded", i)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public_keys[%d]: %w", i, err)
+		}
+		switch pub.(type) {
+		case ed25519.PublicKey, *ecdsa.PublicKey:
+		default:
+			return nil, fmt.Errorf("public_keys[%d] has unsupported type %T, only ed25519 and ECDSA keys are supported", i, pub)
+		}
+		res = append(res, pub)
+	}
+	return res, nil
+}
+
+// verifySignature checks that sig is a signature of content made by one of
+// keys. sig may be base64-encoded, which is the format written by
+// `cosign sign-blob`.
+func verifySignature(keys []crypto.PublicKey, content, sig []byte) error {
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig))); err == nil {
+		sig = decoded
+	}
+
+	for _, key := range keys {
+		switch key := key.(type) {
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, content, sig) {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, ecdsaDigest(key.Curve, content), sig) {
+				return nil
+			}
+		}
+	}
+	return errors.New("signature doesn't match any of the public keys")
+}
+
+// ecdsaDigest hashes content with the hash function matching the size of
+// curve.
+func ecdsaDigest(curve elliptic.Curve, content []byte) []byte {
+	switch curve {
+	case elliptic.P384():
+		sum := sha512.Sum384(content)
+		return sum[:]
+	case elliptic.P521():
+		sum := sha512.Sum512(content)
+		return sum[:]
+	default:
+		sum := sha256.Sum256(content)
+		return sum[:]
+	}
+}
@@ -0,0 +1,118 @@
+package importsource
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleChecksum(t *testing.T) {
+	single := map[string]string{"a.alloy": `declare "a" {}`}
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(`declare "a" {}`))), moduleChecksum(single))
+
+	// sha256sum a.alloy b.alloy | sha256sum
+	multiple := map[string]string{"b.alloy": "b", "a.alloy": "a"}
+	sums := fmt.Sprintf("%x  a.alloy\n%x  b.alloy\n", sha256.Sum256([]byte("a")), sha256.Sum256([]byte("b")))
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(sums))), moduleChecksum(multiple))
+}
+
+func TestVerifyModule(t *testing.T) {
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	files := map[string]string{"a.alloy": `declare "a" {}`}
+	digest := sha256.Sum256([]byte(files["a.alloy"]))
+	edSig := ed25519.Sign(edPriv, []byte(files["a.alloy"]))
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecPriv, digest[:])
+	require.NoError(t, err)
+
+	signatures := func(sig []byte) func(string) ([]byte, error) {
+		return func(string) ([]byte, error) { return sig, nil }
+	}
+	noSignature := func(string) ([]byte, error) { return nil, errors.New("not found") }
+
+	tt := []struct {
+		name      string
+		args      *VerifyArguments
+		signature func(string) ([]byte, error)
+		expectErr string
+	}{
+		{
+			name: "no verification",
+		},
+		{
+			name: "checksum",
+			args: &VerifyArguments{SHA256: fmt.Sprintf("%X", digest)},
+		},
+		{
+			name:      "checksum mismatch",
+			args:      &VerifyArguments{SHA256: fmt.Sprintf("%x", sha256.Sum256(nil))},
+			expectErr: "checksum mismatch",
+		},
+		{
+			name:      "raw ed25519 signature",
+			args:      &VerifyArguments{PublicKeys: []string{encodePublicKey(t, edPub)}},
+			signature: signatures(edSig),
+		},
+		{
+			name:      "base64 ECDSA signature",
+			args:      &VerifyArguments{PublicKeys: []string{encodePublicKey(t, edPub), encodePublicKey(t, ecPriv.Public())}},
+			signature: signatures([]byte(base64.StdEncoding.EncodeToString(ecSig) + "\n")),
+		},
+		{
+			name:      "inline signature",
+			args:      &VerifyArguments{PublicKeys: []string{encodePublicKey(t, edPub)}, Signature: base64.StdEncoding.EncodeToString(edSig)},
+			signature: noSignature,
+		},
+		{
+			name:      "signature of another key",
+			args:      &VerifyArguments{PublicKeys: []string{encodePublicKey(t, ecPriv.Public())}},
+			signature: signatures(edSig),
+			expectErr: `verifying signature of "a.alloy": signature doesn't match any of the public keys`,
+		},
+		{
+			name:      "missing signature",
+			args:      &VerifyArguments{PublicKeys: []string{encodePublicKey(t, edPub)}},
+			signature: noSignature,
+			expectErr: `reading signature of "a.alloy": not found`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.args.verifyModule(files, tc.signature)
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestVerifyArguments_Validate(t *testing.T) {
+	require.ErrorContains(t, (&VerifyArguments{}).Validate(), "at least one of sha256 and public_keys must be set")
+	require.ErrorContains(t, (&VerifyArguments{SHA256: "abc"}).Validate(), "sha256 must be a hex-encoded SHA-256 checksum")
+	require.ErrorContains(t, (&VerifyArguments{PublicKeys: []string{"key"}}).Validate(), "public_keys[0] is not PEM-encoded")
+	require.ErrorContains(t, (&GitVerifyArguments{}).Validate(), "at least one of sha256, public_keys and armored_keyring must be set")
+	require.NoError(t, (&GitVerifyArguments{ArmoredKeyRing: "keyring"}).Validate())
+}
+
+func encodePublicKey(t *testing.T, pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -117,8 +117,16 @@ type Options struct {
 
 	// TaskShutdownDeadline is the maximum duration to wait for a component to shut down before giving up and logging an error.
 	TaskShutdownDeadline time.Duration
+
+	// ComponentRestartPolicy configures how components which exit with an
+	// error are restarted. Components aren't restarted by default.
+	ComponentRestartPolicy RestartPolicy
 }
 
+// RestartPolicy configures how components which exit with an error before
+// they are asked to shut down are restarted.
+type RestartPolicy = controller.RestartPolicy
+
 // Runtime is the Alloy system.
 type Runtime struct {
 	log    *logging.Logger
@@ -160,6 +168,12 @@ type controllerOptions struct {
 	WorkerPool worker.Pool
 	// TaskShutdownDeadline is the maximum duration to wait for a component to shut down before giving up and logging an error.
 	TaskShutdownDeadline time.Duration
+	// DryRun evaluates components without building them. The controller must
+	// not be run.
+	DryRun bool
+	// DryRunExports are the exports of running components, by node ID, to use
+	// for components in a dry run.
+	DryRunExports map[string]component.Exports
 }
 
 // newController creates a new, unstarted Alloy controller with a specific
@@ -192,7 +206,7 @@ func newController(o controllerOptions) *Runtime {
 		opts:   o,
 
 		updateQueue: controller.NewQueue(),
-		sched:       controller.NewScheduler(log, o.TaskShutdownDeadline),
+		sched:       controller.NewScheduler(log, o.ControllerID, o.TaskShutdownDeadline),
 
 		modules: o.ModuleRegistry,
 
@@ -208,6 +222,9 @@ func newController(o controllerOptions) *Runtime {
 			DataPath:             o.DataPath,
 			MinStability:         o.MinStability,
 			EnableCommunityComps: o.EnableCommunityComps,
+			RestartPolicy:        o.ComponentRestartPolicy,
+			DryRun:               o.DryRun,
+			DryRunExports:        o.DryRunExports,
 			OnBlockNodeUpdate: func(cn controller.BlockNode) {
 				// Changed node should be queued for reevaluation.
 				f.updateQueue.Enqueue(&controller.QueuedNode{Node: cn, LastUpdatedTime: time.Now()})
@@ -232,9 +249,11 @@ func newController(o controllerOptions) *Runtime {
 					DataPath:             o.DataPath,
 					MinStability:         o.MinStability,
 					EnableCommunityComps: o.EnableCommunityComps,
+					RestartPolicy:        o.ComponentRestartPolicy,
 					ID:                   opts.Id,
 					ServiceMap:           serviceMap,
 					WorkerPool:           workerPool,
+					DryRun:               o.DryRun,
 				})
 			},
 			GetServiceData: func(name string) (interface{}, error) {
@@ -321,11 +340,16 @@ func (f *Runtime) Run(ctx context.Context) {
 // without any configuration errors.
 // LoadSource uses default loader configuration.
 func (f *Runtime) LoadSource(source *Source, args map[string]any, configPath string) error {
+	return f.applyLoaderConfig(f.sourceApplyOptions(source, args, configPath))
+}
+
+// sourceApplyOptions returns the loader configuration to load source.
+func (f *Runtime) sourceApplyOptions(source *Source, args map[string]any, configPath string) controller.ApplyOptions {
 	modulePath, err := util.ExtractDirPath(configPath)
 	if err != nil {
 		level.Warn(f.log).Log("msg", "failed to extract directory path from configPath", "configPath", configPath, "err", err)
 	}
-	return f.applyLoaderConfig(controller.ApplyOptions{
+	return controller.ApplyOptions{
 		Args:            args,
 		ComponentBlocks: source.Components(),
 		ConfigBlocks:    source.Configs(),
@@ -333,7 +357,7 @@ func (f *Runtime) LoadSource(source *Source, args map[string]any, configPath str
 		ArgScope: vm.NewScope(map[string]interface{}{
 			importsource.ModulePath: modulePath,
 		}),
-	})
+	}
 }
 
 // Same as above but with a customComponentRegistry that provides custom component definitions.
@@ -126,8 +126,10 @@ func (f *Runtime) getComponentDetail(cn controller.ComponentNode, graph *dag.Gra
 
 	if builtinComponent, ok := cn.(*controller.BuiltinComponentNode); ok {
 		componentInfo.Component = builtinComponent.Component()
+		componentInfo.Restarts = builtinComponent.Restarts()
 		if opts.GetDebugInfo {
 			componentInfo.DebugInfo = builtinComponent.DebugInfo()
+			componentInfo.LastPanic = builtinComponent.LastPanic()
 		}
 	}
 
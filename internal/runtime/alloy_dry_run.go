@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/runtime/equality"
+	"github.com/grafana/alloy/internal/runtime/internal/worker"
+	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/service"
+	"github.com/grafana/alloy/syntax/diag"
+)
+
+// DryRunResult is the outcome of evaluating a configuration with
+// [Runtime.DryRun].
+type DryRunResult struct {
+	// Diagnostics are the errors and warnings found while parsing and
+	// evaluating the configuration.
+	Diagnostics diag.Diagnostics
+
+	// Evaluated reports whether the configuration could be evaluated. The
+	// component changes are only known if it could.
+	Evaluated bool
+
+	// IDs of the components of the main configuration which would be created,
+	// updated, or removed if the configuration was loaded. A component is
+	// updated if its arguments change.
+	Created []string
+	Updated []string
+	Removed []string
+}
+
+// DryRun parses and evaluates the configuration in sources without changing
+// the running components, and reports what loading it would change.
+// configPath is the path the configuration would be loaded from.
+//
+// The configuration is evaluated by an isolated controller which shares the
+// component and module registries and the services of f. Components aren't
+// built, so errors reported by components when they're built aren't found.
+// Components which are already running expose their current exports to the
+// components referencing them, while new components expose zero values.
+// Service blocks are evaluated but not applied to the services.
+func (f *Runtime) DryRun(sources map[string][]byte, configPath string) (*DryRunResult, error) {
+	source, err := ParseSources(sources)
+	if err != nil {
+		var diags diag.Diagnostics
+		if errors.As(err, &diags) {
+			return &DryRunResult{Diagnostics: diags}, nil
+		}
+		return nil, err
+	}
+
+	// Import blocks may write to their data directory when they're evaluated.
+	dataPath, err := os.MkdirTemp("", "alloy-dry-run-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dataPath)
+
+	running := make(map[string]component.Arguments)
+	exports := make(map[string]component.Exports)
+	for _, cn := range f.loader.Components() {
+		running[cn.NodeID()] = cn.Arguments()
+		exports[cn.NodeID()] = cn.Exports()
+	}
+
+	services := make([]service.Service, 0, len(f.opts.Services))
+	for _, svc := range f.opts.Services {
+		services = append(services, dryRunService{svc})
+	}
+
+	dr := newController(controllerOptions{
+		Options: Options{
+			Logger:               logging.NewNop(),
+			DataPath:             dataPath,
+			Reg:                  prometheus.NewRegistry(),
+			MinStability:         f.opts.MinStability,
+			EnableCommunityComps: f.opts.EnableCommunityComps,
+			Services:             services,
+		},
+		ComponentRegistry: f.opts.ComponentRegistry,
+		ModuleRegistry:    f.modules,
+		IsModule:          false,
+		WorkerPool:        worker.NewDefaultWorkerPool(),
+		DryRun:            true,
+		DryRunExports:     exports,
+	})
+	defer dr.loader.Cleanup(true)
+
+	res := &DryRunResult{
+		Diagnostics: dr.loader.Apply(dr.sourceApplyOptions(source, nil, configPath)),
+	}
+
+	// The loader only keeps the new graph once it evaluated it.
+	if len(dr.loader.Graph().Nodes()) == 0 {
+		return res, nil
+	}
+	res.Evaluated = true
+
+	res.Created, res.Updated = []string{}, []string{}
+	for _, cn := range dr.loader.Components() {
+		args, ok := running[cn.NodeID()]
+		switch {
+		case !ok:
+			res.Created = append(res.Created, cn.NodeID())
+		case !equality.DeepEqual(args, cn.Arguments()):
+			res.Updated = append(res.Updated, cn.NodeID())
+		}
+		delete(running, cn.NodeID())
+	}
+	res.Removed = make([]string, 0, len(running))
+	for id := range running {
+		res.Removed = append(res.Removed, id)
+	}
+
+	slices.Sort(res.Created)
+	slices.Sort(res.Updated)
+	slices.Sort(res.Removed)
+	return res, nil
+}
+
+// dryRunService wraps a service so that a dry run can evaluate its
+// configuration block without applying it. The data of the service is still
+// available to components.
+type dryRunService struct {
+	service.Service
+}
+
+func (s dryRunService) Run(ctx context.Context, _ service.Host) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (s dryRunService) Update(any) error { return nil }
@@ -0,0 +1,130 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/runtime/internal/testcomponents"
+)
+
+func TestRuntime_DryRun(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+	ctrl := New(testOptions(t))
+	defer cleanUpController(t.Context(), ctrl)
+
+	f, err := ParseSource(t.Name(), []byte(testFile))
+	require.NoError(t, err)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	candidate := `
+		testcomponents.tick "ticker" {
+			frequency = "1s"
+		}
+
+		testcomponents.passthrough "static" {
+			input = "hello, dry run!"
+		}
+
+		testcomponents.passthrough "ticker" {
+			input = testcomponents.tick.ticker.tick_time
+		}
+
+		testcomponents.passthrough "new" {
+			input = testcomponents.passthrough.static.output
+		}
+	`
+	res, err := ctrl.DryRun(map[string][]byte{"candidate.alloy": []byte(candidate)}, "")
+	require.NoError(t, err)
+	require.Empty(t, res.Diagnostics)
+	require.True(t, res.Evaluated)
+	require.Equal(t, []string{"testcomponents.passthrough.new"}, res.Created)
+	require.Equal(t, []string{"testcomponents.passthrough.static"}, res.Updated)
+	require.Equal(t, []string{"testcomponents.passthrough.forwarded"}, res.Removed)
+
+	// The running components are left untouched.
+	require.Len(t, ctrl.loader.Components(), 4)
+	in, _ := getFields(t, ctrl.loader.Graph(), "testcomponents.passthrough.static")
+	require.Equal(t, "hello, world!", in.(testcomponents.PassthroughConfig).Input)
+}
+
+func TestRuntime_DryRun_Errors(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+	ctrl := New(testOptions(t))
+	defer cleanUpController(t.Context(), ctrl)
+
+	f, err := ParseSource(t.Name(), []byte(testFile))
+	require.NoError(t, err)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	tt := []struct {
+		name          string
+		config        string
+		expectEval    bool
+		expectMessage string
+	}{
+		{
+			name:          "parse error",
+			config:        `testcomponents.passthrough "static" {`,
+			expectMessage: "expected }",
+		},
+		{
+			name:          "unknown component",
+			config:        `testcomponents.unknown "static" {}`,
+			expectMessage: `cannot find the definition of component name "testcomponents.unknown"`,
+		},
+		{
+			name:          "evaluation error",
+			config:        `testcomponents.passthrough "static" { input = [1] }`,
+			expectEval:    true,
+			expectMessage: "[1] should be string, got array",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ctrl.DryRun(map[string][]byte{"candidate.alloy": []byte(tc.config)}, "")
+			require.NoError(t, err)
+			require.True(t, res.Diagnostics.HasErrors())
+			require.ErrorContains(t, res.Diagnostics, tc.expectMessage)
+			require.Equal(t, tc.expectEval, res.Evaluated)
+		})
+	}
+}
+
+func TestRuntime_DryRun_CustomComponent(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+	ctrl := New(testOptions(t))
+	defer cleanUpController(t.Context(), ctrl)
+
+	candidate := `
+		declare "example" {
+			argument "input" {}
+
+			testcomponents.passthrough "inner" {
+				input = argument.input.value
+			}
+
+			export "output" {
+				value = testcomponents.passthrough.inner.output
+			}
+		}
+
+		example "default" {
+			input = "hello"
+		}
+
+		testcomponents.passthrough "outer" {
+			input = example.default.output
+		}
+	`
+	res, err := ctrl.DryRun(map[string][]byte{"candidate.alloy": []byte(candidate)}, "")
+	require.NoError(t, err)
+	require.Empty(t, res.Diagnostics)
+	require.Equal(t, []string{"example.default", "testcomponents.passthrough.outer"}, res.Created)
+	require.Empty(t, res.Updated)
+	require.Empty(t, res.Removed)
+
+	// Modules of the dry run aren't registered with the running controller.
+	require.Empty(t, ctrl.modules.List())
+}
@@ -0,0 +1,153 @@
+package runtime
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/grafana/alloy/internal/runtime/internal/controller"
+	"github.com/grafana/alloy/internal/runtime/internal/worker"
+)
+
+// EdgeKind is the kind of an edge between two components in a
+// [ComponentGraph].
+type EdgeKind string
+
+const (
+	// EdgeReference is an edge from a component to a component it references.
+	EdgeReference EdgeKind = "reference"
+
+	// EdgeDataFlow is an edge in the direction in which telemetry data flows
+	// between two components.
+	EdgeDataFlow EdgeKind = "data_flow"
+)
+
+// ComponentGraph is a snapshot of the components of a controller and the
+// edges between them. Controllers created by custom components and foreach
+// blocks are included as subgraphs.
+type ComponentGraph struct {
+	// ID of the controller. The ID of the root controller is empty.
+	ID string `json:"id,omitempty"`
+
+	// Parent is the ID of the component in the enclosing graph which owns the
+	// controller. Parent is empty for the root controller.
+	Parent string `json:"parent,omitempty"`
+
+	Components []GraphComponent  `json:"components"`
+	Edges      []GraphEdge       `json:"edges"`
+	Subgraphs  []*ComponentGraph `json:"subgraphs,omitempty"`
+}
+
+// GraphComponent is a component in a [ComponentGraph].
+type GraphComponent struct {
+	ID    string `json:"id"`              // Local ID of the component, i.e., "prometheus.remote_write.default".
+	Name  string `json:"name"`            // Name of the component, i.e., "prometheus.remote_write".
+	Label string `json:"label,omitempty"` // Label of the component, i.e., "default".
+	Type  string `json:"type"`            // Either "builtin", "custom" or "foreach".
+}
+
+// GraphEdge is an edge between two components in a [ComponentGraph]. From
+// and To are local IDs of components in the same graph.
+type GraphEdge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+}
+
+// ComponentGraph returns a snapshot of the components loaded by the
+// controller. Components don't need to be running for the graph to be
+// available; loading a config source is enough.
+func (f *Runtime) ComponentGraph() *ComponentGraph {
+	f.loadMut.RLock()
+	defer f.loadMut.RUnlock()
+
+	var (
+		components = f.loader.Components()
+		graph      = f.loader.Graph()
+	)
+
+	res := &ComponentGraph{
+		ID:         f.opts.ControllerID,
+		Components: make([]GraphComponent, 0, len(components)),
+		Edges:      []GraphEdge{},
+	}
+
+	for _, cn := range components {
+		gc := GraphComponent{
+			ID:    cn.NodeID(),
+			Name:  cn.ComponentName(),
+			Label: cn.Label(),
+			Type:  componentType(cn).String(),
+		}
+
+		switch cn := cn.(type) {
+		case *controller.CustomComponentNode:
+			if sub := customComponentGraph(cn.CustomComponent()); sub != nil {
+				sub.Parent = cn.NodeID()
+				res.Subgraphs = append(res.Subgraphs, sub)
+			}
+		case *controller.ForeachConfigNode:
+			gc.Type = "foreach"
+			for _, cc := range cn.CustomComponents() {
+				if sub := customComponentGraph(cc); sub != nil {
+					sub.Parent = cn.NodeID()
+					res.Subgraphs = append(res.Subgraphs, sub)
+				}
+			}
+		}
+		res.Components = append(res.Components, gc)
+
+		// Only edges between two components are included, similarly to
+		// [Runtime.ListComponents].
+		for _, dep := range graph.Dependencies(cn) {
+			if _, ok := dep.(controller.ComponentNode); ok {
+				res.Edges = append(res.Edges, GraphEdge{From: cn.NodeID(), To: dep.NodeID(), Kind: EdgeReference})
+			}
+		}
+		for _, to := range cn.GetDataFlowEdgesTo() {
+			res.Edges = append(res.Edges, GraphEdge{From: cn.NodeID(), To: to, Kind: EdgeDataFlow})
+		}
+	}
+
+	slices.SortFunc(res.Components, func(a, b GraphComponent) int { return cmp.Compare(a.ID, b.ID) })
+	slices.SortFunc(res.Edges, func(a, b GraphEdge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To), cmp.Compare(a.Kind, b.Kind))
+	})
+	res.Edges = slices.Compact(res.Edges)
+	slices.SortFunc(res.Subgraphs, func(a, b *ComponentGraph) int { return cmp.Compare(a.ID, b.ID) })
+	return res
+}
+
+// LoadGraph evaluates source in a new controller without building or running
+// its components, and returns the graph of the components it defines. The
+// controller is discarded afterwards.
+//
+// Components expose zero values as their exports, so components whose
+// arguments depend on the exports of other components may fail to evaluate.
+// Evaluation errors are returned alongside the graph, which may then be
+// incomplete.
+func LoadGraph(o Options, source *Source, configPath string) (*ComponentGraph, error) {
+	f := newController(controllerOptions{
+		Options:        o,
+		ModuleRegistry: newModuleRegistry(),
+		IsModule:       false,
+		WorkerPool:     worker.NewDefaultWorkerPool(),
+		DryRun:         true,
+	})
+	defer f.loader.Cleanup(true)
+
+	var err error
+	if diags := f.loader.Apply(f.sourceApplyOptions(source, nil, configPath)); diags.HasErrors() {
+		err = diags
+	}
+	return f.ComponentGraph(), err
+}
+
+// customComponentGraph returns the graph of the controller backing cc, or nil
+// if cc hasn't been created yet.
+func customComponentGraph(cc controller.CustomComponent) *ComponentGraph {
+	mod, ok := cc.(*module)
+	if !ok {
+		return nil
+	}
+	return mod.f.ComponentGraph()
+}
@@ -0,0 +1,84 @@
+package runtime_test
+
+import (
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime"
+	_ "github.com/grafana/alloy/internal/runtime/internal/testcomponents" // Import test components.
+	"github.com/grafana/alloy/internal/runtime/internal/testservices"
+	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/service"
+)
+
+func TestComponentGraph(t *testing.T) {
+	config := `
+		declare "pipeline" {
+			argument "input" {}
+
+			testcomponents.passthrough "inner" {
+				input = argument.input.value
+			}
+		}
+
+		testcomponents.passthrough "source" {
+			input = "hello"
+		}
+
+		pipeline "default" {
+			input = testcomponents.passthrough.source.output
+		}
+
+		foreach "each" {
+			collection = ["a", "b"]
+			var        = "item"
+
+			template {
+				testcomponents.passthrough "item" {
+					input = item
+				}
+			}
+		}
+	`
+
+	logger, err := logging.New(io.Discard, logging.DefaultOptions)
+	require.NoError(t, err)
+	source, err := runtime.ParseSource(t.Name(), []byte(config))
+	require.NoError(t, err)
+
+	graph, err := runtime.LoadGraph(runtime.Options{
+		Logger:       logger,
+		DataPath:     t.TempDir(),
+		MinStability: featuregate.StabilityExperimental,
+		Services:     []service.Service{&testservices.Fake{}},
+	}, source, "")
+	require.NoError(t, err)
+
+	require.Empty(t, graph.ID)
+	require.Equal(t, []runtime.GraphComponent{
+		{ID: "foreach.each", Name: "foreach", Label: "each", Type: "foreach"},
+		{ID: "pipeline.default", Name: "pipeline", Label: "default", Type: "custom"},
+		{ID: "testcomponents.passthrough.source", Name: "testcomponents.passthrough", Label: "source", Type: "builtin"},
+	}, graph.Components)
+	require.Equal(t, []runtime.GraphEdge{
+		{From: "pipeline.default", To: "testcomponents.passthrough.source", Kind: runtime.EdgeReference},
+		{From: "testcomponents.passthrough.source", To: "pipeline.default", Kind: runtime.EdgeDataFlow},
+	}, graph.Edges)
+
+	require.Len(t, graph.Subgraphs, 3)
+	parents := make(map[string]int)
+	for _, sub := range graph.Subgraphs {
+		parents[sub.Parent]++
+		require.Len(t, sub.Components, 1)
+	}
+	require.Equal(t, map[string]int{"foreach.each": 2, "pipeline.default": 1}, parents)
+
+	idx := slices.IndexFunc(graph.Subgraphs, func(g *runtime.ComponentGraph) bool { return g.Parent == "pipeline.default" })
+	pipeline := graph.Subgraphs[idx]
+	require.Equal(t, "pipeline.default", pipeline.ID)
+	require.Equal(t, "testcomponents.passthrough.inner", pipeline.Components[0].ID)
+}
@@ -0,0 +1,181 @@
+// Package componentprof attributes profiling samples to the components which
+// produced them.
+//
+// The scheduler runs the goroutine of each component under the pprof labels
+// defined in this package. Goroutines started by a component inherit the
+// labels, so profiles can be filtered per component, for example with
+// `go tool pprof -tagfocus=component_id=prometheus.scrape.default`.
+package componentprof
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/pprof"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/pprof/profile"
+)
+
+// Labels set on the goroutines of components.
+const (
+	// LabelComponentID is the ID of the component inside its module.
+	LabelComponentID = "component_id"
+	// LabelModuleID is the ID of the module running the component. It is
+	// empty for components of the root module.
+	LabelModuleID = "module_id"
+)
+
+// Do calls f with the pprof labels of a component set on the current
+// goroutine.
+func Do(ctx context.Context, moduleID, componentID string, f func(ctx context.Context)) {
+	pprof.Do(ctx, pprof.Labels(LabelModuleID, moduleID, LabelComponentID, componentID), f)
+}
+
+// cpuProfile is held while a CPU profile is recorded. The Go runtime records
+// only one CPU profile at a time, so the Sampler, support bundles and
+// /debug/pprof/profile take it to wait for each other instead of failing.
+var cpuProfile = make(chan struct{}, 1)
+
+// LockCPUProfile waits until no other CPU profile is recorded through this
+// package and returns a function releasing the lock. It returns ctx.Err() if
+// ctx is canceled first.
+func LockCPUProfile(ctx context.Context) (unlock func(), err error) {
+	select {
+	case cpuProfile <- struct{}{}:
+		return unlockCPUProfile, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// TryLockCPUProfile is like LockCPUProfile but returns false instead of
+// waiting if another CPU profile is recorded.
+func TryLockCPUProfile() (unlock func(), ok bool) {
+	select {
+	case cpuProfile <- struct{}{}:
+		return unlockCPUProfile, true
+	default:
+		return nil, false
+	}
+}
+
+func unlockCPUProfile() { <-cpuProfile }
+
+// CPUProfileHandler wraps a handler recording a CPU profile, such as
+// net/http/pprof.Profile, so that it waits for the other CPU profiles to
+// finish. It responds with 503 Service Unavailable if the request is canceled
+// while waiting.
+func CPUProfileHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unlock, err := LockCPUProfile(r.Context())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("waiting for another CPU profile to finish: %s", err), http.StatusServiceUnavailable)
+			return
+		}
+		defer unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Usage is the CPU time attributed to a component in a profile.
+type Usage struct {
+	ModuleID    string
+	ComponentID string // Empty for samples which aren't attributed to a component.
+	CPU         time.Duration
+}
+
+// CPUProfile is a CPU profile aggregated by component.
+type CPUProfile struct {
+	Duration   time.Duration // Duration of the profile.
+	Total      time.Duration // Total CPU time of the profile.
+	Components []Usage       // Sorted by decreasing CPU time.
+}
+
+// ParseCPUProfile parses a CPU profile in the format written by
+// pprof.StartCPUProfile and aggregates it by component.
+func ParseCPUProfile(bb []byte) (*CPUProfile, error) {
+	p, err := profile.Parse(bytes.NewReader(bb))
+	if err != nil {
+		return nil, err
+	}
+
+	valueIndex := -1
+	for i, st := range p.SampleType {
+		if st.Type == "cpu" && st.Unit == "nanoseconds" {
+			valueIndex = i
+		}
+	}
+	if valueIndex == -1 {
+		return nil, fmt.Errorf("profile has no cpu sample type")
+	}
+
+	type key struct{ moduleID, componentID string }
+	var (
+		res   = &CPUProfile{Duration: time.Duration(p.DurationNanos)}
+		usage = make(map[key]time.Duration)
+	)
+	for _, s := range p.Sample {
+		cpu := time.Duration(s.Value[valueIndex])
+		res.Total += cpu
+
+		var k key
+		if ids := s.Label[LabelComponentID]; len(ids) > 0 {
+			k.componentID = ids[0]
+		}
+		if ids := s.Label[LabelModuleID]; len(ids) > 0 && k.componentID != "" {
+			k.moduleID = ids[0]
+		}
+		usage[k] += cpu
+	}
+
+	for k, cpu := range usage {
+		res.Components = append(res.Components, Usage{ModuleID: k.moduleID, ComponentID: k.componentID, CPU: cpu})
+	}
+	slices.SortFunc(res.Components, func(a, b Usage) int {
+		if c := cmp.Compare(b.CPU, a.CPU); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.ModuleID, b.ModuleID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ComponentID, b.ComponentID)
+	})
+	return res, nil
+}
+
+// WriteTop writes the n components which used the most CPU time in p as a
+// table. n <= 0 writes all components.
+func WriteTop(w io.Writer, p *CPUProfile, n int) error {
+	components := p.Components
+	if n > 0 && len(components) > n {
+		components = components[:n]
+	}
+
+	if _, err := fmt.Fprintf(w, "CPU time by component over %s of profiling, %s in total.\n\n", p.Duration.Round(time.Millisecond), p.Total.Round(time.Millisecond)); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CPU\tCPU%\tMODULE\tCOMPONENT")
+	for _, u := range components {
+		var percent float64
+		if p.Total > 0 {
+			percent = 100 * float64(u.CPU) / float64(p.Total)
+		}
+		moduleID, componentID := u.ModuleID, u.ComponentID
+		if moduleID == "" {
+			moduleID = "-"
+		}
+		if componentID == "" {
+			componentID = "(not attributed to a component)"
+		}
+		fmt.Fprintf(tw, "%s\t%.1f%%\t%s\t%s\n", u.CPU.Round(time.Millisecond), percent, moduleID, componentID)
+	}
+	return tw.Flush()
+}
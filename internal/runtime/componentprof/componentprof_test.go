@@ -0,0 +1,163 @@
+package componentprof
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPUProfile(t *testing.T) {
+	p, err := ParseCPUProfile(testProfile(t))
+	require.NoError(t, err)
+
+	require.Equal(t, &CPUProfile{
+		Duration: 10 * time.Second,
+		Total:    1700 * time.Millisecond,
+		Components: []Usage{
+			{ModuleID: "", ComponentID: "prometheus.scrape.default", CPU: time.Second},
+			{ModuleID: "import.file.math", ComponentID: "add.a", CPU: 500 * time.Millisecond},
+			{CPU: 200 * time.Millisecond},
+		},
+	}, p)
+}
+
+func TestParseCPUProfile_Invalid(t *testing.T) {
+	_, err := ParseCPUProfile([]byte("not a profile"))
+	require.Error(t, err)
+}
+
+func TestWriteTop(t *testing.T) {
+	p, err := ParseCPUProfile(testProfile(t))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteTop(&buf, p, 2))
+
+	expect := `CPU time by component over 10s of profiling, 1.7s in total.
+
+CPU    CPU%   MODULE            COMPONENT
+1s     58.8%  -                 prometheus.scrape.default
+500ms  29.4%  import.file.math  add.a
+`
+	require.Equal(t, expect, buf.String())
+}
+
+func TestSampler_Record(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	s, err := NewSampler(log.NewNopLogger(), reg, SamplerOptions{
+		Interval:        time.Minute,
+		ProfileDuration: 10 * time.Second,
+	})
+	require.NoError(t, err)
+
+	p, err := ParseCPUProfile(testProfile(t))
+	require.NoError(t, err)
+	s.record(p)
+
+	// The CPU time is extrapolated from 10s of profiling to the whole minute.
+	expect := `
+# HELP alloy_component_cpu_seconds_total Estimated CPU time used by the component, extrapolated from sampled CPU profiles.
+# TYPE alloy_component_cpu_seconds_total counter
+alloy_component_cpu_seconds_total{component_id="add.a",module_id="import.file.math"} 3
+alloy_component_cpu_seconds_total{component_id="prometheus.scrape.default",module_id=""} 6
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expect), "alloy_component_cpu_seconds_total"))
+}
+
+func TestSamplerOptions_Validate(t *testing.T) {
+	require.NoError(t, SamplerOptions{Interval: time.Minute, ProfileDuration: time.Minute}.Validate())
+	require.Error(t, SamplerOptions{Interval: time.Minute}.Validate())
+	require.Error(t, SamplerOptions{Interval: time.Second, ProfileDuration: time.Minute}.Validate())
+}
+
+// testProfile returns an encoded CPU profile with samples of two components
+// and unattributed samples.
+func testProfile(t *testing.T) []byte {
+	t.Helper()
+
+	fn := &profile.Function{ID: 1, Name: "main.work"}
+	loc := &profile.Location{ID: 1, Line: []profile.Line{{Function: fn}}}
+	sample := func(cpu time.Duration, labels map[string][]string) *profile.Sample {
+		return &profile.Sample{
+			Location: []*profile.Location{loc},
+			Value:    []int64{1, int64(cpu)},
+			Label:    labels,
+		}
+	}
+	component := func(moduleID, componentID string) map[string][]string {
+		return map[string][]string{LabelModuleID: {moduleID}, LabelComponentID: {componentID}}
+	}
+
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "samples", Unit: "count"},
+			{Type: "cpu", Unit: "nanoseconds"},
+		},
+		PeriodType:    &profile.ValueType{Type: "cpu", Unit: "nanoseconds"},
+		Period:        int64(10 * time.Millisecond),
+		DurationNanos: int64(10 * time.Second),
+		Function:      []*profile.Function{fn},
+		Location:      []*profile.Location{loc},
+		Sample: []*profile.Sample{
+			sample(600*time.Millisecond, component("", "prometheus.scrape.default")),
+			sample(400*time.Millisecond, component("", "prometheus.scrape.default")),
+			sample(500*time.Millisecond, component("import.file.math", "add.a")),
+			sample(200*time.Millisecond, nil),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Write(&buf))
+	return buf.Bytes()
+}
+
+func TestLockCPUProfile(t *testing.T) {
+	unlock, err := LockCPUProfile(context.Background())
+	require.NoError(t, err)
+
+	_, ok := TryLockCPUProfile()
+	require.False(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = LockCPUProfile(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+	unlock, ok = TryLockCPUProfile()
+	require.True(t, ok)
+	unlock()
+}
+
+func TestCPUProfileHandler(t *testing.T) {
+	h := CPUProfileHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, ok := TryLockCPUProfile()
+		require.False(t, ok, "the lock must be held while the handler runs")
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/profile", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Requests canceled while another profile is recorded fail.
+	unlock, ok := TryLockCPUProfile()
+	require.True(t, ok)
+	defer unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/profile", nil).WithContext(ctx))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
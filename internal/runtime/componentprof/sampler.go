@@ -0,0 +1,128 @@
+package componentprof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// SamplerOptions configures a Sampler.
+type SamplerOptions struct {
+	// Interval is how often a CPU profile is recorded.
+	Interval time.Duration
+	// ProfileDuration is how long each CPU profile is recorded for. It must be
+	// positive and at most Interval.
+	ProfileDuration time.Duration
+}
+
+// Validate checks that the options are valid.
+func (o SamplerOptions) Validate() error {
+	if o.ProfileDuration <= 0 || o.Interval < o.ProfileDuration {
+		return fmt.Errorf("the profile duration must be positive and at most the sampling interval")
+	}
+	return nil
+}
+
+// Sampler periodically records a CPU profile and exports the CPU time used by
+// each component as metrics.
+//
+// Only one CPU profile can be recorded at a time. CPU profiles taken through
+// LockCPUProfile, such as those of /debug/pprof/profile and support bundles,
+// wait while the Sampler records one, and the Sampler skips a profile if
+// another one is being recorded.
+type Sampler struct {
+	log  log.Logger
+	opts SamplerOptions
+
+	cpuSeconds *prometheus.CounterVec
+}
+
+// NewSampler creates a new Sampler which registers its metrics to reg. Call
+// Run to start sampling.
+func NewSampler(l log.Logger, reg prometheus.Registerer, opts SamplerOptions) (*Sampler, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	cpuSeconds := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "alloy_component_cpu_seconds_total",
+		Help: "Estimated CPU time used by the component, extrapolated from sampled CPU profiles.",
+	}, []string{LabelModuleID, LabelComponentID})
+	if err := reg.Register(cpuSeconds); err != nil {
+		return nil, err
+	}
+
+	return &Sampler{
+		log:        l,
+		opts:       opts,
+		cpuSeconds: cpuSeconds,
+	}, nil
+}
+
+// Run records CPU profiles until ctx is canceled.
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.sample(ctx); err != nil {
+			level.Warn(s.log).Log("msg", "failed to sample the CPU usage of components", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sampler) sample(ctx context.Context) error {
+	unlock, ok := TryLockCPUProfile()
+	if !ok {
+		level.Debug(s.log).Log("msg", "skipping CPU usage sample of components, another CPU profile is being recorded")
+		return nil
+	}
+	defer unlock()
+
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		return fmt.Errorf("starting CPU profile: %w", err)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(s.opts.ProfileDuration):
+	}
+	pprof.StopCPUProfile()
+
+	if ctx.Err() != nil {
+		// The profile is incomplete.
+		return nil
+	}
+
+	p, err := ParseCPUProfile(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("parsing CPU profile: %w", err)
+	}
+	s.record(p)
+	return nil
+}
+
+// record adds the CPU time of the components in p to the metrics. The CPU
+// time is extrapolated to the whole sampling interval.
+func (s *Sampler) record(p *CPUProfile) {
+	scale := s.opts.Interval.Seconds() / s.opts.ProfileDuration.Seconds()
+	for _, u := range p.Components {
+		if u.ComponentID == "" {
+			continue
+		}
+		s.cpuSeconds.WithLabelValues(u.ModuleID, u.ComponentID).Add(u.CPU.Seconds() * scale)
+	}
+}
@@ -0,0 +1,393 @@
+package runtime_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/featuregate"
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	"github.com/grafana/alloy/internal/runtime/internal/testservices"
+	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/service"
+)
+
+const ociModule = `declare "add" {
+	argument "a" {}
+	argument "b" {}
+
+	export "sum" {
+		value = argument.a.value + argument.b.value + %d
+	}
+}`
+
+func TestImportOCI(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+
+	registry := newFakeRegistry("user", "secret")
+	registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(0), "README.md": "# math"})
+	srv := httptest.NewServer(registry)
+	defer srv.Close()
+
+	main := `
+import.oci "testImport" {
+	repository     = "` + strings.TrimPrefix(srv.URL, "http://") + `/modules/math"
+	reference      = "v1"
+	plain_http     = true
+	pull_frequency = "100ms"
+
+	basic_auth {
+		username = "user"
+		password = "secret"
+	}
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	ctrl, f := setup(t, main, nil, featuregate.StabilityExperimental)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctrl.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 2
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// Move the tag to a new version of the module.
+	registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(1)})
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 3
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestImportOCI_FailedUpdate(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+
+	registry := newFakeRegistry("", "")
+	registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(0)})
+	srv := httptest.NewServer(registry)
+	defer srv.Close()
+
+	unreachable := httptest.NewServer(registry)
+	unreachable.Close()
+
+	config := func(host string) string {
+		return `
+import.oci "testImport" {
+	repository     = "` + strings.TrimPrefix(host, "http://") + `/modules/math"
+	reference      = "v1"
+	plain_http     = true
+	pull_frequency = "100ms"
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	}
+	ctrl, f := setup(t, config(srv.URL), nil, featuregate.StabilityExperimental)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctrl.Run(ctx)
+	}()
+	require.Equal(t, 2, getExport[map[string]any](t, ctrl, "", "testImport.add.cc")["sum"])
+
+	// The update fails, so the module keeps being pulled from the previous
+	// repository.
+	f, err := alloy_runtime.ParseSource(t.Name(), []byte(config(unreachable.URL)))
+	require.NoError(t, err)
+	require.ErrorContains(t, ctrl.LoadSource(f, nil, ""), "connection refused")
+
+	registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(1)})
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 3
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestImportOCI_PullTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	main := `
+import.oci "testImport" {
+	repository   = "` + strings.TrimPrefix(srv.URL, "http://") + `/modules/math"
+	plain_http   = true
+	pull_timeout = "100ms"
+}
+`
+	ctrl, f := setup(t, main, nil, featuregate.StabilityExperimental)
+	require.ErrorContains(t, ctrl.LoadSource(f, nil, ""), "context deadline exceeded")
+	stopController(t, ctrl)
+}
+
+func TestImportOCI_Cache(t *testing.T) {
+	registry := newFakeRegistry("", "")
+	dgst := registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(0)})
+	srv := httptest.NewServer(registry)
+
+	main := `
+import.oci "testImport" {
+	repository = "` + strings.TrimPrefix(srv.URL, "http://") + `/modules/math"
+	reference  = "` + dgst.String() + `"
+	plain_http = true
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	dataPath := t.TempDir()
+	load := func() *alloy_runtime.Runtime {
+		logger, err := logging.New(io.Discard, logging.DefaultOptions)
+		require.NoError(t, err)
+		ctrl := alloy_runtime.New(alloy_runtime.Options{
+			Logger:       logger,
+			DataPath:     dataPath,
+			MinStability: featuregate.StabilityExperimental,
+			Services:     []service.Service{&testservices.Fake{}},
+		})
+		f, err := alloy_runtime.ParseSource(t.Name(), []byte(main))
+		require.NoError(t, err)
+		require.NoError(t, ctrl.LoadSource(f, nil, ""))
+		return ctrl
+	}
+
+	ctrl := load()
+	require.Equal(t, 2, getExport[map[string]any](t, ctrl, "", "testImport.add.cc")["sum"])
+	stopController(t, ctrl)
+
+	// The module is loaded from the cache when the registry can't be reached.
+	srv.Close()
+	ctrl = load()
+	require.Equal(t, 2, getExport[map[string]any](t, ctrl, "", "testImport.add.cc")["sum"])
+	stopController(t, ctrl)
+}
+
+func TestImportOCI_Verify(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	registry := newFakeRegistry("", "")
+	module := ociModuleWithOffset(0)
+	registry.push(t, "v1", map[string]string{
+		"math.alloy":     module,
+		"math.alloy.sig": base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(module))),
+	})
+	srv := httptest.NewServer(registry)
+	defer srv.Close()
+
+	main := `
+import.oci "testImport" {
+	repository     = "` + strings.TrimPrefix(srv.URL, "http://") + `/modules/math"
+	reference      = "v1"
+	plain_http     = true
+	pull_frequency = "50ms"
+
+	verify {
+		public_keys = [` + strconv.Quote(string(publicKey)) + `]
+	}
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	ctrl, f := setup(t, main, nil, featuregate.StabilityExperimental)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctrl.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 2
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// An unsigned artifact isn't loaded.
+	registry.push(t, "v1", map[string]string{"math.alloy": ociModuleWithOffset(1)})
+	require.Never(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] != 2
+	}, 500*time.Millisecond, 50*time.Millisecond)
+}
+
+func TestImportOCI_Stability(t *testing.T) {
+	main := `
+import.oci "testImport" {
+	repository = "localhost:5000/modules/math"
+}
+`
+	ctrl, f := setup(t, main, nil, featuregate.StabilityPublicPreview)
+	err := ctrl.LoadSource(f, nil, "")
+	require.ErrorContains(t, err, `config block "import.oci" is at stability level "experimental"`)
+	stopController(t, ctrl)
+}
+
+// stopController releases the resources of a controller which was loaded but
+// not run.
+func stopController(t *testing.T, ctrl *alloy_runtime.Runtime) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	ctrl.Run(ctx)
+}
+
+func ociModuleWithOffset(offset int) string {
+	return strings.Replace(ociModule, "%d", strconv.Itoa(offset), 1)
+}
+
+// fakeRegistry is a minimal in-memory implementation of the pull endpoints
+// of the OCI distribution API.
+type fakeRegistry struct {
+	username, password string
+
+	mut       sync.Mutex
+	blobs     map[digest.Digest][]byte
+	manifests map[digest.Digest][]byte
+	tags      map[string]digest.Digest
+}
+
+func newFakeRegistry(username, password string) *fakeRegistry {
+	return &fakeRegistry{
+		username:  username,
+		password:  password,
+		blobs:     make(map[digest.Digest][]byte),
+		manifests: make(map[digest.Digest][]byte),
+		tags:      make(map[string]digest.Digest),
+	}
+}
+
+// push stores an artifact with one layer per file, in the layout used by
+// `oras push`, and points tag to it.
+func (r *fakeRegistry) push(t *testing.T, tag string, files map[string]string) digest.Digest {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	config := []byte("{}")
+	r.blobs[digest.FromBytes(config)] = config
+
+	manifest := ocispec.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: "application/vnd.grafana.alloy.module",
+		Config:       ocispec.DescriptorEmptyJSON,
+	}
+	for name, content := range files {
+		dgst := digest.FromString(content)
+		r.blobs[dgst] = []byte(content)
+		manifest.Layers = append(manifest.Layers, ocispec.Descriptor{
+			MediaType:   "application/vnd.oci.image.layer.v1.tar",
+			Digest:      dgst,
+			Size:        int64(len(content)),
+			Annotations: map[string]string{ocispec.AnnotationTitle: name},
+		})
+	}
+
+	bb, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	dgst := digest.FromBytes(bb)
+	r.manifests[dgst] = bb
+	r.tags[tag] = dgst
+	return dgst
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.username != "" {
+		if username, password, ok := req.BasicAuth(); !ok || username != r.username || password != r.password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="fake"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	var (
+		bb        []byte
+		mediaType string
+		dgst      digest.Digest
+	)
+	switch path := req.URL.Path; {
+	case strings.Contains(path, "/manifests/"):
+		ref := path[strings.LastIndex(path, "/")+1:]
+		dgst = digest.Digest(ref)
+		if tagged, ok := r.tags[ref]; ok {
+			dgst = tagged
+		}
+		bb, mediaType = r.manifests[dgst], ocispec.MediaTypeImageManifest
+	case strings.Contains(path, "/blobs/"):
+		dgst = digest.Digest(path[strings.LastIndex(path, "/")+1:])
+		bb, mediaType = r.blobs[dgst], "application/octet-stream"
+	}
+	if bb == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(bb)))
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	if req.Method != http.MethodHead {
+		_, _ = w.Write(bb)
+	}
+}
@@ -0,0 +1,153 @@
+package runtime_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/featuregate"
+)
+
+func TestImportFile_VerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	modulePath := filepath.Join(t.TempDir(), "math.alloy")
+	writeModule := func(offset int, sign bool) {
+		module := ociModuleWithOffset(offset)
+		if sign {
+			sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(module)))
+			require.NoError(t, os.WriteFile(modulePath+".sig", []byte(sig), 0664))
+		}
+		require.NoError(t, os.WriteFile(modulePath, []byte(module), 0664))
+	}
+	writeModule(0, true)
+
+	main := `
+import.file "testImport" {
+	filename       = ` + strconv.Quote(modulePath) + `
+	detector       = "poll"
+	poll_frequency = "50ms"
+
+	verify {
+		public_keys = [` + strconv.Quote(string(publicKey)) + `]
+	}
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	defer verifyNoGoroutineLeaks(t)
+	ctrl, f := setup(t, main, nil, featuregate.StabilityPublicPreview)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctrl.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 2
+	}, 3*time.Second, 10*time.Millisecond)
+
+	// A module which doesn't match its signature isn't loaded.
+	writeModule(1, false)
+	require.Never(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] != 2
+	}, 500*time.Millisecond, 50*time.Millisecond)
+
+	writeModule(1, true)
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 3
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestImportHTTP_VerifyChecksum(t *testing.T) {
+	defer verifyNoGoroutineLeaks(t)
+
+	var (
+		mut    sync.Mutex
+		module = ociModuleWithOffset(0) + "\n"
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		defer mut.Unlock()
+		_, _ = w.Write([]byte(module))
+	}))
+	defer srv.Close()
+
+	// The checksum covers the module as served, including the trailing newline.
+	sum := sha256.Sum256([]byte(module))
+	main := `
+import.http "testImport" {
+	url            = "` + srv.URL + `/math.alloy"
+	poll_frequency = "50ms"
+
+	verify {
+		sha256 = "` + hex.EncodeToString(sum[:]) + `"
+	}
+}
+
+testImport.add "cc" {
+	a = 1
+	b = 1
+}
+`
+	ctrl, f := setup(t, main, nil, featuregate.StabilityPublicPreview)
+	require.NoError(t, ctrl.LoadSource(f, nil, ""))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctrl.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] == 2
+	}, 3*time.Second, 10*time.Millisecond)
+
+	// A module which doesn't match the checksum isn't loaded.
+	mut.Lock()
+	module = ociModuleWithOffset(1)
+	mut.Unlock()
+	require.Never(t, func() bool {
+		export := getExport[map[string]any](t, ctrl, "", "testImport.add.cc")
+		return export["sum"] != 2
+	}, 500*time.Millisecond, 50*time.Millisecond)
+}
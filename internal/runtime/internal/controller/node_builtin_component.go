@@ -8,8 +8,10 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kit/log"
@@ -18,8 +20,10 @@ import (
 
 	"github.com/grafana/alloy/internal/component"
 	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/componentprof"
 	"github.com/grafana/alloy/internal/runtime/equality"
 	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
 	"github.com/grafana/alloy/internal/runtime/tracing"
 	"github.com/grafana/alloy/syntax/ast"
 	"github.com/grafana/alloy/syntax/vm"
@@ -84,6 +88,9 @@ type ComponentGlobals struct {
 	NewModuleController  func(opts ModuleControllerOpts) ModuleController // Func to generate a module controller.
 	GetServiceData       func(name string) (interface{}, error)           // Get data for a service.
 	EnableCommunityComps bool                                             // Enables the use of community components.
+	RestartPolicy        RestartPolicy                                    // Policy to restart components which exited with an error.
+	DryRun               bool                                             // Evaluate components without building them.
+	DryRunExports        map[string]component.Exports                     // Exports to use for components in a dry run, by node ID.
 }
 
 // BuiltinComponentNode is a controller node which manages a builtin component.
@@ -93,15 +100,20 @@ type ComponentGlobals struct {
 // from an Alloy block.
 type BuiltinComponentNode struct {
 	id                ComponentID
+	moduleID          string // ID of the module running the component. Empty for the root module.
 	globalID          string
 	label             string
 	componentName     string
 	nodeID            string // Cached from id.String() to avoid allocating new strings every time NodeID is called.
 	reg               component.Registration
 	managedOpts       component.Options
-	registry          *prometheus.Registry
+	registry          *prometheus.Registry  // Registry of the metrics of the node
+	nodeRegisterer    prometheus.Registerer // Registerer for metrics about the managed component, like restarts
+	managedMetrics    *managedCollector     // Metrics of the current instance of the managed component
 	exportsType       reflect.Type
 	moduleController  ModuleController
+	restartPolicy     RestartPolicy
+	dryRun            bool
 	OnBlockNodeUpdate func(cn BlockNode) // Informs controller that we need to reevaluate
 
 	mut     sync.RWMutex
@@ -118,6 +130,12 @@ type BuiltinComponentNode struct {
 	evalHealth component.Health // Health of the last evaluate
 	runHealth  component.Health // Health of running the component
 
+	restarts atomic.Int64 // Number of times the managed component was restarted
+
+	panics    atomic.Int64 // Number of panics recovered from the managed component
+	panicMut  sync.RWMutex
+	lastPanic *component.PanicInfo // Last panic recovered from the managed component
+
 	exportsMut sync.RWMutex
 	exports    component.Exports // Evaluated exports for the managed component
 
@@ -153,6 +171,7 @@ func NewBuiltinComponentNode(globals ComponentGlobals, reg component.Registratio
 
 	cn := &BuiltinComponentNode{
 		id:                id,
+		moduleID:          globals.ControllerID,
 		globalID:          globalID,
 		label:             b.Label,
 		nodeID:            nodeID,
@@ -160,6 +179,8 @@ func NewBuiltinComponentNode(globals ComponentGlobals, reg component.Registratio
 		reg:               reg,
 		exportsType:       getExportsType(reg),
 		moduleController:  globals.NewModuleController(ModuleControllerOpts{Id: globalID}),
+		restartPolicy:     globals.RestartPolicy,
+		dryRun:            globals.DryRun,
 		OnBlockNodeUpdate: globals.OnBlockNodeUpdate,
 
 		block: b,
@@ -175,20 +196,44 @@ func NewBuiltinComponentNode(globals ComponentGlobals, reg component.Registratio
 		dataFlowEdgeRefs: []string{},
 	}
 	cn.managedOpts = getManagedOptions(globals, cn)
+	cn.managedOpts.Registerer = cn.newManagedRegisterer()
+
+	// Components aren't built in dry runs, so they never set their exports.
+	// Use the exports of the running component instead, if there is one.
+	if exports, ok := globals.DryRunExports[nodeID]; globals.DryRun && ok {
+		cn.exports = exports
+	}
 
+	cn.registerNodeMetrics()
 	return cn
 }
 
+// registerNodeMetrics registers the metrics about the managed component
+// alongside its own metrics, so that they're labeled with the component's ID.
+func (cn *BuiltinComponentNode) registerNodeMetrics() {
+	_ = cn.nodeRegisterer.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Name: "alloy_component_panics_total",
+		Help: "Total number of panics recovered from the component.",
+	}, func() float64 { return float64(cn.panics.Load()) }))
+
+	if cn.restartPolicy.Enabled() {
+		_ = cn.nodeRegisterer.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "alloy_component_restarts_total",
+			Help: "Total number of times the component was restarted after exiting with an error.",
+		}, func() float64 { return float64(cn.restarts.Load()) }))
+	}
+}
+
 func getManagedOptions(globals ComponentGlobals, cn *BuiltinComponentNode) component.Options {
 	cn.registry = prometheus.NewRegistry()
+	cn.nodeRegisterer = wrapComponentRegisterer(cn.globalID, cn.registry)
+	cn.managedMetrics = &managedCollector{}
+	cn.registry.MustRegister(cn.managedMetrics)
+
 	parent, id := splitPath(cn.globalID)
 	return component.Options{
 		ID:     cn.globalID,
 		Logger: log.With(globals.Logger, "component_path", parent, "component_id", id),
-		Registerer: prometheus.WrapRegistererWith(prometheus.Labels{
-			"component_path": parent,
-			"component_id":   id,
-		}, cn.registry),
 		Tracer: tracing.WrapTracer(globals.TraceProvider, cn.globalID),
 
 		DataPath: filepath.Join(globals.DataPath, cn.globalID),
@@ -211,6 +256,42 @@ func getExportsType(reg component.Registration) reflect.Type {
 	return nil
 }
 
+// newManagedRegisterer returns the Registerer for a new instance of the
+// managed component. Every instance gets its own registry, so that an instance
+// built after a restart can register the same metrics again.
+func (cn *BuiltinComponentNode) newManagedRegisterer() prometheus.Registerer {
+	reg := prometheus.NewRegistry()
+	cn.managedMetrics.reg.Store(reg)
+	return wrapComponentRegisterer(cn.globalID, reg)
+}
+
+// wrapComponentRegisterer labels the metrics registered to reg with the path
+// and ID of the component.
+func wrapComponentRegisterer(globalID string, reg prometheus.Registerer) prometheus.Registerer {
+	parent, id := splitPath(globalID)
+	return prometheus.WrapRegistererWith(prometheus.Labels{
+		"component_path": parent,
+		"component_id":   id,
+	}, reg)
+}
+
+// managedCollector collects the metrics of the current instance of the managed
+// component.
+type managedCollector struct {
+	reg atomic.Pointer[prometheus.Registry]
+}
+
+// Describe implements prometheus.Collector. It doesn't describe any metrics,
+// so that the metrics can change when the managed component is rebuilt.
+func (c *managedCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *managedCollector) Collect(ch chan<- prometheus.Metric) {
+	if reg := c.reg.Load(); reg != nil {
+		reg.Collect(ch)
+	}
+}
+
 // Registration returns the original registration of the component.
 func (cn *BuiltinComponentNode) Registration() component.Registration { return cn.reg }
 
@@ -285,9 +366,16 @@ func (cn *BuiltinComponentNode) evaluate(scope *vm.Scope) error {
 	// components expect a non-pointer.
 	argsCopyValue := reflect.ValueOf(argsPointer).Elem().Interface()
 
+	if cn.dryRun {
+		// Building the component could have side effects, such as listening
+		// on a port, so only its arguments are evaluated.
+		cn.args = argsCopyValue
+		return nil
+	}
+
 	if cn.managed == nil {
 		// We haven't built the managed component successfully yet.
-		managed, err := cn.reg.Build(cn.managedOpts, argsCopyValue)
+		managed, err := cn.buildManaged(argsCopyValue)
 		if err != nil {
 			return fmt.Errorf("building component: %w", err)
 		}
@@ -305,7 +393,7 @@ func (cn *BuiltinComponentNode) evaluate(scope *vm.Scope) error {
 	}
 
 	// Update the existing managed component
-	if err := cn.managed.Update(argsCopyValue); err != nil {
+	if err := cn.updateManaged(argsCopyValue); err != nil {
 		return fmt.Errorf("updating component: %w", err)
 	}
 
@@ -317,6 +405,11 @@ func (cn *BuiltinComponentNode) evaluate(scope *vm.Scope) error {
 // canceled. Evaluate must have been called at least once without returning an
 // error before calling Run.
 //
+// Panics of the managed component are recovered and handled as errors. If the
+// managed component exits with an error before ctx is canceled, it is run
+// again according to the RestartPolicy of the node. The component is
+// reported as crash looping while waiting to be restarted.
+//
 // Run will immediately return ErrUnevaluated if Evaluate has never been called
 // successfully. Otherwise, Run will return nil.
 func (cn *BuiltinComponentNode) Run(ctx context.Context) error {
@@ -328,18 +421,159 @@ func (cn *BuiltinComponentNode) Run(ctx context.Context) error {
 		return ErrUnevaluated
 	}
 
+	// The receivers of the managed component run in the goroutines of the
+	// components which send data to it, so their panics are reported through
+	// the component package.
+	defer component.RegisterPanicHandler(cn.globalID, cn.recordPanic)()
+
 	cn.setRunHealth(component.HealthTypeHealthy, "started component")
-	err := cn.managed.Run(ctx)
 
-	// Note: logging of this error is handled by the scheduler.
+	var (
+		attempts int
+		rebuild  bool
+	)
+	for {
+		startTime := time.Now()
+
+		var err error
+		if rebuild {
+			// Components may tear down their state when Run exits, so a new
+			// instance is built instead of running the exited one again.
+			managed, err = cn.rebuildManaged()
+		}
+		if err == nil {
+			err = cn.runManaged(ctx, managed)
+		}
+
+		// Note: logging of this error is handled by the scheduler.
+		if err == nil {
+			cn.setRunHealth(component.HealthTypeExited, "component shut down cleanly")
+			return nil
+		}
+		if ctx.Err() != nil || !cn.restartPolicy.Enabled() {
+			cn.setRunHealth(component.HealthTypeExited, fmt.Sprintf("component shut down with error: %s", err))
+			return err
+		}
+
+		// Components which ran long enough since their last restart start
+		// again with the initial backoff.
+		if time.Since(startTime) >= cn.restartPolicy.MaxBackoff {
+			attempts = 0
+		}
+		if cn.restartPolicy.exhausted(attempts) {
+			cn.setRunHealth(component.HealthTypeExited, fmt.Sprintf("component shut down with error after %d restarts: %s", attempts, err))
+			return err
+		}
+
+		attempts++
+		backoff := cn.restartPolicy.backoff(attempts)
+		cn.setRunHealth(component.HealthTypeCrashLooping, fmt.Sprintf("component shut down with error, restarting in %s: %s", backoff, err))
+		level.Warn(cn.managedOpts.Logger).Log("msg", "component shut down with error, restarting", "attempt", attempts, "backoff", backoff, "err", err)
+
+		select {
+		case <-ctx.Done():
+			// The component isn't restarted, so it exits with its last error.
+			cn.setRunHealth(component.HealthTypeExited, fmt.Sprintf("component shut down with error: %s", err))
+			return err
+		case <-time.After(backoff):
+		}
+
+		cn.restarts.Add(1)
+		cn.setRunHealth(component.HealthTypeHealthy, fmt.Sprintf("restarted component after error: %s", err))
+		rebuild = true
+	}
+}
+
+// rebuildManaged builds a new instance of the managed component from its
+// current arguments and replaces the exited instance with it.
+func (cn *BuiltinComponentNode) rebuildManaged() (component.Component, error) {
+	cn.mut.Lock()
+	defer cn.mut.Unlock()
+
+	cn.managedOpts.Registerer = cn.newManagedRegisterer()
+	managed, err := cn.buildManaged(cn.args)
 	if err != nil {
-		cn.setRunHealth(component.HealthTypeExited, fmt.Sprintf("component shut down with error: %s", err))
-	} else {
-		cn.setRunHealth(component.HealthTypeExited, "component shut down cleanly")
+		return nil, fmt.Errorf("rebuilding component: %w", err)
 	}
+	cn.managed = managed
+	return managed, nil
+}
+
+// buildManaged builds the managed component, recovering from a panic of its
+// constructor. The constructor runs under the pprof labels of the component,
+// like its Run method, so that goroutines it starts are attributed to it.
+// cn.mut must be held when calling buildManaged.
+func (cn *BuiltinComponentNode) buildManaged(args component.Arguments) (managed component.Component, err error) {
+	componentprof.Do(context.Background(), cn.moduleID, cn.nodeID, func(context.Context) {
+		defer cn.recoverPanic(&err)
+		managed, err = cn.reg.Build(cn.managedOpts, args)
+	})
+	return managed, err
+}
+
+// updateManaged updates the managed component, recovering from a panic of its
+// Update method. Update runs under the pprof labels of the component, like
+// buildManaged. cn.mut must be held when calling updateManaged.
+func (cn *BuiltinComponentNode) updateManaged(args component.Arguments) (err error) {
+	componentprof.Do(context.Background(), cn.moduleID, cn.nodeID, func(context.Context) {
+		defer cn.recoverPanic(&err)
+		err = cn.managed.Update(args)
+	})
 	return err
 }
 
+// runManaged runs the managed component, recovering from a panic of its Run
+// method.
+func (cn *BuiltinComponentNode) runManaged(ctx context.Context, managed component.Component) (err error) {
+	defer cn.recoverPanic(&err)
+	return managed.Run(ctx)
+}
+
+// recoverPanic recovers a panic of the managed component, records it, and
+// stores it as a *PanicError in err. recoverPanic must be deferred directly by
+// the function which calls the managed component.
+func (cn *BuiltinComponentNode) recoverPanic(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	pe := &PanicError{Value: r, Stack: debug.Stack()}
+	cn.recordPanic(pe)
+	*err = pe
+}
+
+// recordPanic logs and records a panic of the managed component. It's also
+// called for the panics recovered in the receivers of the managed component,
+// which run in the goroutines of the components that send data to it.
+func (cn *BuiltinComponentNode) recordPanic(pe *PanicError) {
+	level.Error(cn.managedOpts.Logger).Log("msg", "recovered from panic in component", "panic", fmt.Sprint(pe.Value), "stack", string(pe.Stack))
+
+	cn.panics.Add(1)
+
+	cn.panicMut.Lock()
+	cn.lastPanic = &component.PanicInfo{
+		Value: fmt.Sprint(pe.Value),
+		Stack: string(pe.Stack),
+		Time:  time.Now(),
+	}
+	cn.panicMut.Unlock()
+}
+
+// LastPanic returns the last panic recovered from the managed component, or
+// nil if the managed component never panicked.
+func (cn *BuiltinComponentNode) LastPanic() *component.PanicInfo {
+	cn.panicMut.RLock()
+	defer cn.panicMut.RUnlock()
+	return cn.lastPanic
+}
+
+// Restarts returns the number of times the managed component was restarted
+// after exiting with an error.
+func (cn *BuiltinComponentNode) Restarts() int {
+	return int(cn.restarts.Load())
+}
+
 // ErrUnevaluated is returned if BuiltinComponentNode.Run is called before a managed
 // component is built.
 var ErrUnevaluated = errors.New("managed component not built")
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash/fnv"
+	"maps"
 	"path"
 	"reflect"
 	"strings"
@@ -112,6 +113,14 @@ func (fn *ForeachConfigNode) ModuleIDs() []string {
 	return fn.moduleController.ModuleIDs()
 }
 
+// CustomComponents returns the custom components created for the entries of
+// the collection, keyed by their ID.
+func (fn *ForeachConfigNode) CustomComponents() map[string]CustomComponent {
+	fn.mut.RLock()
+	defer fn.mut.RUnlock()
+	return maps.Clone(fn.customComponents)
+}
+
 func (fn *ForeachConfigNode) ComponentName() string {
 	return fn.componentName
 }
@@ -263,7 +263,7 @@ func (cn *ImportConfigNode) processImportedContent(content *ast.File) error {
 		switch componentName {
 		case declareType:
 			cn.processDeclareBlock(blockStmt)
-		case importsource.BlockNameFile, importsource.BlockNameString, importsource.BlockNameHTTP, importsource.BlockNameGit:
+		case importsource.BlockNameFile, importsource.BlockNameString, importsource.BlockNameHTTP, importsource.BlockNameGit, importsource.BlockNameOCI:
 			err := cn.processImportBlock(blockStmt, componentName)
 			if err != nil {
 				return err
@@ -287,6 +287,9 @@ func (cn *ImportConfigNode) processDeclareBlock(stmt *ast.BlockStmt) {
 // processDeclareBlock creates an ImportConfigNode child from the provided import block.
 func (cn *ImportConfigNode) processImportBlock(stmt *ast.BlockStmt, fullName string) error {
 	sourceType := importsource.GetSourceType(fullName)
+	if err := checkFeatureStability(fullName, cn.globals.MinStability); err != nil {
+		return err
+	}
 	if _, ok := cn.importConfigNodesChildren[stmt.Label]; ok {
 		return fmt.Errorf("import block redefined %s", stmt.Label)
 	}
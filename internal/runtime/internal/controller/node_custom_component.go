@@ -234,6 +234,14 @@ func (cn *CustomComponentNode) Run(ctx context.Context) error {
 	return err
 }
 
+// CustomComponent returns the managed custom component. CustomComponent
+// returns nil if the node hasn't been successfully evaluated yet.
+func (cn *CustomComponentNode) CustomComponent() CustomComponent {
+	cn.mut.RLock()
+	defer cn.mut.RUnlock()
+	return cn.managed
+}
+
 // Arguments returns the current arguments of the managed custom component.
 func (cn *CustomComponentNode) Arguments() component.Arguments {
 	cn.mut.RLock()
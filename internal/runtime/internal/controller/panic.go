@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"runtime/debug"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+// PanicError is returned when a panic is recovered from a node or from a
+// managed component.
+type PanicError = component.PanicError
+
+// recoverPanic recovers a panic and stores it as a *PanicError in err.
+// recoverPanic must be deferred directly by the function which may panic:
+//
+//	defer recoverPanic(&err)
+func recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
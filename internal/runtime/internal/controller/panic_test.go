@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+func TestBuiltinComponentNode_RecoversPanics(t *testing.T) {
+	t.Run("run", func(t *testing.T) {
+		cn := newRestartTestNode(panickingComponent{}, RestartPolicy{})
+
+		// The panics counter is registered before the component panics.
+		require.Equal(t, 1, testutil.CollectAndCount(cn.registry, "alloy_component_panics_total"))
+		require.Equal(t, 0.0, testutil.ToFloat64(cn.registry))
+
+		err := cn.Run(t.Context())
+
+		var pe *PanicError
+		require.ErrorAs(t, err, &pe)
+		require.Equal(t, "run failed", pe.Value)
+
+		health := cn.CurrentHealth()
+		require.Equal(t, component.HealthTypeExited, health.Health)
+		require.Contains(t, health.Message, "panic: run failed")
+		require.Contains(t, health.Message, "panickingComponent")
+
+		lastPanic := cn.LastPanic()
+		require.NotNil(t, lastPanic)
+		require.Equal(t, "run failed", lastPanic.Value)
+		require.Contains(t, lastPanic.Stack, "panickingComponent")
+
+		require.Equal(t, 1.0, testutil.ToFloat64(cn.registry))
+	})
+
+	t.Run("run with restarts", func(t *testing.T) {
+		cn := newRestartTestNode(panickingComponent{}, RestartPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Minute,
+		})
+
+		err := cn.Run(t.Context())
+		require.ErrorAs(t, err, new(*PanicError))
+		require.Equal(t, 2, cn.Restarts())
+		require.Equal(t, int64(3), cn.panics.Load())
+	})
+
+	t.Run("receiver", func(t *testing.T) {
+		fc := &failingComponent{}
+		cn := newRestartTestNode(fc, RestartPolicy{})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- cn.Run(ctx) }()
+		require.Eventually(t, func() bool { return fc.runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+		err := panickingReceiver(cn.globalID)
+		require.ErrorAs(t, err, new(*PanicError))
+		require.Equal(t, "receiver failed", cn.LastPanic().Value)
+		require.Equal(t, 1.0, testutil.ToFloat64(cn.registry))
+		require.Equal(t, component.HealthTypeHealthy, cn.CurrentHealth().Health)
+
+		// Panics aren't reported to the node once it stopped running.
+		cancel()
+		require.NoError(t, <-done)
+		require.ErrorAs(t, panickingReceiver(cn.globalID), new(*PanicError))
+		require.Equal(t, 1.0, testutil.ToFloat64(cn.registry))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		cn := newRestartTestNode(panickingComponent{}, RestartPolicy{})
+
+		err := cn.updateManaged(nil)
+		require.ErrorAs(t, err, new(*PanicError))
+		require.Equal(t, "update failed", cn.LastPanic().Value)
+	})
+
+	t.Run("build", func(t *testing.T) {
+		cn := newRestartTestNode(nil, RestartPolicy{})
+		cn.reg = component.Registration{
+			Build: func(component.Options, component.Arguments) (component.Component, error) {
+				panic(errors.New("build failed"))
+			},
+		}
+
+		_, err := cn.buildManaged(nil)
+		require.ErrorAs(t, err, new(*PanicError))
+		require.Equal(t, "build failed", cn.LastPanic().Value)
+	})
+}
+
+type panickingComponent struct{}
+
+func (panickingComponent) Run(context.Context) error        { panic("run failed") }
+func (panickingComponent) Update(component.Arguments) error { panic("update failed") }
+
+// panickingReceiver is an entry point of the component with the given ID
+// which panics.
+func panickingReceiver(id string) (err error) {
+	defer component.RecoverPanic(id, &err)
+	panic("receiver failed")
+}
@@ -0,0 +1,50 @@
+package controller
+
+import "time"
+
+// RestartPolicy configures how BuiltinComponentNodes restart managed
+// components which exit with an error before they are asked to shut down.
+//
+// Restarts are delayed with an exponential backoff, starting at
+// InitialBackoff and doubling for every consecutive restart up to MaxBackoff.
+// A component which ran for at least MaxBackoff before exiting is considered
+// to have recovered, and its next restart starts again from InitialBackoff.
+type RestartPolicy struct {
+	// MaxAttempts is the maximum number of consecutive restarts of a
+	// component. Components aren't restarted if MaxAttempts is zero, and are
+	// restarted indefinitely if MaxAttempts is negative.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first restart of a component.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two restarts of a component.
+	MaxBackoff time.Duration
+}
+
+// Enabled returns true if components should be restarted.
+func (p RestartPolicy) Enabled() bool {
+	return p.MaxAttempts != 0
+}
+
+// exhausted returns true if a component was already restarted the maximum
+// number of consecutive times.
+func (p RestartPolicy) exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// backoff returns the delay before the given consecutive restart attempt,
+// starting from 1.
+func (p RestartPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
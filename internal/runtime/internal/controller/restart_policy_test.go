@@ -0,0 +1,239 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/alloy/internal/component"
+)
+
+func TestRestartPolicyBackoff(t *testing.T) {
+	p := RestartPolicy{
+		MaxAttempts:    -1,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+
+	require.Equal(t, time.Second, p.backoff(1))
+	require.Equal(t, 2*time.Second, p.backoff(2))
+	require.Equal(t, 8*time.Second, p.backoff(4))
+	require.Equal(t, 10*time.Second, p.backoff(5))
+	require.Equal(t, 10*time.Second, p.backoff(1000))
+}
+
+func TestRestartPolicyExhausted(t *testing.T) {
+	require.False(t, RestartPolicy{}.Enabled())
+	require.False(t, RestartPolicy{MaxAttempts: -1}.exhausted(1000))
+	require.False(t, RestartPolicy{MaxAttempts: 3}.exhausted(2))
+	require.True(t, RestartPolicy{MaxAttempts: 3}.exhausted(3))
+}
+
+func TestBuiltinComponentNode_Restart(t *testing.T) {
+	t.Run("restarts failed component", func(t *testing.T) {
+		fc := &failingComponent{failures: 2}
+		cn := newRestartTestNode(fc, RestartPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Minute,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- cn.Run(ctx) }()
+
+		require.Eventually(t, func() bool {
+			return fc.runs.Load() == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, 2, cn.Restarts())
+		require.Equal(t, component.HealthTypeHealthy, cn.CurrentHealth().Health)
+
+		cancel()
+		require.NoError(t, <-done)
+		require.Equal(t, component.HealthTypeExited, cn.CurrentHealth().Health)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		fc := &failingComponent{failures: 10}
+		cn := newRestartTestNode(fc, RestartPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Minute,
+		})
+
+		err := cn.Run(t.Context())
+		require.ErrorIs(t, err, errComponentFailed)
+		require.Equal(t, int64(3), fc.runs.Load())
+		require.Equal(t, 2, cn.Restarts())
+
+		health := cn.CurrentHealth()
+		require.Equal(t, component.HealthTypeExited, health.Health)
+		require.Contains(t, health.Message, "after 2 restarts")
+	})
+
+	t.Run("reports crash loop while waiting", func(t *testing.T) {
+		fc := &failingComponent{failures: 1}
+		cn := newRestartTestNode(fc, RestartPolicy{
+			MaxAttempts:    -1,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- cn.Run(ctx) }()
+
+		require.Eventually(t, func() bool {
+			return cn.CurrentHealth().Health == component.HealthTypeCrashLooping
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Contains(t, cn.CurrentHealth().Message, "restarting in 1h0m0s")
+
+		cancel()
+		require.ErrorIs(t, <-done, errComponentFailed)
+		require.Equal(t, component.HealthTypeExited, cn.CurrentHealth().Health)
+		require.Equal(t, 0, cn.Restarts())
+	})
+
+	t.Run("cancelled during backoff", func(t *testing.T) {
+		fc := &failingComponent{failures: 1}
+		cn := newRestartTestNode(fc, RestartPolicy{
+			MaxAttempts:    -1,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- cn.Run(ctx) }()
+
+		require.Eventually(t, func() bool {
+			return cn.CurrentHealth().Health == component.HealthTypeCrashLooping
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		err := <-done
+		require.ErrorIs(t, err, errComponentFailed)
+		require.Equal(t, int64(1), fc.runs.Load())
+
+		health := cn.CurrentHealth()
+		require.Equal(t, component.HealthTypeExited, health.Health)
+		require.Equal(t, "component shut down with error: "+err.Error(), health.Message)
+	})
+
+	t.Run("rebuilds component on restart", func(t *testing.T) {
+		var builds atomic.Int64
+		cn := newRestartTestNodeWithBuild(func(opts component.Options, _ component.Arguments) (component.Component, error) {
+			// Every instance registers the same metric.
+			counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_builds_total"})
+			if err := opts.Registerer.Register(counter); err != nil {
+				return nil, err
+			}
+			return &teardownComponent{fail: builds.Add(1) == 1}, nil
+		}, RestartPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Minute,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- cn.Run(ctx) }()
+
+		require.Eventually(t, func() bool {
+			return cn.Component().(*teardownComponent).running.Load()
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, int64(2), builds.Load())
+		require.Equal(t, 1, cn.Restarts())
+		require.Equal(t, component.HealthTypeHealthy, cn.CurrentHealth().Health)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		fc := &failingComponent{failures: 1}
+		cn := newRestartTestNode(fc, RestartPolicy{})
+
+		err := cn.Run(t.Context())
+		require.ErrorIs(t, err, errComponentFailed)
+		require.Equal(t, int64(1), fc.runs.Load())
+		require.Equal(t, component.HealthTypeExited, cn.CurrentHealth().Health)
+	})
+}
+
+func newRestartTestNode(c component.Component, policy RestartPolicy) *BuiltinComponentNode {
+	return newRestartTestNodeWithBuild(func(component.Options, component.Arguments) (component.Component, error) {
+		return c, nil
+	}, policy)
+}
+
+func newRestartTestNodeWithBuild(build func(component.Options, component.Arguments) (component.Component, error), policy RestartPolicy) *BuiltinComponentNode {
+	cn := &BuiltinComponentNode{
+		globalID:       "test.component",
+		reg:            component.Registration{Build: build},
+		restartPolicy:  policy,
+		managedOpts:    component.Options{Logger: log.NewNopLogger()},
+		registry:       prometheus.NewRegistry(),
+		managedMetrics: &managedCollector{},
+		evalHealth:     component.Health{Health: component.HealthTypeHealthy},
+	}
+	cn.nodeRegisterer = cn.registry
+	cn.managedOpts.Registerer = cn.newManagedRegisterer()
+	cn.registerNodeMetrics()
+
+	managed, err := cn.buildManaged(nil)
+	if err != nil {
+		panic(err)
+	}
+	cn.managed = managed
+	return cn
+}
+
+var errComponentFailed = errors.New("component failed")
+
+// failingComponent fails the given number of times before running until its
+// context is canceled.
+type failingComponent struct {
+	failures int64
+	runs     atomic.Int64
+}
+
+func (c *failingComponent) Run(ctx context.Context) error {
+	if c.runs.Add(1) <= c.failures {
+		return errComponentFailed
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (c *failingComponent) Update(component.Arguments) error { return nil }
+
+// teardownComponent tears down its state when Run exits, so that it fails if
+// it's run again.
+type teardownComponent struct {
+	fail    bool
+	closed  atomic.Bool
+	running atomic.Bool
+}
+
+func (c *teardownComponent) Run(ctx context.Context) error {
+	if c.closed.Load() {
+		return errors.New("component ran after it was torn down")
+	}
+	defer c.closed.Store(true)
+
+	if c.fail {
+		return errComponentFailed
+	}
+	c.running.Store(true)
+	<-ctx.Done()
+	return nil
+}
+
+func (c *teardownComponent) Update(component.Arguments) error { return nil }
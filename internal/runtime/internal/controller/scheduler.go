@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-kit/log"
 
+	"github.com/grafana/alloy/internal/runtime/componentprof"
 	"github.com/grafana/alloy/internal/runtime/logging/level"
 )
 
@@ -29,22 +30,25 @@ type Scheduler struct {
 	cancel               context.CancelFunc
 	running              sync.WaitGroup
 	logger               log.Logger
+	moduleID             string
 	taskShutdownDeadline time.Duration
 
 	tasksMut sync.Mutex
 	tasks    map[string]*task
 }
 
-// NewScheduler creates a new Scheduler. Call Synchronize to manage the set of
-// components which are running.
+// NewScheduler creates a new Scheduler for the components of the module
+// moduleID. Call Synchronize to manage the set of components which are
+// running.
 //
 // Call Close to stop the Scheduler and all running components.
-func NewScheduler(logger log.Logger, taskShutdownDeadline time.Duration) *Scheduler {
+func NewScheduler(logger log.Logger, moduleID string, taskShutdownDeadline time.Duration) *Scheduler {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Scheduler{
 		ctx:                  ctx,
 		cancel:               cancel,
 		logger:               logger,
+		moduleID:             moduleID,
 		taskShutdownDeadline: taskShutdownDeadline,
 
 		tasks: make(map[string]*task),
@@ -113,6 +117,7 @@ func (s *Scheduler) Synchronize(rr []RunnableNode) error {
 				delete(s.tasks, nodeID)
 			},
 			logger:               log.With(s.logger, "taskID", nodeID),
+			moduleID:             s.moduleID,
 			taskShutdownDeadline: s.taskShutdownDeadline,
 		}
 
@@ -149,6 +154,7 @@ type taskOptions struct {
 	runnable             RunnableNode
 	onDone               func(error)
 	logger               log.Logger
+	moduleID             string
 	taskShutdownDeadline time.Duration
 }
 
@@ -164,7 +170,12 @@ func newTask(opts taskOptions) *task {
 	}
 
 	go func() {
-		err := opts.runnable.Run(t.ctx)
+		// The goroutines started by the runnable inherit the pprof labels, so
+		// profiles can be filtered per component.
+		var err error
+		componentprof.Do(t.ctx, opts.moduleID, opts.runnable.NodeID(), func(ctx context.Context) {
+			err = runTask(ctx, opts.runnable)
+		})
 		close(t.exited)
 		t.doneOnce.Do(func() {
 			t.opts.onDone(err)
@@ -173,6 +184,13 @@ func newTask(opts taskOptions) *task {
 	return t
 }
 
+// runTask runs r, recovering from a panic so that a single node can't stop
+// the process.
+func runTask(ctx context.Context, r RunnableNode) (err error) {
+	defer recoverPanic(&err)
+	return r.Run(ctx)
+}
+
 func (t *task) Stop() {
 	t.cancel()
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"runtime/pprof"
 	"sync"
 	"testing"
 	"time"
@@ -32,7 +33,7 @@ func TestScheduler_Synchronize(t *testing.T) {
 			return nil
 		}
 
-		sched := controller.NewScheduler(logger, 1*time.Minute)
+		sched := controller.NewScheduler(logger, "", 1*time.Minute)
 		sched.Synchronize([]controller.RunnableNode{
 			fakeRunnable{ID: "component-a", Component: mockComponent{RunFunc: runFunc}},
 			fakeRunnable{ID: "component-b", Component: mockComponent{RunFunc: runFunc}},
@@ -54,7 +55,7 @@ func TestScheduler_Synchronize(t *testing.T) {
 			return nil
 		}
 
-		sched := controller.NewScheduler(logger, 1*time.Minute)
+		sched := controller.NewScheduler(logger, "", 1*time.Minute)
 
 		for i := 0; i < 10; i++ {
 			// If a new runnable is created, runFunc will panic since the WaitGroup
@@ -80,7 +81,7 @@ func TestScheduler_Synchronize(t *testing.T) {
 			return nil
 		}
 
-		sched := controller.NewScheduler(logger, 1*time.Minute)
+		sched := controller.NewScheduler(logger, "", 1*time.Minute)
 
 		sched.Synchronize([]controller.RunnableNode{
 			fakeRunnable{ID: "component-a", Component: mockComponent{RunFunc: runFunc}},
@@ -141,7 +142,7 @@ func TestScheduler_TaskTimeoutLogging(t *testing.T) {
 		return nil
 	}
 
-	sched := controller.NewScheduler(logger, 150*time.Millisecond)
+	sched := controller.NewScheduler(logger, "", 150*time.Millisecond)
 
 	// Start a component
 	err := sched.Synchronize([]controller.RunnableNode{
@@ -167,3 +168,73 @@ func TestScheduler_TaskTimeoutLogging(t *testing.T) {
 
 	require.NoError(t, sched.Close())
 }
+
+func TestScheduler_RecoversPanics(t *testing.T) {
+	var logBuffer syncBuffer
+	logger := log.NewLogfmtLogger(&logBuffer)
+
+	var finished sync.WaitGroup
+	finished.Add(1)
+
+	runFunc := func(ctx context.Context) error {
+		defer finished.Done()
+		panic("something went wrong")
+	}
+
+	sched := controller.NewScheduler(logger, "", 1*time.Minute)
+	err := sched.Synchronize([]controller.RunnableNode{
+		fakeRunnable{ID: "panicking-component", Component: mockComponent{RunFunc: runFunc}},
+	})
+	require.NoError(t, err)
+
+	finished.Wait()
+	require.NoError(t, sched.Close())
+
+	logOutput := logBuffer.String()
+	require.Contains(t, logOutput, "node exited with error")
+	require.Contains(t, logOutput, "panic: something went wrong")
+}
+
+func TestScheduler_ProfilingLabels(t *testing.T) {
+	labels := make(chan map[string]string, 1)
+	runFunc := func(ctx context.Context) error {
+		ls := make(map[string]string)
+		pprof.ForLabels(ctx, func(key, value string) bool {
+			ls[key] = value
+			return true
+		})
+		labels <- ls
+		<-ctx.Done()
+		return nil
+	}
+
+	sched := controller.NewScheduler(log.NewNopLogger(), "module.file.example", 1*time.Minute)
+	err := sched.Synchronize([]controller.RunnableNode{
+		fakeRunnable{ID: "component-a", Component: mockComponent{RunFunc: runFunc}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"module_id":    "module.file.example",
+		"component_id": "component-a",
+	}, <-labels)
+	require.NoError(t, sched.Close())
+}
+
+// syncBuffer is a bytes.Buffer which can be written to concurrently.
+type syncBuffer struct {
+	mut sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.buf.String()
+}
@@ -135,14 +135,16 @@ func newModule(o *moduleOptions) *module {
 			ModuleRegistry:    o.ModuleRegistry,
 			ComponentRegistry: o.ComponentRegistry,
 			WorkerPool:        o.WorkerPool,
+			DryRun:            o.DryRun,
 			Options: Options{
-				ControllerID:         o.ID,
-				Tracer:               o.Tracer,
-				Reg:                  o.Reg,
-				Logger:               o.Logger,
-				DataPath:             o.DataPath,
-				MinStability:         o.MinStability,
-				EnableCommunityComps: o.EnableCommunityComps,
+				ControllerID:           o.ID,
+				Tracer:                 o.Tracer,
+				Reg:                    o.Reg,
+				Logger:                 o.Logger,
+				DataPath:               o.DataPath,
+				MinStability:           o.MinStability,
+				EnableCommunityComps:   o.EnableCommunityComps,
+				ComponentRestartPolicy: o.RestartPolicy,
 				OnExportsChange: func(exports map[string]any) {
 					if o.export != nil {
 						o.export(exports)
@@ -230,4 +232,11 @@ type moduleControllerOptions struct {
 
 	// EnableCommunityComps enables the use of community components.
 	EnableCommunityComps bool
+
+	// RestartPolicy configures how components which exit with an error are
+	// restarted.
+	RestartPolicy RestartPolicy
+
+	// DryRun evaluates components without building them.
+	DryRun bool
 }
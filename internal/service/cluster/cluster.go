@@ -205,6 +205,7 @@ func New(opts Options) (*Service, error) {
 		notifyClusterChange: make(chan struct{}, 1),
 	}
 	s.alloyCluster = newAlloyCluster(ckitConfig.Sharder, s.triggerClusterChangeNotification, opts, l)
+	s.alloyCluster.httpClient = httpClient
 
 	return s, nil
 }
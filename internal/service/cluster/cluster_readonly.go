@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"net/http"
 	"sync"
 	"time"
 
@@ -54,6 +55,17 @@ type Cluster interface {
 	Ready() bool
 }
 
+// PeerClient is implemented by clusters which can send HTTP requests to their
+// peers. Components can use it to hand work off to other peers, using the same
+// transport as the cluster itself.
+type PeerClient interface {
+	// PeerHTTPClient returns the HTTP client used to communicate with peers.
+	PeerHTTPClient() *http.Client
+
+	// PeerURL returns the base URL of the HTTP server of p.
+	PeerURL(p peer.Peer) string
+}
+
 // alloyCluster implements the Cluster interface and manages the admission control logic.
 type alloyCluster struct {
 	log     log.Logger
@@ -63,12 +75,18 @@ type alloyCluster struct {
 	clusterChangeCallback func()
 	clusterReadyGauge     prometheus.Gauge
 
+	// httpClient is the client used to communicate with peers.
+	httpClient *http.Client
+
 	rwMutex       sync.RWMutex
 	deadlineTimer *time.Timer
 	clusterState  clusterState
 }
 
-var _ Cluster = (*alloyCluster)(nil)
+var (
+	_ Cluster    = (*alloyCluster)(nil)
+	_ PeerClient = (*alloyCluster)(nil)
+)
 
 func newAlloyCluster(sharder shard.Sharder, clusterChangeCallback func(), opts Options, log log.Logger) *alloyCluster {
 	c := &alloyCluster{
@@ -133,6 +151,20 @@ func (c *alloyCluster) Peers() []peer.Peer {
 	return c.sharder.Peers()
 }
 
+func (c *alloyCluster) PeerHTTPClient() *http.Client {
+	if c.httpClient == nil {
+		return http.DefaultClient
+	}
+	return c.httpClient
+}
+
+func (c *alloyCluster) PeerURL(p peer.Peer) string {
+	if c.opts.EnableTLS {
+		return "https://" + p.Addr
+	}
+	return "http://" + p.Addr
+}
+
 func (c *alloyCluster) Ready() bool {
 	// Lock-free path: if clustering is disabled or no minimum size is set, the cluster is always ready.
 	if !c.opts.EnableClustering || c.opts.MinimumClusterSize == 0 {
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+)
+
+// maxDryRunRequestSize is the maximum size of the body of a request to the
+// /-/dry-run endpoint.
+const maxDryRunRequestSize = 16 << 20
+
+// DryRunRequest is the body of a request to the /-/dry-run endpoint with the
+// application/json content type. Requests with any other content type send
+// the configuration as a single file in their body instead.
+type DryRunRequest struct {
+	// Sources maps file names to the content of the configuration files.
+	Sources map[string]string `json:"sources"`
+}
+
+func (s *Service) dryRunHandler(w http.ResponseWriter, r *http.Request) {
+	level.Info(s.log).Log("msg", "dry run requested via /-/dry-run endpoint")
+
+	sources, err := readDryRunSources(w, r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	res, err := s.opts.DryRunFunc(sources)
+	if err != nil {
+		level.Error(s.log).Log("msg", "failed to dry run config", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		level.Error(s.log).Log("msg", "failed to encode dry run result", "err", err)
+	}
+}
+
+func readDryRunSources(w http.ResponseWriter, r *http.Request) (map[string][]byte, error) {
+	body := http.MaxBytesReader(w, r.Body, maxDryRunRequestSize)
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		bb, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{"config.alloy": bb}, nil
+	}
+
+	var req DryRunRequest
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if len(req.Sources) == 0 {
+		return nil, fmt.Errorf("no sources in request")
+	}
+	sources := make(map[string][]byte, len(req.Sources))
+	for name, content := range req.Sources {
+		sources[name] = []byte(content)
+	}
+	return sources, nil
+}
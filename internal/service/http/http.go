@@ -5,11 +5,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
-	_ "net/http/pprof" // Register pprof handlers
+	httppprof "net/http/pprof" // Register pprof handlers
 	"os"
 	"path"
 	"sort"
@@ -22,6 +23,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/grafana/alloy/internal/component"
 	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/componentprof"
 	"github.com/grafana/alloy/internal/runtime/logging"
 	"github.com/grafana/alloy/internal/runtime/logging/level"
 	"github.com/grafana/alloy/internal/service"
@@ -53,6 +55,16 @@ type Options struct {
 	ReadyFunc  func() bool
 	ReloadFunc func() error
 
+	// ReloadStatusFunc returns the outcome of the last reload, which is served
+	// as JSON by the /-/reload/status endpoint. The endpoint isn't served if
+	// ReloadStatusFunc is nil.
+	ReloadStatusFunc func() any
+
+	// DryRunFunc evaluates a configuration, given as a map of file names to
+	// contents, without applying it. Its result is served as JSON by the
+	// /-/dry-run endpoint. The endpoint isn't served if DryRunFunc is nil.
+	DryRunFunc func(sources map[string][]byte) (any, error)
+
 	HTTPListenAddr   string                // Address to listen for HTTP traffic on.
 	MemoryListenAddr string                // Address to accept in-memory traffic on.
 	EnablePProf      bool                  // Whether pprof endpoints should be exposed.
@@ -102,6 +114,11 @@ type Service struct {
 
 	memLis *memconn.Listener
 
+	// host is set once the Service is running and is used to look up
+	// components on behalf of the components which use [Data.ComponentHealth].
+	hostMut sync.RWMutex
+	host    service.Host
+
 	componentHttpPathPrefix          string
 	componentHttpPathPrefixRemotecfg string
 }
@@ -170,6 +187,16 @@ func (s *Service) Run(ctx context.Context, host service.Host) error {
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+
+	s.hostMut.Lock()
+	s.host = host
+	s.hostMut.Unlock()
+	defer func() {
+		s.hostMut.Lock()
+		s.host = nil
+		s.hostMut.Unlock()
+	}()
+
 	defer func() {
 		s.winMut.Lock()
 		defer s.winMut.Unlock()
@@ -224,7 +251,7 @@ func (s *Service) Run(ctx context.Context, host service.Host) error {
 
 		unhealthyComponents := []string{}
 		for _, c := range components {
-			if c.Health.Health == component.HealthTypeUnhealthy {
+			if c.Health.Health == component.HealthTypeUnhealthy || c.Health.Health == component.HealthTypeCrashLooping {
 				unhealthyComponents = append(unhealthyComponents, c.ComponentName)
 			}
 		}
@@ -242,6 +269,9 @@ func (s *Service) Run(ctx context.Context, host service.Host) error {
 		promhttp.HandlerFor(s.gatherer, promhttp.HandlerOpts{}),
 	)
 	if s.opts.EnablePProf {
+		// CPU profiles wait for the ones recorded by the component CPU
+		// sampler and support bundles instead of failing.
+		r.Handle("/debug/pprof/profile", componentprof.CPUProfileHandler(http.HandlerFunc(httppprof.Profile)))
 		r.PathPrefix("/debug/pprof").Handler(http.DefaultServeMux)
 	}
 
@@ -278,6 +308,19 @@ func (s *Service) Run(ctx context.Context, host service.Host) error {
 		}).Methods(http.MethodGet, http.MethodPost)
 	}
 
+	if s.opts.ReloadStatusFunc != nil {
+		r.HandleFunc("/-/reload/status", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(s.opts.ReloadStatusFunc()); err != nil {
+				level.Error(s.log).Log("msg", "failed to encode reload status", "err", err)
+			}
+		}).Methods(http.MethodGet)
+	}
+
+	if s.opts.DryRunFunc != nil {
+		r.HandleFunc("/-/dry-run", s.dryRunHandler).Methods(http.MethodPost)
+	}
+
 	// Wire in support bundle generator
 	r.HandleFunc("/-/support", s.generateSupportBundleHandler(host)).Methods("GET")
 
@@ -524,6 +567,20 @@ func (s *Service) Data() any {
 				return (&net.Dialer{}).DialContext(ctx, network, address)
 			}
 		},
+
+		ComponentHealth: func(id component.ID) (component.Health, error) {
+			s.hostMut.RLock()
+			defer s.hostMut.RUnlock()
+
+			if s.host == nil {
+				return component.Health{}, fmt.Errorf("the http service is not running yet")
+			}
+			info, err := s.host.GetComponent(id, component.InfoOptions{GetHealth: true})
+			if err != nil {
+				return component.Health{}, err
+			}
+			return info.Health, nil
+		},
 	}
 }
 
@@ -543,6 +600,12 @@ type Data struct {
 	// address is MemoryListenAddr. If address is not MemoryListenAddr, DialFunc
 	// establishes an outbound network connection.
 	DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// ComponentHealth returns the health of the component with the given ID,
+	// for components which report on the health of the components configured
+	// in their arguments. ComponentHealth returns an error if the HTTP service
+	// isn't running or the component doesn't exist.
+	ComponentHealth func(id component.ID) (component.Health, error)
 }
 
 // HTTPPathForComponent returns the full HTTP path for a given global component
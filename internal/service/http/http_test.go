@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/grafana/alloy/internal/component"
@@ -67,6 +68,55 @@ func TestHTTP(t *testing.T) {
 
 		require.Equal(t, http.StatusOK, resp.StatusCode)
 	})
+
+	util.Eventually(t, func(t require.TestingT) {
+		cli, err := config.NewClientFromConfig(config.HTTPClientConfig{}, "test")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/-/reload/status", env.ListenAddr()), nil)
+		require.NoError(t, err)
+
+		resp, err := cli.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		buf, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"outcome": "succeeded"}`, string(buf))
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	})
+
+	dryRunTests := []struct {
+		contentType string
+		body        string
+		expect      string
+	}{
+		{contentType: "text/plain", body: `logging {}`, expect: `{"config.alloy": "logging {}"}`},
+		{contentType: "application/json", body: `{"sources": {"a.alloy": "logging {}"}}`, expect: `{"a.alloy": "logging {}"}`},
+	}
+	for _, tc := range dryRunTests {
+		util.Eventually(t, func(t require.TestingT) {
+			cli, err := config.NewClientFromConfig(config.HTTPClientConfig{}, "test")
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/-/dry-run", env.ListenAddr()), strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+
+			resp, err := cli.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			buf, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expect, string(buf))
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		})
+	}
 }
 
 func TestTLS(t *testing.T) {
@@ -330,6 +380,40 @@ func TestUnhealthy(t *testing.T) {
 	})
 }
 
+func TestData_ComponentHealth(t *testing.T) {
+	ctx := componenttest.TestContext(t)
+
+	env, err := newTestEnvironment(t)
+	require.NoError(t, err)
+
+	env.components = []*component.Info{
+		{
+			ID:            component.ID{LocalID: "testCompId"},
+			ComponentName: "testCompName",
+			Health:        component.Health{Health: component.HealthTypeUnhealthy, Message: "broken"},
+		},
+	}
+	require.NoError(t, env.ApplyConfig(""))
+
+	data := env.svc.Data().(Data)
+
+	_, err = data.ComponentHealth(component.ID{LocalID: "testCompId"})
+	require.EqualError(t, err, "the http service is not running yet")
+
+	go func() {
+		require.NoError(t, env.Run(ctx))
+	}()
+
+	util.Eventually(t, func(t require.TestingT) {
+		health, err := data.ComponentHealth(component.ID{LocalID: "testCompId"})
+		require.NoError(t, err)
+		require.Equal(t, env.components[0].Health, health)
+	})
+
+	_, err = data.ComponentHealth(component.ID{LocalID: "missing"})
+	require.ErrorIs(t, err, component.ErrComponentNotFound)
+}
+
 type testEnvironment struct {
 	svc        *Service
 	addr       string
@@ -347,8 +431,16 @@ func newTestEnvironment(t *testing.T) (*testEnvironment, error) {
 		Tracer:   noop.NewTracerProvider(),
 		Gatherer: prometheus.NewRegistry(),
 
-		ReadyFunc:  func() bool { return true },
-		ReloadFunc: func() error { return nil },
+		ReadyFunc:        func() bool { return true },
+		ReloadFunc:       func() error { return nil },
+		ReloadStatusFunc: func() any { return map[string]string{"outcome": "succeeded"} },
+		DryRunFunc: func(sources map[string][]byte) (any, error) {
+			res := make(map[string]string, len(sources))
+			for name, bb := range sources {
+				res[name] = string(bb)
+			}
+			return res, nil
+		},
 
 		HTTPListenAddr:   fmt.Sprintf("127.0.0.1:%d", port),
 		MemoryListenAddr: "alloy.internal:12345",
@@ -383,8 +475,13 @@ type fakeHost struct {
 
 var _ service.Host = (fakeHost{})
 
-func (fakeHost) GetComponent(id component.ID, opts component.InfoOptions) (*component.Info, error) {
-	return nil, fmt.Errorf("no such component %s", id)
+func (f fakeHost) GetComponent(id component.ID, opts component.InfoOptions) (*component.Info, error) {
+	for _, info := range f.components {
+		if info.ID == id {
+			return info, nil
+		}
+	}
+	return nil, component.ErrComponentNotFound
 }
 
 func (f fakeHost) ListComponents(moduleID string, opts component.InfoOptions) ([]*component.Info, error) {
@@ -15,7 +15,9 @@ import (
 	"time"
 
 	"github.com/grafana/alloy/internal/build"
+	"github.com/grafana/alloy/internal/runtime/componentprof"
 	"github.com/grafana/alloy/internal/static/server"
+	"github.com/grafana/alloy/internal/util/profilerate"
 	"github.com/mackerelio/go-osstat/uptime"
 	"gopkg.in/yaml.v3"
 )
@@ -43,6 +45,7 @@ type Bundle struct {
 	blockBuf             *bytes.Buffer
 	mutexBuf             *bytes.Buffer
 	cpuBuf               *bytes.Buffer
+	cpuComponents        []byte
 }
 
 // Metadata contains general runtime information about the current Alloy environment.
@@ -75,13 +78,13 @@ func ExportSupportBundle(ctx context.Context, runtimeFlags []string, srvAddress
 		return nil, fmt.Errorf("failed to get peer details: %s", err)
 	}
 
-	// The block profiler is disabled by default. Temporarily enable recording
-	// of all blocking events. Also, temporarily record all mutex contentions,
-	// and defer restoring of earlier mutex profiling fraction.
-	runtime.SetBlockProfileRate(1)
+	// Temporarily record all blocking events and mutex contentions, and
+	// defer restoring the earlier block profile rate and mutex profiling
+	// fraction.
+	oldBlock := profilerate.SetBlockProfileRate(1)
 	old := runtime.SetMutexProfileFraction(1)
 	defer func() {
-		runtime.SetBlockProfileRate(0)
+		profilerate.SetBlockProfileRate(oldBlock)
 		runtime.SetMutexProfileFraction(old)
 	}()
 
@@ -109,8 +112,13 @@ func ExportSupportBundle(ctx context.Context, runtimeFlags []string, srvAddress
 		blockBuf     bytes.Buffer
 		mutexBuf     bytes.Buffer
 	)
+	unlockCPUProfile, err := componentprof.LockCPUProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for another CPU profile to finish: %w", err)
+	}
 	err = pprof.StartCPUProfile(&cpuBuf)
 	if err != nil {
+		unlockCPUProfile()
 		return nil, err
 	}
 	deadline, _ := ctx.Deadline()
@@ -118,6 +126,8 @@ func ExportSupportBundle(ctx context.Context, runtimeFlags []string, srvAddress
 	// the rest of the bundle to be exported successfully.
 	time.Sleep(time.Until(deadline) - 200*time.Millisecond)
 	pprof.StopCPUProfile()
+	unlockCPUProfile()
+	cpuComponents := componentsCPUTop(cpuBuf.Bytes())
 
 	p := pprof.Lookup("heap")
 	if err := p.WriteTo(&heapBuf, 0); err != nil {
@@ -159,11 +169,26 @@ func ExportSupportBundle(ctx context.Context, runtimeFlags []string, srvAddress
 		blockBuf:             &blockBuf,
 		mutexBuf:             &mutexBuf,
 		cpuBuf:               &cpuBuf,
+		cpuComponents:        cpuComponents,
 	}
 
 	return bundle, nil
 }
 
+// componentsCPUTop returns the components which used the most CPU time in
+// the CPU profile, as a table.
+func componentsCPUTop(cpuProfile []byte) []byte {
+	p, err := componentprof.ParseCPUProfile(cpuProfile)
+	if err != nil {
+		return []byte(fmt.Sprintf("failed to parse CPU profile: %s\n", err))
+	}
+	var buf bytes.Buffer
+	if err := componentprof.WriteTop(&buf, p, 20); err != nil {
+		return []byte(fmt.Sprintf("failed to write CPU usage by component: %s\n", err))
+	}
+	return buf.Bytes()
+}
+
 func retrieveAPIEndpoint(httpClient http.Client, srvAddress, endpoint string) ([]byte, error) {
 	url := fmt.Sprintf("http://%s/%s", srvAddress, endpoint)
 	resp, err := httpClient.Get(url)
@@ -221,6 +246,7 @@ func ServeSupportBundle(rw http.ResponseWriter, b *Bundle, logsBuf *bytes.Buffer
 		"alloy-environment.txt":          b.environmentVariables,
 		"alloy-logs.txt":                 logsBuf.Bytes(),
 		"pprof/cpu.pprof":                b.cpuBuf.Bytes(),
+		"pprof/cpu-components.txt":       b.cpuComponents,
 		"pprof/heap.pprof":               b.heapBuf.Bytes(),
 		"pprof/goroutine.pprof":          b.goroutineBuf.Bytes(),
 		"pprof/mutex.pprof":              b.mutexBuf.Bytes(),
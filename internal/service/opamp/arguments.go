@@ -0,0 +1,90 @@
+package opamp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grafana/alloy/internal/alloyseed"
+	"github.com/grafana/alloy/internal/component/common/config"
+	"github.com/grafana/alloy/syntax"
+	"github.com/grafana/alloy/syntax/alloytypes"
+	"github.com/open-telemetry/opamp-go/client/types"
+)
+
+// Arguments holds runtime settings for the opamp service.
+type Arguments struct {
+	URL               string                       `alloy:"url,attr,optional"`
+	ID                string                       `alloy:"id,attr,optional"`
+	Attributes        map[string]string            `alloy:"attributes,attr,optional"`
+	HeartbeatInterval time.Duration                `alloy:"heartbeat_interval,attr,optional"`
+	Headers           map[string]alloytypes.Secret `alloy:"http_headers,attr,optional"`
+	TLSConfig         *config.TLSConfig            `alloy:"tls_config,block,optional"`
+}
+
+// Make sure Arguments implements the syntax.Defaulter interface
+var _ syntax.Defaulter = (*Arguments)(nil)
+
+// Make sure Arguments implements the syntax.Validator interface
+var _ syntax.Validator = (*Arguments)(nil)
+
+// getDefaultArguments populates the default values for the Arguments struct.
+func getDefaultArguments() Arguments {
+	return Arguments{
+		ID:                alloyseed.Get().UID,
+		Attributes:        make(map[string]string),
+		HeartbeatInterval: 30 * time.Second,
+	}
+}
+
+// SetToDefault implements syntax.Defaulter.
+func (a *Arguments) SetToDefault() {
+	*a = getDefaultArguments()
+}
+
+// Validate implements syntax.Validator.
+func (a *Arguments) Validate() error {
+	if a.HeartbeatInterval < time.Second {
+		return fmt.Errorf("heartbeat_interval must be at least \"1s\", got %q", a.HeartbeatInterval)
+	}
+
+	if a.URL != "" {
+		u, err := url.Parse(a.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "ws", "wss":
+		default:
+			return fmt.Errorf("url must use one of the http, https, ws or wss schemes, got %q", u.Scheme)
+		}
+	}
+
+	if a.TLSConfig != nil {
+		return a.TLSConfig.Validate()
+	}
+
+	return nil
+}
+
+// instanceUID returns the OpAMP instance UID for the configured ID. IDs which
+// are already UUIDs are used as-is, other IDs are hashed into a UUID so that
+// the instance UID is stable across restarts.
+func (a *Arguments) instanceUID() types.InstanceUid {
+	id, err := uuid.Parse(a.ID)
+	if err != nil {
+		id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(a.ID))
+	}
+	return types.InstanceUid(id)
+}
+
+// usesWebSocket returns true if the OpAMP server must be reached over a
+// WebSocket rather than with plain HTTP polling.
+func (a *Arguments) usesWebSocket() bool {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "ws" || u.Scheme == "wss"
+}
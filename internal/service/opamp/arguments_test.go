@@ -0,0 +1,76 @@
+package opamp
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/grafana/alloy/syntax"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         string
+		expectedErr string
+	}{
+		{
+			name: "empty",
+			cfg:  ``,
+		},
+		{
+			name: "http",
+			cfg:  `url = "https://opamp.example.com/v1/opamp"`,
+		},
+		{
+			name: "websocket",
+			cfg: `
+				url                = "wss://opamp.example.com/v1/opamp"
+				heartbeat_interval = "1m"
+				http_headers       = {"Authorization" = "Bearer token"}
+			`,
+		},
+		{
+			name:        "invalid scheme",
+			cfg:         `url = "grpc://opamp.example.com"`,
+			expectedErr: `url must use one of the http, https, ws or wss schemes, got "grpc"`,
+		},
+		{
+			name: "heartbeat too short",
+			cfg: `
+				url                = "https://opamp.example.com/v1/opamp"
+				heartbeat_interval = "10ms"
+			`,
+			expectedErr: `heartbeat_interval must be at least "1s"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args Arguments
+			err := syntax.Unmarshal([]byte(tc.cfg), &args)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInstanceUID(t *testing.T) {
+	id := uuid.New()
+	args := Arguments{ID: id.String()}
+	require.Equal(t, [16]byte(id), [16]byte(args.instanceUID()))
+
+	// IDs which aren't UUIDs are hashed into a stable UUID.
+	args = Arguments{ID: "my-alloy"}
+	require.Equal(t, args.instanceUID(), (&Arguments{ID: "my-alloy"}).instanceUID())
+	require.NotEqual(t, args.instanceUID(), (&Arguments{ID: "other-alloy"}).instanceUID())
+}
+
+func TestUsesWebSocket(t *testing.T) {
+	require.True(t, (&Arguments{URL: "ws://localhost:4320/v1/opamp"}).usesWebSocket())
+	require.True(t, (&Arguments{URL: "wss://localhost:4320/v1/opamp"}).usesWebSocket())
+	require.False(t, (&Arguments{URL: "https://localhost:4320/v1/opamp"}).usesWebSocket())
+}
@@ -0,0 +1,25 @@
+package opamp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/open-telemetry/opamp-go/client/types"
+)
+
+// clientLogger adapts a go-kit logger to the logger of the OpAMP client.
+type clientLogger struct {
+	l log.Logger
+}
+
+var _ types.Logger = clientLogger{}
+
+func (c clientLogger) Debugf(_ context.Context, format string, v ...any) {
+	level.Debug(c.l).Log("msg", fmt.Sprintf(format, v...))
+}
+
+func (c clientLogger) Errorf(_ context.Context, format string, v ...any) {
+	level.Error(c.l).Log("msg", fmt.Sprintf(format, v...))
+}
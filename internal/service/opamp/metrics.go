@@ -0,0 +1,66 @@
+package opamp
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	connected       prometheus.Gauge
+	lastLoadSuccess prometheus.Gauge
+	totalFailures   prometheus.Counter
+	totalAttempts   prometheus.Counter
+}
+
+func registerMetrics(reg prometheus.Registerer) *metrics {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	m := &metrics{
+		connected: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "opamp_connected",
+				Help: "Whether the last attempt to reach the OpAMP server was successful",
+			},
+		),
+		lastLoadSuccess: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "opamp_last_load_successful",
+				Help: "OpAMP remote config loaded successfully",
+			},
+		),
+		totalFailures: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "opamp_load_failures_total",
+				Help: "OpAMP remote configuration load failures",
+			},
+		),
+		totalAttempts: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "opamp_load_attempts_total",
+				Help: "Attempts to load OpAMP remote configuration",
+			},
+		),
+	}
+
+	// Register metrics safely - ignore AlreadyRegisteredError
+	safeRegister(reg, m.connected)
+	safeRegister(reg, m.lastLoadSuccess)
+	safeRegister(reg, m.totalFailures)
+	safeRegister(reg, m.totalAttempts)
+
+	return m
+}
+
+// safeRegister registers a metric with the registerer, ignoring AlreadyRegisteredError
+func safeRegister(reg prometheus.Registerer, c prometheus.Collector) {
+	err := reg.Register(c)
+	if err != nil {
+		var alreadyRegErr prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegErr) {
+			panic(err)
+		}
+	}
+}
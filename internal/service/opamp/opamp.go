@@ -0,0 +1,437 @@
+// Package opamp implements a service which lets an OpAMP server manage Alloy.
+package opamp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/alloy/internal/featuregate"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/service"
+	"github.com/grafana/alloy/internal/useragent"
+	"github.com/grafana/alloy/syntax/ast"
+	"github.com/open-telemetry/opamp-go/client"
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/prometheus/client_golang/prometheus"
+	promconfig "github.com/prometheus/common/config"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServiceName defines the name used for the opamp service.
+const ServiceName = "opamp"
+
+const (
+	// healthCheckInterval is how often component health is checked for
+	// changes which must be reported to the OpAMP server.
+	healthCheckInterval = 10 * time.Second
+
+	// stopTimeout is how long to wait for the OpAMP client to stop.
+	stopTimeout = 5 * time.Second
+)
+
+// capabilities are the OpAMP capabilities supported by the service. Packages
+// aren't supported since Alloy doesn't manage its own installation.
+const capabilities = protobufs.AgentCapabilities_AgentCapabilities_ReportsStatus |
+	protobufs.AgentCapabilities_AgentCapabilities_AcceptsRemoteConfig |
+	protobufs.AgentCapabilities_AgentCapabilities_ReportsRemoteConfig |
+	protobufs.AgentCapabilities_AgentCapabilities_ReportsEffectiveConfig |
+	protobufs.AgentCapabilities_AgentCapabilities_ReportsHealth |
+	protobufs.AgentCapabilities_AgentCapabilities_ReportsHeartbeat |
+	protobufs.AgentCapabilities_AgentCapabilities_AcceptsRestartCommand
+
+// Options are used to configure the opamp service. Options are constant for
+// the lifetime of the opamp service.
+type Options struct {
+	Logger      log.Logger            // Where to send logs.
+	StoragePath string                // Where to cache configuration on-disk.
+	ConfigPath  string                // Where the root config file is.
+	Metrics     prometheus.Registerer // Where to send metrics to.
+
+	// ReloadFunc reloads the root configuration. It is called when the OpAMP
+	// server sends a restart command. If nil, only the remote configuration is
+	// reloaded.
+	ReloadFunc func() error
+}
+
+// Service implements a service which connects Alloy to an OpAMP server. The
+// remote configuration offered by the server is loaded as a module in an
+// isolated controller.
+type Service struct {
+	opts           Options
+	metrics        *metrics
+	cachePath      string
+	healthInterval time.Duration
+	startTime      time.Time
+
+	// updateCh is signaled when the arguments change.
+	updateCh chan struct{}
+
+	// applyMut serializes loads of the remote configuration.
+	applyMut sync.Mutex
+
+	mut          sync.RWMutex
+	args         Arguments
+	host         service.Host
+	ctrl         service.Controller
+	sources      map[string]*ast.File
+	remoteConfig *protobufs.AgentRemoteConfig // Last successfully applied remote config.
+	remoteStatus *protobufs.RemoteConfigStatus
+	astFile      *ast.File
+	client       client.OpAMPClient // Only written from Run.
+
+	// Only accessed from Run.
+	clientArgs Arguments
+	lastHealth *protobufs.ComponentHealth
+}
+
+var _ service.Service = (*Service)(nil)
+
+// New returns a new instance of the opamp service.
+func New(opts Options) (*Service, error) {
+	opampPath := filepath.Join(opts.StoragePath, ServiceName)
+	err := os.MkdirAll(opampPath, 0750)
+	if err != nil {
+		opts.Logger.Log("level", "error", "msg", "failed to create opamp storage directory", "path", opampPath, "err", err)
+		return nil, err
+	}
+
+	return &Service{
+		opts:           opts,
+		metrics:        registerMetrics(opts.Metrics),
+		cachePath:      filepath.Join(opampPath, "remote_config.pb"),
+		healthInterval: healthCheckInterval,
+		startTime:      time.Now(),
+		updateCh:       make(chan struct{}, 1),
+		remoteStatus: &protobufs.RemoteConfigStatus{
+			LastRemoteConfigHash: []byte{},
+			Status:               protobufs.RemoteConfigStatuses_RemoteConfigStatuses_UNSET,
+		},
+	}, nil
+}
+
+// Data includes information associated with the opamp service.
+type Data struct {
+	// Host exposes the Host of the isolated controller that is created by the
+	// opamp service.
+	Host service.Host
+}
+
+// Data returns an instance of [Data]. Calls to Data are cachable by the
+// caller.
+func (s *Service) Data() any {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	if s.ctrl == nil {
+		return Data{Host: nil}
+	}
+	hp, ok := s.ctrl.(hostProvider)
+	if !ok {
+		return Data{Host: nil}
+	}
+	return Data{Host: hp.GetHost()}
+}
+
+// hostProvider is implemented by controllers which expose their own host,
+// such as the isolated controllers created by the runtime.
+type hostProvider interface {
+	GetHost() service.Host
+}
+
+// Definition returns the definition of the opamp service.
+func (s *Service) Definition() service.Definition {
+	return service.Definition{
+		Name:       ServiceName,
+		ConfigType: Arguments{},
+		DependsOn:  nil, // opamp has no dependencies.
+		Stability:  featuregate.StabilityExperimental,
+	}
+}
+
+// Run implements [service.Service] and starts the opamp service. It will run
+// until the provided context is canceled.
+func (s *Service) Run(ctx context.Context, host service.Host) error {
+	ctrl := host.NewController(ServiceName)
+	s.mut.Lock()
+	s.host = host
+	s.ctrl = ctrl
+	s.mut.Unlock()
+
+	// Load the cached remote configuration so that Alloy keeps running it
+	// while the OpAMP server can't be reached.
+	s.loadCachedConfig()
+
+	go ctrl.Run(ctx)
+
+	s.restartClient(ctx)
+	defer s.stopClient()
+
+	ticker := time.NewTicker(s.healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.updateCh:
+			s.restartClient(ctx)
+		case <-ticker.C:
+			s.reportHealth()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Update implements [service.Service] and applies settings.
+func (s *Service) Update(newConfig any) error {
+	newArgs := newConfig.(Arguments)
+
+	s.mut.Lock()
+	s.args = newArgs
+	s.mut.Unlock()
+
+	select {
+	case s.updateCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// SetSources sets the sources of the root configuration on reload, so that
+// they're reported to the OpAMP server as part of the effective config.
+func (s *Service) SetSources(sources map[string]*ast.File) {
+	s.mut.Lock()
+	s.sources = sources
+	s.mut.Unlock()
+
+	s.updateEffectiveConfig(context.Background())
+}
+
+// restartClient (re)connects to the OpAMP server if the arguments changed
+// since the client was started.
+func (s *Service) restartClient(ctx context.Context) {
+	s.mut.RLock()
+	args := s.args
+	s.mut.RUnlock()
+
+	if s.client != nil && reflect.DeepEqual(args, s.clientArgs) {
+		return
+	}
+	s.stopClient()
+
+	// We either never set the block on the first place, or recently removed
+	// it.
+	if args.URL == "" {
+		return
+	}
+
+	c, err := s.startClient(ctx, args)
+	if err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to start OpAMP client", "url", args.URL, "err", err)
+		return
+	}
+	s.mut.Lock()
+	s.client = c
+	s.mut.Unlock()
+	s.clientArgs = args
+}
+
+func (s *Service) startClient(ctx context.Context, args Arguments) (client.OpAMPClient, error) {
+	var c client.OpAMPClient
+	if args.usesWebSocket() {
+		c = client.NewWebSocket(clientLogger{s.opts.Logger})
+	} else {
+		c = client.NewHTTP(clientLogger{s.opts.Logger})
+	}
+
+	if err := c.SetAgentDescription(agentDescription(args)); err != nil {
+		return nil, err
+	}
+	// The health must be set before the capabilities which include reporting
+	// it.
+	s.lastHealth = s.buildHealth()
+	if err := c.SetHealth(s.lastHealth); err != nil {
+		return nil, err
+	}
+	caps := capabilities
+	if err := c.SetCapabilities(&caps); err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("User-Agent", useragent.Get())
+	for k, v := range args.Headers {
+		header.Set(k, string(v))
+	}
+
+	var tlsConfig *tls.Config
+	if args.TLSConfig != nil {
+		var err error
+		tlsConfig, err = promconfig.NewTLSConfig(args.TLSConfig.Convert())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	heartbeat := args.HeartbeatInterval
+	err := c.Start(ctx, types.StartSettings{
+		OpAMPServerURL:     args.URL,
+		Header:             header,
+		TLSConfig:          tlsConfig,
+		InstanceUid:        args.instanceUID(),
+		HeartbeatInterval:  &heartbeat,
+		RemoteConfigStatus: s.getRemoteConfigStatus(),
+		Callbacks: types.Callbacks{
+			OnConnect: func(context.Context) {
+				s.metrics.connected.Set(1)
+				level.Debug(s.opts.Logger).Log("msg", "connected to OpAMP server", "url", args.URL)
+			},
+			OnConnectFailed: func(_ context.Context, err error) {
+				s.metrics.connected.Set(0)
+				level.Warn(s.opts.Logger).Log("msg", "failed to connect to OpAMP server", "url", args.URL, "err", err)
+			},
+			OnError: func(_ context.Context, err *protobufs.ServerErrorResponse) {
+				level.Error(s.opts.Logger).Log("msg", "OpAMP server returned an error", "err", err.GetErrorMessage())
+			},
+			OnMessage: func(ctx context.Context, msg *types.MessageData) {
+				s.onMessage(ctx, c, msg)
+			},
+			OnCommand: func(_ context.Context, cmd *protobufs.ServerToAgentCommand) error {
+				return s.onCommand(cmd)
+			},
+			GetEffectiveConfig: func(context.Context) (*protobufs.EffectiveConfig, error) {
+				return s.effectiveConfig()
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *Service) stopClient() {
+	if s.client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	if err := s.client.Stop(ctx); err != nil {
+		level.Warn(s.opts.Logger).Log("msg", "failed to stop OpAMP client", "err", err)
+	}
+	s.mut.Lock()
+	s.client = nil
+	s.mut.Unlock()
+	s.metrics.connected.Set(0)
+}
+
+func (s *Service) onMessage(ctx context.Context, c client.OpAMPClient, msg *types.MessageData) {
+	if msg.RemoteConfig != nil {
+		s.applyRemoteConfig(ctx, c, msg.RemoteConfig)
+	}
+	if msg.PackagesAvailable != nil {
+		level.Warn(s.opts.Logger).Log("msg", "ignoring packages offered by the OpAMP server, package management is not supported")
+	}
+	if msg.AgentIdentification != nil {
+		level.Warn(s.opts.Logger).Log("msg", "ignoring new instance UID offered by the OpAMP server, set the id argument of the opamp block instead")
+	}
+}
+
+// onCommand handles a command sent by the OpAMP server. Restarting is done by
+// reloading the configuration rather than by exiting the process, which would
+// require a supervisor to start Alloy again.
+func (s *Service) onCommand(cmd *protobufs.ServerToAgentCommand) error {
+	if cmd.GetType() != protobufs.CommandType_CommandType_Restart {
+		return fmt.Errorf("unsupported command %s", cmd.GetType())
+	}
+
+	level.Info(s.opts.Logger).Log("msg", "reloading configuration on request of the OpAMP server")
+	if s.opts.ReloadFunc != nil {
+		if err := s.opts.ReloadFunc(); err != nil {
+			level.Error(s.opts.Logger).Log("msg", "failed to reload configuration", "err", err)
+			return err
+		}
+	}
+
+	s.applyMut.Lock()
+	defer s.applyMut.Unlock()
+
+	s.mut.RLock()
+	cfg := s.remoteConfig
+	s.mut.RUnlock()
+	if cfg == nil {
+		return nil
+	}
+	if err := s.loadRemoteConfig(cfg); err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to reload remote configuration", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) updateEffectiveConfig(ctx context.Context) {
+	s.mut.RLock()
+	c := s.client
+	s.mut.RUnlock()
+	if c == nil {
+		return
+	}
+
+	if err := c.UpdateEffectiveConfig(ctx); err != nil {
+		level.Warn(s.opts.Logger).Log("msg", "failed to report effective configuration", "err", err)
+	}
+}
+
+func (s *Service) getRemoteConfigStatus() *protobufs.RemoteConfigStatus {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return proto.Clone(s.remoteStatus).(*protobufs.RemoteConfigStatus)
+}
+
+func (s *Service) setRemoteConfigStatus(c client.OpAMPClient, status protobufs.RemoteConfigStatuses, hash []byte, errorMessage string) {
+	s.mut.Lock()
+	s.remoteStatus = &protobufs.RemoteConfigStatus{
+		LastRemoteConfigHash: hash,
+		Status:               status,
+		ErrorMessage:         errorMessage,
+	}
+	s.mut.Unlock()
+
+	if c == nil {
+		return
+	}
+	if err := c.SetRemoteConfigStatus(s.getRemoteConfigStatus()); err != nil {
+		level.Warn(s.opts.Logger).Log("msg", "failed to report remote configuration status", "err", err)
+	}
+}
+
+// GetHost returns the host for the opamp service.
+func GetHost(host service.Host) (service.Host, error) {
+	svc, found := host.GetService(ServiceName)
+	if !found {
+		return nil, fmt.Errorf("opamp service not available")
+	}
+
+	data := svc.Data().(Data)
+	if data.Host == nil {
+		return nil, fmt.Errorf("opamp service startup in progress")
+	}
+	return data.Host, nil
+}
+
+// GetCachedAstFile returns the AST file that was parsed from the remote
+// configuration.
+func (s *Service) GetCachedAstFile() *ast.File {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.astFile
+}
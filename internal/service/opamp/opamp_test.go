@@ -0,0 +1,431 @@
+package opamp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grafana/alloy/internal/component"
+	_ "github.com/grafana/alloy/internal/component/loki/process"
+	"github.com/grafana/alloy/internal/featuregate"
+	alloy_runtime "github.com/grafana/alloy/internal/runtime"
+	"github.com/grafana/alloy/internal/runtime/logging"
+	"github.com/grafana/alloy/internal/service"
+	"github.com/grafana/alloy/internal/service/livedebugging"
+	"github.com/grafana/alloy/internal/util"
+	"github.com/grafana/alloy/syntax/ast"
+	"github.com/grafana/alloy/syntax/parser"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/open-telemetry/opamp-go/server"
+	serverTypes "github.com/open-telemetry/opamp-go/server/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+const (
+	goodConfig = `loki.process "test" {
+	forward_to = []
+}`
+	badConfig = `loki.process "test" {
+	forward_to = [nope.receiver]
+}`
+)
+
+func TestRemoteConfig(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.setRemoteConfig(goodConfig, "hash-1")
+
+	env := newTestEnvironment(t)
+	env.start(t, srv.url)
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		status := srv.lastRemoteConfigStatus()
+		assert.Equal(c, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, status.GetStatus())
+		assert.Equal(c, []byte("hash-1"), status.GetLastRemoteConfigHash())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The remote configuration is running in the isolated controller and is
+	// reported as part of the health and the effective config.
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		health := srv.lastHealth()
+		if !assert.NotNil(c, health) {
+			return
+		}
+		assert.True(c, health.GetHealthy())
+		assert.Contains(c, health.GetComponentHealthMap(), "opamp/loki.process.test")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		files := srv.lastEffectiveConfig().GetConfigMap().GetConfigMap()
+		if assert.Contains(c, files, ServiceName) {
+			assert.Contains(c, string(files[ServiceName].GetBody()), `loki.process "test"`)
+		}
+		assert.Contains(c, files, "config.alloy")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	description := srv.firstMessage().GetAgentDescription()
+	require.Contains(t, description.GetIdentifyingAttributes(), stringKeyValue("service.instance.id", "alloy-test"))
+	require.Contains(t, description.GetNonIdentifyingAttributes(), stringKeyValue("env", "test"))
+
+	// The configuration is cached on disk and loaded on startup, even if the
+	// OpAMP server can't be reached.
+	_, err := os.Stat(env.svc.cachePath)
+	require.NoError(t, err)
+	env.stop()
+
+	cached := newTestEnvironmentAt(t, env.storagePath)
+	cached.start(t, "")
+	require.Eventually(t, func() bool {
+		return cached.remoteComponent() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRemoteConfigFailure(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.setRemoteConfig(goodConfig, "hash-1")
+
+	env := newTestEnvironment(t)
+	env.start(t, srv.url)
+
+	require.Eventually(t, func() bool {
+		return srv.lastRemoteConfigStatus().GetStatus() == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED
+	}, 5*time.Second, 10*time.Millisecond)
+
+	srv.setRemoteConfig(badConfig, "hash-2")
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		status := srv.lastRemoteConfigStatus()
+		assert.Equal(c, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, status.GetStatus())
+		assert.Equal(c, []byte("hash-2"), status.GetLastRemoteConfigHash())
+		assert.Contains(c, status.GetErrorMessage(), "nope")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		health := srv.lastHealth()
+		return health != nil && !health.GetHealthy()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The last applied configuration is restored.
+	require.NotNil(t, env.remoteComponent())
+	require.Equal(t, float64(1), getCounterValue(t, env.reg, "opamp_load_failures_total"))
+}
+
+func TestUnsupportedContentType(t *testing.T) {
+	_, err := remoteConfigSource(&protobufs.AgentRemoteConfig{
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"collector.yaml": {Body: []byte("receivers: {}"), ContentType: "text/yaml"},
+			},
+		},
+	})
+	require.ErrorContains(t, err, `unsupported content type "text/yaml"`)
+
+	b, err := remoteConfigSource(&protobufs.AgentRemoteConfig{
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"b.alloy": {Body: []byte("b"), ContentType: contentType},
+				"a.alloy": {Body: []byte("a")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "a\nb\n", string(b))
+}
+
+func TestRestartCommand(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.setRemoteConfig(goodConfig, "hash-1")
+
+	var reloads atomic.Int32
+	env := newTestEnvironment(t)
+	env.svc.opts.ReloadFunc = func() error {
+		reloads.Inc()
+		return nil
+	}
+	env.start(t, srv.url)
+
+	require.Eventually(t, func() bool {
+		return srv.lastRemoteConfigStatus().GetStatus() == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED
+	}, 5*time.Second, 10*time.Millisecond)
+
+	srv.sendCommand(protobufs.CommandType_CommandType_Restart)
+	require.Eventually(t, func() bool {
+		return reloads.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotNil(t, env.remoteComponent())
+}
+
+func TestWithoutURL(t *testing.T) {
+	env := newTestEnvironment(t)
+	env.start(t, "")
+
+	require.Eventually(t, func() bool {
+		_, err := GetHost(env.host)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, env.remoteComponent())
+}
+
+type testEnvironment struct {
+	storagePath string
+	reg         *prometheus.Registry
+	svc         *Service
+	host        *fakeHost
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func newTestEnvironment(t *testing.T) *testEnvironment {
+	return newTestEnvironmentAt(t, t.TempDir())
+}
+
+func newTestEnvironmentAt(t *testing.T, storagePath string) *testEnvironment {
+	reg := prometheus.NewRegistry()
+	svc, err := New(Options{
+		Logger:      util.TestLogger(t),
+		StoragePath: storagePath,
+		Metrics:     reg,
+	})
+	require.NoError(t, err)
+	svc.healthInterval = 10 * time.Millisecond
+
+	return &testEnvironment{
+		storagePath: storagePath,
+		reg:         reg,
+		svc:         svc,
+	}
+}
+
+func (env *testEnvironment) start(t *testing.T, url string) {
+	args := getDefaultArguments()
+	args.URL = url
+	args.ID = "alloy-test"
+	args.Attributes = map[string]string{"env": "test"}
+	require.NoError(t, args.Validate())
+	// The lower limit of the heartbeat_interval argument would slow our tests
+	// considerably; let's artificially lower it after the initial validation
+	// has taken place.
+	args.HeartbeatInterval = 20 * time.Millisecond
+	require.NoError(t, env.svc.Update(args))
+
+	file, err := parser.ParseFile("config.alloy", []byte(`logging {}`))
+	require.NoError(t, err)
+	env.svc.SetSources(map[string]*ast.File{"config.alloy": file})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	env.host = &fakeHost{svc: env.svc}
+	env.cancel = cancel
+	env.done = make(chan struct{})
+	go func() {
+		defer close(env.done)
+		require.NoError(t, env.svc.Run(ctx, env.host))
+	}()
+	t.Cleanup(env.stop)
+}
+
+func (env *testEnvironment) stop() {
+	env.cancel()
+	<-env.done
+}
+
+// remoteComponent returns the component of the remote configuration, or nil
+// if it's not running.
+func (env *testEnvironment) remoteComponent() *component.Info {
+	host, err := GetHost(env.host)
+	if err != nil {
+		return nil
+	}
+	info, err := host.GetComponent(component.ID{LocalID: "loki.process.test"}, component.InfoOptions{})
+	if err != nil {
+		return nil
+	}
+	return info
+}
+
+func getCounterValue(t *testing.T, reg *prometheus.Registry, name string) float64 {
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() == name {
+			return f.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+// fakeServer is a local OpAMP server which records the messages it receives
+// and offers a remote configuration and commands to the agent.
+type fakeServer struct {
+	url string
+
+	mut             sync.Mutex
+	messages        []*protobufs.AgentToServer
+	health          *protobufs.ComponentHealth
+	status          *protobufs.RemoteConfigStatus
+	effectiveConfig *protobufs.EffectiveConfig
+	remoteConfig    *protobufs.AgentRemoteConfig
+	command         *protobufs.ServerToAgentCommand
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	s := &fakeServer{}
+
+	handler, connContext, err := server.New(clientLogger{util.TestLogger(t)}).Attach(server.Settings{
+		Callbacks: serverTypes.Callbacks{
+			OnConnecting: func(*http.Request) serverTypes.ConnectionResponse {
+				return serverTypes.ConnectionResponse{
+					Accept: true,
+					ConnectionCallbacks: serverTypes.ConnectionCallbacks{
+						OnMessage: func(_ context.Context, _ serverTypes.Connection, msg *protobufs.AgentToServer) *protobufs.ServerToAgent {
+							return s.onMessage(msg)
+						},
+					},
+				}
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	httpSrv := httptest.NewUnstartedServer(http.HandlerFunc(handler))
+	httpSrv.Config.ConnContext = connContext
+	httpSrv.Start()
+	t.Cleanup(httpSrv.Close)
+
+	s.url = httpSrv.URL + "/v1/opamp"
+	return s
+}
+
+func (s *fakeServer) onMessage(msg *protobufs.AgentToServer) *protobufs.ServerToAgent {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.messages = append(s.messages, msg)
+	if msg.GetHealth() != nil {
+		s.health = msg.GetHealth()
+	}
+	if msg.GetRemoteConfigStatus() != nil {
+		s.status = msg.GetRemoteConfigStatus()
+	}
+	if msg.GetEffectiveConfig() != nil {
+		s.effectiveConfig = msg.GetEffectiveConfig()
+	}
+
+	resp := &protobufs.ServerToAgent{
+		InstanceUid:  msg.GetInstanceUid(),
+		RemoteConfig: s.remoteConfig,
+		Command:      s.command,
+	}
+	s.command = nil
+	return resp
+}
+
+func (s *fakeServer) setRemoteConfig(content string, hash string) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.remoteConfig = &protobufs.AgentRemoteConfig{
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"config.alloy": {Body: []byte(content), ContentType: contentType},
+			},
+		},
+		ConfigHash: []byte(hash),
+	}
+}
+
+func (s *fakeServer) sendCommand(cmd protobufs.CommandType) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.command = &protobufs.ServerToAgentCommand{Type: cmd}
+}
+
+func (s *fakeServer) firstMessage() *protobufs.AgentToServer {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if len(s.messages) == 0 {
+		return nil
+	}
+	return s.messages[0]
+}
+
+func (s *fakeServer) lastRemoteConfigStatus() *protobufs.RemoteConfigStatus {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.status
+}
+
+func (s *fakeServer) lastHealth() *protobufs.ComponentHealth {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.health
+}
+
+func (s *fakeServer) lastEffectiveConfig() *protobufs.EffectiveConfig {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.effectiveConfig
+}
+
+type fakeHost struct {
+	svc *Service
+}
+
+var _ service.Host = (*fakeHost)(nil)
+
+func (*fakeHost) GetComponent(id component.ID, opts component.InfoOptions) (*component.Info, error) {
+	return nil, fmt.Errorf("no such component %s", id)
+}
+
+func (*fakeHost) ListComponents(moduleID string, opts component.InfoOptions) ([]*component.Info, error) {
+	if moduleID == "" {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("no such module %q", moduleID)
+}
+
+func (*fakeHost) GetServiceConsumers(_ string) []service.Consumer { return nil }
+
+func (f *fakeHost) GetService(name string) (service.Service, bool) {
+	if name == ServiceName {
+		return f.svc, true
+	}
+	return nil, false
+}
+
+func (*fakeHost) NewController(id string) service.Controller {
+	logger, _ := logging.New(io.Discard, logging.DefaultOptions)
+	ctrl := alloy_runtime.New(alloy_runtime.Options{
+		ControllerID:    ServiceName,
+		Logger:          logger,
+		Tracer:          nil,
+		DataPath:        "",
+		MinStability:    featuregate.StabilityGenerallyAvailable,
+		Reg:             prometheus.NewRegistry(),
+		OnExportsChange: func(map[string]interface{}) {},
+		Services:        []service.Service{livedebugging.New()},
+	})
+
+	return serviceController{ctrl}
+}
+
+type serviceController struct {
+	f *alloy_runtime.Runtime
+}
+
+func (sc serviceController) Run(ctx context.Context) { sc.f.Run(ctx) }
+func (sc serviceController) LoadSource(b []byte, args map[string]any, configPath string) (*ast.File, error) {
+	source, err := alloy_runtime.ParseSource("", b)
+	if err != nil {
+		return nil, err
+	}
+	return source.SourceFiles()[""], sc.f.LoadSource(source, args, configPath)
+}
+func (sc serviceController) Ready() bool           { return sc.f.Ready() }
+func (sc serviceController) GetHost() service.Host { return sc.f }
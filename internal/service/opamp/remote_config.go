@@ -0,0 +1,178 @@
+package opamp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/syntax/diag"
+	"github.com/open-telemetry/opamp-go/client"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"google.golang.org/protobuf/proto"
+)
+
+// contentType is the content type of Alloy configuration files. Remote
+// configuration files without a content type are also assumed to be Alloy
+// configuration files.
+const contentType = "text/x-alloy"
+
+// applyRemoteConfig loads the remote configuration offered by the OpAMP
+// server and reports the result. If the configuration fails to load, the last
+// applied configuration is loaded again to restore component health.
+func (s *Service) applyRemoteConfig(ctx context.Context, c client.OpAMPClient, cfg *protobufs.AgentRemoteConfig) {
+	s.applyMut.Lock()
+	defer s.applyMut.Unlock()
+
+	hash := cfg.GetConfigHash()
+	if hash == nil {
+		hash = []byte{}
+	}
+
+	// The server offers the same configuration until it's told about the
+	// result of applying it, so only load each configuration once.
+	if len(hash) > 0 && bytes.Equal(hash, s.getRemoteConfigStatus().GetLastRemoteConfigHash()) {
+		return
+	}
+
+	level.Info(s.opts.Logger).Log("msg", "attempting to load new remote configuration", "config_hash", fmt.Sprintf("%x", hash))
+	s.metrics.totalAttempts.Inc()
+	s.setRemoteConfigStatus(c, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING, hash, "")
+
+	if err := s.loadRemoteConfig(cfg); err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to load remote configuration", "config_hash", fmt.Sprintf("%x", hash), "err", err)
+		s.metrics.totalFailures.Inc()
+		s.metrics.lastLoadSuccess.Set(0)
+		s.setRemoteConfigStatus(c, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, hash, getErrorMessage(err))
+
+		s.mut.RLock()
+		previous := s.remoteConfig
+		s.mut.RUnlock()
+		if previous == nil {
+			previous = &protobufs.AgentRemoteConfig{}
+		}
+		if err := s.loadRemoteConfig(previous); err != nil {
+			level.Error(s.opts.Logger).Log("msg", "failed to restore the last applied remote configuration", "err", err)
+		}
+		return
+	}
+
+	s.mut.Lock()
+	s.remoteConfig = cfg
+	s.mut.Unlock()
+
+	s.metrics.lastLoadSuccess.Set(1)
+	s.setRemoteConfigStatus(c, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, hash, "")
+	s.setCachedConfig(cfg)
+	level.Info(s.opts.Logger).Log("msg", "successfully loaded remote configuration", "config_hash", fmt.Sprintf("%x", hash))
+
+	if err := c.UpdateEffectiveConfig(ctx); err != nil {
+		level.Warn(s.opts.Logger).Log("msg", "failed to report effective configuration", "err", err)
+	}
+}
+
+// loadRemoteConfig loads the files of cfg as a single module in the isolated
+// controller of the service.
+func (s *Service) loadRemoteConfig(cfg *protobufs.AgentRemoteConfig) error {
+	b, err := remoteConfigSource(cfg)
+	if err != nil {
+		return err
+	}
+
+	s.mut.RLock()
+	ctrl := s.ctrl
+	s.mut.RUnlock()
+	if ctrl == nil {
+		return fmt.Errorf("controller not available - loadRemoteConfig called before Run()")
+	}
+
+	file, err := ctrl.LoadSource(b, nil, s.opts.ConfigPath)
+	if err != nil {
+		return err
+	}
+
+	s.mut.Lock()
+	s.astFile = file
+	s.mut.Unlock()
+	return nil
+}
+
+// remoteConfigSource concatenates the files of cfg in the order of their
+// names.
+func remoteConfigSource(cfg *protobufs.AgentRemoteConfig) ([]byte, error) {
+	files := cfg.GetConfig().GetConfigMap()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		f := files[name]
+		if f.GetContentType() != "" && f.GetContentType() != contentType {
+			return nil, fmt.Errorf("remote configuration file %q has unsupported content type %q, expected %q", name, f.GetContentType(), contentType)
+		}
+		buf.Write(f.GetBody())
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// loadCachedConfig loads the last remote configuration which was applied
+// successfully, if any.
+func (s *Service) loadCachedConfig() {
+	b, err := os.ReadFile(s.cachePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	} else if err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to read from cache", "cache_path", s.cachePath, "err", err)
+		return
+	}
+
+	var cfg protobufs.AgentRemoteConfig
+	if err := proto.Unmarshal(b, &cfg); err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to decode cached remote configuration", "cache_path", s.cachePath, "err", err)
+		return
+	}
+
+	s.applyMut.Lock()
+	defer s.applyMut.Unlock()
+	if err := s.loadRemoteConfig(&cfg); err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to load from cache", "cache_path", s.cachePath, "err", err)
+		return
+	}
+
+	s.mut.Lock()
+	s.remoteConfig = &cfg
+	s.mut.Unlock()
+	s.metrics.lastLoadSuccess.Set(1)
+	s.setRemoteConfigStatus(nil, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, cfg.GetConfigHash(), "")
+
+	level.Info(s.opts.Logger).Log("msg", "successfully loaded remote configuration from cache",
+		"config_hash", fmt.Sprintf("%x", cfg.GetConfigHash()), "cache_path", s.cachePath)
+}
+
+func (s *Service) setCachedConfig(cfg *protobufs.AgentRemoteConfig) {
+	b, err := proto.Marshal(cfg)
+	if err == nil {
+		err = os.WriteFile(s.cachePath, b, 0640)
+	}
+	if err != nil {
+		level.Error(s.opts.Logger).Log("msg", "failed to flush remote configuration contents the on-disk cache", "err", err)
+	}
+}
+
+// getErrorMessage extracts the best error message from an error,
+// using AllMessages() for diagnostic errors and Error() for others.
+func getErrorMessage(err error) string {
+	var diags diag.Diagnostics
+	if errors.As(err, &diags) {
+		return strings.TrimSpace(diags.AllMessages())
+	}
+	return err.Error()
+}
@@ -0,0 +1,198 @@
+package opamp
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+
+	"github.com/grafana/alloy/internal/build"
+	"github.com/grafana/alloy/internal/component"
+	"github.com/grafana/alloy/internal/runtime/logging/level"
+	"github.com/grafana/alloy/internal/useragent"
+	"github.com/grafana/alloy/syntax/ast"
+	"github.com/grafana/alloy/syntax/printer"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceName is the service.name attribute reported to the OpAMP server.
+const serviceName = "io.grafana.alloy"
+
+// agentDescription builds the description of Alloy reported to the OpAMP
+// server.
+func agentDescription(args Arguments) *protobufs.AgentDescription {
+	hostname, _ := os.Hostname()
+
+	nonIdentifying := map[string]string{
+		"os.type":           runtime.GOOS,
+		"host.arch":         runtime.GOARCH,
+		"host.name":         hostname,
+		"alloy.deploy_mode": useragent.GetDeployMode(),
+		"alloy.user_agent":  useragent.Get(),
+	}
+	for k, v := range args.Attributes {
+		nonIdentifying[k] = v
+	}
+
+	return &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("service.name", serviceName),
+			stringKeyValue("service.version", build.Version),
+			stringKeyValue("service.instance.id", args.ID),
+		},
+		NonIdentifyingAttributes: keyValues(nonIdentifying),
+	}
+}
+
+func keyValues(m map[string]string) []*protobufs.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	kvs := make([]*protobufs.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, stringKeyValue(k, m[k]))
+	}
+	return kvs
+}
+
+func stringKeyValue(key, value string) *protobufs.KeyValue {
+	return &protobufs.KeyValue{
+		Key: key,
+		Value: &protobufs.AnyValue{
+			Value: &protobufs.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+// effectiveConfig returns the configuration files of the root configuration
+// and the remote configuration, with secrets redacted. The remote
+// configuration is reported under the name of the service.
+func (s *Service) effectiveConfig() (*protobufs.EffectiveConfig, error) {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	files := make(map[string]*protobufs.AgentConfigFile, len(s.sources)+1)
+	for name, f := range s.sources {
+		b, err := printFileRedacted(f)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = &protobufs.AgentConfigFile{Body: b, ContentType: contentType}
+	}
+	if s.astFile != nil {
+		b, err := printFileRedacted(s.astFile)
+		if err != nil {
+			return nil, err
+		}
+		files[ServiceName] = &protobufs.AgentConfigFile{Body: b, ContentType: contentType}
+	}
+
+	return &protobufs.EffectiveConfig{
+		ConfigMap: &protobufs.AgentConfigMap{ConfigMap: files},
+	}, nil
+}
+
+func printFileRedacted(f *ast.File) ([]byte, error) {
+	c := printer.Config{
+		RedactSecrets: true,
+	}
+
+	var buf bytes.Buffer
+	if err := c.Fprint(&buf, f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reportHealth reports the health of Alloy to the OpAMP server if it changed
+// since the last report.
+func (s *Service) reportHealth() {
+	s.mut.RLock()
+	c := s.client
+	s.mut.RUnlock()
+	if c == nil {
+		return
+	}
+
+	health := s.buildHealth()
+	if proto.Equal(health, s.lastHealth) {
+		return
+	}
+	if err := c.SetHealth(health); err != nil {
+		level.Warn(s.opts.Logger).Log("msg", "failed to report health", "err", err)
+		return
+	}
+	s.lastHealth = health
+}
+
+// buildHealth returns the health of Alloy, with the health of each running
+// component. Alloy is healthy if all of its components are healthy and the
+// last remote configuration was loaded successfully.
+func (s *Service) buildHealth() *protobufs.ComponentHealth {
+	s.mut.RLock()
+	host, ctrl := s.host, s.ctrl
+	status := s.remoteStatus
+	s.mut.RUnlock()
+
+	// The IDs of the components of the remote configuration are prefixed with
+	// the ID of the isolated controller, which is the name of the service.
+	var components []*component.Info
+	if host != nil {
+		components = append(components, component.GetAllComponents(host, component.InfoOptions{GetHealth: true})...)
+	}
+	if hp, ok := ctrl.(hostProvider); ok {
+		components = append(components, component.GetAllComponents(hp.GetHost(), component.InfoOptions{GetHealth: true})...)
+	}
+
+	health := &protobufs.ComponentHealth{
+		Healthy:            true,
+		StartTimeUnixNano:  uint64(s.startTime.UnixNano()),
+		ComponentHealthMap: make(map[string]*protobufs.ComponentHealth, len(components)),
+	}
+
+	var unhealthy int
+	for _, info := range components {
+		healthy := componentHealthy(info.Health)
+		ch := &protobufs.ComponentHealth{
+			Healthy: healthy,
+			Status:  info.Health.Health.String(),
+		}
+		if !info.Health.UpdateTime.IsZero() {
+			ch.StatusTimeUnixNano = uint64(info.Health.UpdateTime.UnixNano())
+		}
+		if !healthy {
+			ch.LastError = info.Health.Message
+			unhealthy++
+		}
+		health.ComponentHealthMap[info.ID.String()] = ch
+	}
+
+	switch {
+	case status.GetStatus() == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED:
+		health.Healthy = false
+		health.LastError = "failed to load remote configuration: " + status.GetErrorMessage()
+	case unhealthy > 0:
+		health.Healthy = false
+		health.LastError = fmt.Sprintf("%d components are unhealthy", unhealthy)
+	}
+	if health.Healthy {
+		health.Status = component.HealthTypeHealthy.String()
+	} else {
+		health.Status = component.HealthTypeUnhealthy.String()
+	}
+	return health
+}
+
+func componentHealthy(h component.Health) bool {
+	switch h.Health {
+	case component.HealthTypeUnhealthy, component.HealthTypeCrashLooping, component.HealthTypeExited:
+		return false
+	default:
+		return true
+	}
+}
@@ -47,6 +47,10 @@ type configManager struct {
 	// Configuration file path for parsing
 	configPath string
 
+	// Loads configuration into the controller. It's loadSource unless it was
+	// wrapped with Options.WrapLoad.
+	load func(b []byte) error
+
 	// This is the hash of the arguments passed to the service. It is used to determine where
 	// to store and retrieve the cached configuration.
 	argsHash string
@@ -82,7 +86,7 @@ type configManager struct {
 }
 
 func newConfigManager(metrics *metrics, logger log.Logger, remotecfgPath string, ctrl service.Controller, configPath string) *configManager {
-	return &configManager{
+	cm := &configManager{
 		metrics:          metrics,
 		logger:           logger,
 		remotecfgPath:    remotecfgPath,
@@ -96,6 +100,8 @@ func newConfigManager(metrics *metrics, logger log.Logger, remotecfgPath string,
 			ErrorMessage: "",
 		},
 	}
+	cm.load = cm.loadSource
+	return cm
 }
 
 func (cm *configManager) setPollFrequency(t time.Duration) {
@@ -158,7 +164,11 @@ func (cm *configManager) parseAndLoad(b []byte) error {
 	if len(b) == 0 {
 		return nil
 	}
+	return cm.load(b)
+}
 
+// loadSource parses and loads b into the controller.
+func (cm *configManager) loadSource(b []byte) error {
 	cm.mut.RLock()
 	ctrl := cm.ctrl
 	configPath := cm.configPath
@@ -55,6 +55,11 @@ type Options struct {
 	StoragePath string                // Where to cache configuration on-disk.
 	ConfigPath  string                // Where the root config file is.
 	Metrics     prometheus.Registerer // Where to send metrics to.
+
+	// WrapLoad, if set, wraps the function which loads remote configuration
+	// into the isolated controller of the service, for example to roll back
+	// configurations which make components unhealthy.
+	WrapLoad func(load func(b []byte) error) func(b []byte) error
 }
 
 // New returns a new instance of the remotecfg service.
@@ -74,6 +79,9 @@ func New(opts Options) (*Service, error) {
 		metrics:     metrics,
 		cm:          newConfigManager(metrics, opts.Logger, remotecfgPath, nil, opts.ConfigPath),
 	}
+	if opts.WrapLoad != nil {
+		svc.cm.load = opts.WrapLoad(svc.cm.loadSource)
+	}
 
 	return svc, nil
 }
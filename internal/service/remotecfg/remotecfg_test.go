@@ -76,6 +76,27 @@ func TestOnDiskCache(t *testing.T) {
 	}, time.Second, 10*time.Millisecond)
 }
 
+func TestWrapLoad(t *testing.T) {
+	var loaded []string
+	svc, err := New(Options{
+		Logger:      util.TestLogger(t),
+		StoragePath: t.TempDir(),
+		WrapLoad: func(load func(b []byte) error) func(b []byte) error {
+			return func(b []byte) error {
+				loaded = append(loaded, string(b))
+				return load(b)
+			}
+		},
+	})
+	require.NoError(t, err)
+	svc.cm.setController(fakeHost{}.NewController(ServiceName))
+
+	cfg := `loki.process "default" { forward_to = [] }`
+	require.NoError(t, svc.cm.parseAndLoad([]byte(cfg)))
+	require.Equal(t, []string{cfg}, loaded)
+	require.NotNil(t, svc.cm.getAstFile())
+}
+
 func TestGoodBadGood(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 
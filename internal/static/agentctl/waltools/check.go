@@ -0,0 +1,149 @@
+package waltools
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/tsdb/record"
+	"github.com/prometheus/prometheus/tsdb/wlog"
+	"github.com/prometheus/prometheus/util/compression"
+)
+
+// CheckResult holds the result of validating a WAL.
+type CheckResult struct {
+	// Checkpoint is the directory of the most recent checkpoint, if any.
+	Checkpoint string
+
+	// FirstSegment and LastSegment are the range of segments which were
+	// checked.
+	FirstSegment, LastSegment int
+
+	// Records is the number of valid records read before the first
+	// corruption, if any.
+	Records int
+
+	// Corruption is the first corruption found in the WAL, or nil if the WAL
+	// is valid.
+	Corruption *wlog.CorruptionErr
+}
+
+// Check reads every record in the latest checkpoint and the segments of the
+// WAL and validates that it can be decoded. It stops at the first corrupted
+// record.
+func Check(walDir string) (CheckResult, error) {
+	var res CheckResult
+
+	checkpoint, checkpointIdx, err := wlog.LastCheckpoint(walDir)
+	if err != nil && !errors.Is(err, record.ErrNotFound) {
+		return res, err
+	}
+	first, last, err := wlog.Segments(walDir)
+	if err != nil {
+		return res, err
+	}
+	if checkpoint != "" {
+		first = checkpointIdx + 1
+	}
+	res.Checkpoint = checkpoint
+	res.FirstSegment, res.LastSegment = first, last
+
+	if checkpoint != "" {
+		sr, err := wlog.NewSegmentsReader(checkpoint)
+		if err != nil {
+			return res, err
+		}
+		res.Corruption = checkRecords(wlog.NewReader(sr), checkpoint, &res.Records)
+		_ = sr.Close()
+		if res.Corruption != nil {
+			return res, nil
+		}
+	}
+
+	for i := first; i >= 0 && i <= last; i++ {
+		s, err := wlog.OpenReadSegment(wlog.SegmentName(walDir, i))
+		if err != nil {
+			return res, err
+		}
+		sr := wlog.NewSegmentBufReader(s)
+		res.Corruption = checkRecords(wlog.NewReader(sr), walDir, &res.Records)
+		_ = sr.Close()
+		if res.Corruption != nil {
+			// Records are read from a single segment, so the segment reported
+			// by the reader is always the one being checked.
+			res.Corruption.Segment = i
+			return res, nil
+		}
+	}
+
+	return res, nil
+}
+
+// checkRecords reads and decodes every record from r, returning the first
+// corruption found.
+func checkRecords(r *wlog.Reader, dir string, records *int) *wlog.CorruptionErr {
+	var dec record.Decoder
+
+	for r.Next() {
+		rec := r.Record()
+
+		var err error
+		switch dec.Type(rec) {
+		case record.Series:
+			_, err = dec.Series(rec, nil)
+		case record.Samples:
+			_, err = dec.Samples(rec, nil)
+		case record.Tombstones:
+			_, err = dec.Tombstones(rec, nil)
+		case record.Exemplars:
+			_, err = dec.Exemplars(rec, nil)
+		case record.Metadata:
+			_, err = dec.Metadata(rec, nil)
+		case record.HistogramSamples, record.CustomBucketsHistogramSamples:
+			_, err = dec.HistogramSamples(rec, nil)
+		case record.FloatHistogramSamples, record.CustomBucketsFloatHistogramSamples:
+			_, err = dec.FloatHistogramSamples(rec, nil)
+		}
+		if err != nil {
+			// The reader's offset points to the end of the record, so
+			// repairing at this offset drops the record.
+			return &wlog.CorruptionErr{
+				Dir:    dir,
+				Offset: r.Offset(),
+				Err:    err,
+			}
+		}
+		*records++
+	}
+
+	if err := r.Err(); err != nil {
+		var cerr *wlog.CorruptionErr
+		if errors.As(err, &cerr) {
+			return cerr
+		}
+		return &wlog.CorruptionErr{Dir: dir, Segment: -1, Offset: r.Offset(), Err: err}
+	}
+	return nil
+}
+
+// Repair truncates the WAL at the given corruption and deletes all segments
+// after it. Data after the corruption is lost. Corruptions in a checkpoint
+// can't be repaired.
+//
+// The WAL must not be in use by a running process.
+func Repair(walDir string, corruption *wlog.CorruptionErr) error {
+	if filepath.Clean(corruption.Dir) != filepath.Clean(walDir) {
+		return fmt.Errorf("corruption in %s can't be repaired; remove the directory to discard it", corruption.Dir)
+	}
+
+	w, err := wlog.NewSize(nil, prometheus.NewRegistry(), walDir, wlog.DefaultSegmentSize, compression.Snappy)
+	if err != nil {
+		return err
+	}
+	if err := w.Repair(corruption); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
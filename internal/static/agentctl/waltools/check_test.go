@@ -0,0 +1,44 @@
+package waltools
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prometheus/prometheus/tsdb/wlog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	walDir := setupTestWAL(t)
+
+	res, err := Check(walDir)
+	require.NoError(t, err)
+	require.Nil(t, res.Corruption)
+	require.NotEmpty(t, res.Checkpoint)
+	require.Equal(t, 2, res.FirstSegment)
+	require.Equal(t, 3, res.LastSegment)
+	require.Equal(t, 2, res.Records)
+}
+
+func TestCheckRepair(t *testing.T) {
+	walDir := setupTestWAL(t)
+
+	// Corrupt the segment holding the samples by flipping a byte inside the
+	// record.
+	segment := wlog.SegmentName(walDir, 2)
+	b, err := os.ReadFile(segment)
+	require.NoError(t, err)
+	b[10] ^= 0xff
+	require.NoError(t, os.WriteFile(segment, b, 0o644))
+
+	res, err := Check(walDir)
+	require.NoError(t, err)
+	require.NotNil(t, res.Corruption)
+	require.Equal(t, 2, res.Corruption.Segment)
+
+	require.NoError(t, Repair(walDir, res.Corruption))
+
+	res, err = Check(walDir)
+	require.NoError(t, err)
+	require.Nil(t, res.Corruption)
+}
@@ -0,0 +1,284 @@
+package waltools
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/tsdb/record"
+	"github.com/prometheus/prometheus/tsdb/wlog"
+)
+
+// ExportedSeries holds the samples found in the WAL for a single series.
+type ExportedSeries struct {
+	Labels     labels.Labels
+	Samples    []prompb.Sample
+	Histograms []prompb.Histogram
+}
+
+// ExportOptions controls which data is read from the WAL by ExportSeries and
+// Replay.
+type ExportOptions struct {
+	// Selector is a label selector used to filter series. Defaults to "{}".
+	Selector string
+
+	// MinTime and MaxTime, in milliseconds, bound the timestamps of the
+	// samples that are read. Samples outside of the range are ignored.
+	MinTime, MaxTime int64
+}
+
+func (o ExportOptions) withDefaults() ExportOptions {
+	if o.Selector == "" {
+		o.Selector = "{}"
+	}
+	if o.MinTime == 0 && o.MaxTime == 0 {
+		o.MinTime, o.MaxTime = math.MinInt64, math.MaxInt64
+	}
+	return o
+}
+
+func (o ExportOptions) inRange(t int64) bool {
+	return t >= o.MinTime && t <= o.MaxTime
+}
+
+// ExportSeries reads the WAL and returns every series matching the options
+// along with their samples, sorted by labels. Series without samples in the
+// requested time range are omitted.
+//
+// All matching data is held in memory; use a selector or time range to
+// limit the amount of data read from large WALs.
+func ExportSeries(walDir string, opts ExportOptions) ([]*ExportedSeries, error) {
+	opts = opts.withDefaults()
+
+	w, err := wlog.Open(nil, walDir)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	selector, err := parser.ParseMetricSelector(opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsByRef := make(map[chunks.HeadSeriesRef]labels.Labels)
+	err = walIterate(w, func(r *wlog.Reader) error {
+		return collectSeries(r, selector, labelsByRef)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not collect series: %w", err)
+	}
+
+	// Refs which share the same labels are merged into a single series.
+	seriesByLabels := make(map[string]*ExportedSeries)
+	seriesByRef := make(map[chunks.HeadSeriesRef]*ExportedSeries, len(labelsByRef))
+	for ref, lbls := range labelsByRef {
+		key := lbls.String()
+		s, ok := seriesByLabels[key]
+		if !ok {
+			s = &ExportedSeries{Labels: lbls}
+			seriesByLabels[key] = s
+		}
+		seriesByRef[ref] = s
+	}
+
+	err = walIterate(w, func(r *wlog.Reader) error {
+		return readSamples(r, opts, func(ref chunks.HeadSeriesRef, sample *prompb.Sample, hist *prompb.Histogram) {
+			s, ok := seriesByRef[ref]
+			if !ok {
+				return
+			}
+			if sample != nil {
+				s.Samples = append(s.Samples, *sample)
+			} else {
+				s.Histograms = append(s.Histograms, *hist)
+			}
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not collect samples: %w", err)
+	}
+
+	res := make([]*ExportedSeries, 0, len(seriesByLabels))
+	for _, s := range seriesByLabels {
+		if len(s.Samples) == 0 && len(s.Histograms) == 0 {
+			continue
+		}
+		sort.SliceStable(s.Samples, func(i, j int) bool { return s.Samples[i].Timestamp < s.Samples[j].Timestamp })
+		sort.SliceStable(s.Histograms, func(i, j int) bool { return s.Histograms[i].Timestamp < s.Histograms[j].Timestamp })
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		ni, nj := res[i].Labels.Get(model.MetricNameLabel), res[j].Labels.Get(model.MetricNameLabel)
+		if ni != nj {
+			return ni < nj
+		}
+		return labels.Compare(res[i].Labels, res[j].Labels) < 0
+	})
+	return res, nil
+}
+
+// readSamples calls f for every float sample and native histogram sample in
+// r within the time range of opts. Exactly one of sample or hist is non-nil.
+func readSamples(r *wlog.Reader, opts ExportOptions, f func(ref chunks.HeadSeriesRef, sample *prompb.Sample, hist *prompb.Histogram)) error {
+	var (
+		dec         record.Decoder
+		samples     []record.RefSample
+		histograms  []record.RefHistogramSample
+		fHistograms []record.RefFloatHistogramSample
+	)
+
+	for r.Next() {
+		rec := r.Record()
+
+		switch dec.Type(rec) {
+		case record.Samples:
+			var err error
+			samples, err = dec.Samples(rec, samples[:0])
+			if err != nil {
+				return err
+			}
+			for _, s := range samples {
+				if opts.inRange(s.T) {
+					f(s.Ref, &prompb.Sample{Timestamp: s.T, Value: s.V}, nil)
+				}
+			}
+		case record.HistogramSamples, record.CustomBucketsHistogramSamples:
+			var err error
+			histograms, err = dec.HistogramSamples(rec, histograms[:0])
+			if err != nil {
+				return err
+			}
+			for _, h := range histograms {
+				if opts.inRange(h.T) {
+					ph := prompb.FromIntHistogram(h.T, h.H)
+					f(h.Ref, nil, &ph)
+				}
+			}
+		case record.FloatHistogramSamples, record.CustomBucketsFloatHistogramSamples:
+			var err error
+			fHistograms, err = dec.FloatHistogramSamples(rec, fHistograms[:0])
+			if err != nil {
+				return err
+			}
+			for _, h := range fHistograms {
+				if opts.inRange(h.T) {
+					ph := prompb.FromFloatHistogram(h.T, h.FH)
+					f(h.Ref, nil, &ph)
+				}
+			}
+		}
+	}
+
+	return r.Err()
+}
+
+// WriteOpenMetrics writes the float samples of series to w in the
+// OpenMetrics text format. Series must be grouped by metric name, as
+// returned by ExportSeries. Staleness markers and native histograms aren't
+// representable in the text format and are skipped.
+func WriteOpenMetrics(w io.Writer, series []*ExportedSeries) error {
+	bw := bufio.NewWriter(w)
+
+	lastName := ""
+	for i, s := range series {
+		name := s.Labels.Get(model.MetricNameLabel)
+		if i == 0 || name != lastName {
+			if _, err := fmt.Fprintf(bw, "# TYPE %s unknown\n", name); err != nil {
+				return err
+			}
+			lastName = name
+		}
+
+		lbls := formatOpenMetricsLabels(s.Labels)
+		for _, sample := range s.Samples {
+			if value.IsStaleNaN(sample.Value) {
+				continue
+			}
+			_, err := fmt.Fprintf(bw, "%s%s %s %s\n",
+				name,
+				lbls,
+				formatOpenMetricsFloat(sample.Value),
+				strconv.FormatFloat(float64(sample.Timestamp)/1000, 'f', -1, 64),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := bw.WriteString("# EOF\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func formatOpenMetricsLabels(lbls labels.Labels) string {
+	var sb strings.Builder
+	lbls.Range(func(l labels.Label) {
+		if l.Name == model.MetricNameLabel {
+			return
+		}
+		if sb.Len() == 0 {
+			sb.WriteByte('{')
+		} else {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(l.Name)
+		sb.WriteString(`="`)
+		sb.WriteString(openMetricsLabelValueReplacer.Replace(l.Value))
+		sb.WriteByte('"')
+	})
+	if sb.Len() > 0 {
+		sb.WriteByte('}')
+	}
+	return sb.String()
+}
+
+var openMetricsLabelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func formatOpenMetricsFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, +1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+// WriteRemoteWriteRequest writes series to w as a single snappy-compressed
+// Prometheus remote write request, as it would be sent over the wire.
+func WriteRemoteWriteRequest(w io.Writer, series []*ExportedSeries) error {
+	req := prompb.WriteRequest{
+		Timeseries: make([]prompb.TimeSeries, 0, len(series)),
+	}
+	for _, s := range series {
+		req.Timeseries = append(req.Timeseries, prompb.TimeSeries{
+			Labels:     prompb.FromLabels(s.Labels, nil),
+			Samples:    s.Samples,
+			Histograms: s.Histograms,
+		})
+	}
+
+	buf, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(snappy.Encode(nil, buf))
+	return err
+}
@@ -0,0 +1,82 @@
+package waltools
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSeries(t *testing.T) {
+	walDir := setupTestWAL(t)
+
+	series, err := ExportSeries(walDir, ExportOptions{Selector: `{__name__="metric_1"}`})
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	// Series are sorted by labels; refs 3 and 99 share the same labels and
+	// are merged.
+	require.Equal(t, labels.FromStrings("__name__", "metric_1", "initial", "no", "instance", "test-instance", "job", "test-job"), series[0].Labels)
+	require.Equal(t, []prompb.Sample{{Timestamp: 4, Value: 1}}, series[0].Samples)
+	require.Equal(t, labels.FromStrings("__name__", "metric_1", "initial", "yes", "instance", "test-instance", "job", "test-job"), series[1].Labels)
+	require.Equal(t, []prompb.Sample{{Timestamp: 3, Value: 1}}, series[1].Samples)
+
+	// Series without samples in the time range are omitted.
+	series, err = ExportSeries(walDir, ExportOptions{MinTime: 19, MaxTime: 20})
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	require.Equal(t, "metric_9", series[0].Labels.Get("__name__"))
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	series := []*ExportedSeries{
+		{
+			Labels:  labels.FromStrings("__name__", "up", "job", "a"),
+			Samples: []prompb.Sample{{Timestamp: 1500, Value: 1}, {Timestamp: 2000, Value: 0}},
+		},
+		{
+			Labels:  labels.FromStrings("__name__", "up", "job", "quote\"d"),
+			Samples: []prompb.Sample{{Timestamp: 1000, Value: 1}},
+		},
+		{
+			Labels:  labels.FromStrings("__name__", "x"),
+			Samples: []prompb.Sample{{Timestamp: 1000, Value: 2.5}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteOpenMetrics(&buf, series))
+	require.Equal(t, strings.Join([]string{
+		`# TYPE up unknown`,
+		`up{job="a"} 1 1.5`,
+		`up{job="a"} 0 2`,
+		`up{job="quote\"d"} 1 1`,
+		`# TYPE x unknown`,
+		`x 2.5 1`,
+		`# EOF`,
+		``,
+	}, "\n"), buf.String())
+}
+
+func TestWriteRemoteWriteRequest(t *testing.T) {
+	series := []*ExportedSeries{{
+		Labels:  labels.FromStrings("__name__", "up", "job", "a"),
+		Samples: []prompb.Sample{{Timestamp: 1000, Value: 1}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRemoteWriteRequest(&buf, series))
+
+	decoded, err := snappy.Decode(nil, buf.Bytes())
+	require.NoError(t, err)
+	var req prompb.WriteRequest
+	require.NoError(t, req.Unmarshal(decoded))
+	require.Equal(t, []prompb.TimeSeries{{
+		Labels:  []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "a"}},
+		Samples: []prompb.Sample{{Timestamp: 1000, Value: 1}},
+	}}, req.Timeseries)
+}
@@ -0,0 +1,187 @@
+package waltools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/tsdb/wlog"
+)
+
+// ReplayOptions configures Replay.
+type ReplayOptions struct {
+	ExportOptions
+
+	// BatchSize is the maximum number of samples sent in a single request.
+	BatchSize int
+
+	// MaxRetries is the maximum number of times a request is retried after a
+	// recoverable error.
+	MaxRetries int
+
+	// MinBackoff is the initial delay between retries. It doubles after
+	// every attempt.
+	MinBackoff time.Duration
+}
+
+// ReplayStats holds statistics on the data sent by Replay.
+type ReplayStats struct {
+	Series     int
+	Samples    int
+	Histograms int
+	Requests   int
+}
+
+// Replay reads the WAL and sends every series matching the options to a
+// remote write endpoint using client. Samples are sent in the order they
+// were written to the WAL.
+func Replay(ctx context.Context, walDir string, client remote.WriteClient, opts ReplayOptions) (ReplayStats, error) {
+	opts.ExportOptions = opts.withDefaults()
+	if opts.BatchSize <= 0 {
+		return ReplayStats{}, fmt.Errorf("batch size must be greater than 0")
+	}
+
+	w, err := wlog.Open(nil, walDir)
+	if err != nil {
+		return ReplayStats{}, err
+	}
+	defer w.Close()
+
+	selector, err := parser.ParseMetricSelector(opts.Selector)
+	if err != nil {
+		return ReplayStats{}, err
+	}
+
+	labelsByRef := make(map[chunks.HeadSeriesRef]labels.Labels)
+	err = walIterate(w, func(r *wlog.Reader) error {
+		return collectSeries(r, selector, labelsByRef)
+	})
+	if err != nil {
+		return ReplayStats{}, fmt.Errorf("could not collect series: %w", err)
+	}
+
+	var (
+		stats      ReplayStats
+		sentSeries = make(map[chunks.HeadSeriesRef]struct{})
+		b          = newReplayBatch()
+	)
+
+	flush := func() error {
+		if b.size == 0 {
+			return nil
+		}
+		if err := sendWithRetries(ctx, client, b.request(), opts); err != nil {
+			return err
+		}
+		stats.Requests++
+		b.reset()
+		return nil
+	}
+
+	err = walIterate(w, func(r *wlog.Reader) error {
+		var sendErr error
+		err := readSamples(r, opts.ExportOptions, func(ref chunks.HeadSeriesRef, sample *prompb.Sample, hist *prompb.Histogram) {
+			lbls, ok := labelsByRef[ref]
+			if !ok || sendErr != nil {
+				return
+			}
+			if _, sent := sentSeries[ref]; !sent {
+				sentSeries[ref] = struct{}{}
+				stats.Series++
+			}
+
+			b.add(ref, lbls, sample, hist)
+			if sample != nil {
+				stats.Samples++
+			} else {
+				stats.Histograms++
+			}
+			if b.size >= opts.BatchSize {
+				sendErr = flush()
+			}
+		})
+		if sendErr != nil {
+			return sendErr
+		}
+		return err
+	})
+	if err != nil {
+		return stats, err
+	}
+	return stats, flush()
+}
+
+func sendWithRetries(ctx context.Context, client remote.WriteClient, req *prompb.WriteRequest, opts ReplayOptions) error {
+	buf, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	compressed := snappy.Encode(nil, buf)
+
+	backoff := opts.MinBackoff
+	for attempt := 0; ; attempt++ {
+		_, err := client.Store(ctx, compressed, attempt)
+		if err == nil {
+			return nil
+		}
+
+		var recoverable remote.RecoverableError
+		if !errors.As(err, &recoverable) || attempt >= opts.MaxRetries {
+			return fmt.Errorf("failed to send samples to %s: %w", client.Endpoint(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// replayBatch accumulates samples into a single remote write request.
+type replayBatch struct {
+	series map[chunks.HeadSeriesRef]int
+	req    prompb.WriteRequest
+	size   int
+}
+
+func newReplayBatch() *replayBatch {
+	return &replayBatch{series: make(map[chunks.HeadSeriesRef]int)}
+}
+
+func (b *replayBatch) add(ref chunks.HeadSeriesRef, lbls labels.Labels, sample *prompb.Sample, hist *prompb.Histogram) {
+	idx, ok := b.series[ref]
+	if !ok {
+		idx = len(b.req.Timeseries)
+		b.series[ref] = idx
+		b.req.Timeseries = append(b.req.Timeseries, prompb.TimeSeries{
+			Labels: prompb.FromLabels(lbls, nil),
+		})
+	}
+
+	ts := &b.req.Timeseries[idx]
+	if sample != nil {
+		ts.Samples = append(ts.Samples, *sample)
+	} else {
+		ts.Histograms = append(ts.Histograms, *hist)
+	}
+	b.size++
+}
+
+func (b *replayBatch) request() *prompb.WriteRequest {
+	return &b.req
+}
+
+func (b *replayBatch) reset() {
+	clear(b.series)
+	b.req = prompb.WriteRequest{}
+	b.size = 0
+}
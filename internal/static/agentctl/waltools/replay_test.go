@@ -0,0 +1,76 @@
+package waltools
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	walDir := setupTestWAL(t)
+
+	var (
+		mut      sync.Mutex
+		requests []prompb.WriteRequest
+		failures = 1
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		// Fail the first request with a recoverable error to exercise retries.
+		if failures > 0 {
+			failures--
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		compressed, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		buf, err := snappy.Decode(nil, compressed)
+		require.NoError(t, err)
+		var req prompb.WriteRequest
+		require.NoError(t, req.Unmarshal(buf))
+		requests = append(requests, req)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client, err := remote.NewWriteClient("test", &remote.ClientConfig{
+		URL:     &config.URL{URL: u},
+		Timeout: model.Duration(5 * time.Second),
+	})
+	require.NoError(t, err)
+
+	stats, err := Replay(t.Context(), walDir, client, ReplayOptions{
+		ExportOptions: ExportOptions{Selector: `{initial="yes"}`},
+		BatchSize:     4,
+		MaxRetries:    1,
+		MinBackoff:    time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ReplayStats{Series: 10, Samples: 10, Requests: 3}, stats)
+
+	mut.Lock()
+	defer mut.Unlock()
+	require.Len(t, requests, 3)
+	var samples int
+	for _, req := range requests {
+		for _, ts := range req.Timeseries {
+			samples += len(ts.Samples)
+		}
+	}
+	require.Equal(t, 10, samples)
+}
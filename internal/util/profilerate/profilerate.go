@@ -0,0 +1,34 @@
+// Package profilerate sets the block profile rate of the process and keeps
+// track of it, since the Go runtime doesn't expose it.
+package profilerate
+
+import (
+	"runtime"
+	"sync"
+)
+
+var (
+	mut       sync.Mutex
+	blockRate int
+)
+
+// SetBlockProfileRate calls runtime.SetBlockProfileRate and returns the
+// previous rate set through this package. Rates <= 0 disable the block
+// profile.
+func SetBlockProfileRate(rate int) (prev int) {
+	mut.Lock()
+	defer mut.Unlock()
+
+	prev = blockRate
+	blockRate = max(rate, 0)
+	runtime.SetBlockProfileRate(blockRate)
+	return prev
+}
+
+// BlockProfileRate returns the block profile rate last set with
+// SetBlockProfileRate.
+func BlockProfileRate() int {
+	mut.Lock()
+	defer mut.Unlock()
+	return blockRate
+}
@@ -0,0 +1,17 @@
+package profilerate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBlockProfileRate(t *testing.T) {
+	t.Cleanup(func() { SetBlockProfileRate(0) })
+
+	require.Equal(t, 0, SetBlockProfileRate(10_000))
+	require.Equal(t, 10_000, BlockProfileRate())
+
+	require.Equal(t, 10_000, SetBlockProfileRate(-1))
+	require.Equal(t, 0, BlockProfileRate())
+}
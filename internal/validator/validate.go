@@ -271,6 +271,19 @@ func (v *validator) validateImport(node *node, register bool, s *state) {
 	case importsource.BlockNameGit:
 		node.args = &importsource.GitArguments{}
 		s.graph.Add(node)
+	case importsource.BlockNameOCI:
+		// Check required stability level.
+		name := node.block.GetBlockName()
+		if err := featuregate.CheckAllowed(importsource.StabilityLevelOCI, v.minStability, fmt.Sprintf("config block %q", name)); err != nil {
+			node.diags.Add(diag.Diagnostic{
+				Severity: diag.SeverityLevelError,
+				StartPos: node.block.NamePos.Position(),
+				EndPos:   node.block.NamePos.Add(len(name) - 1).Position(),
+				Message:  err.Error(),
+			})
+		}
+		node.args = &importsource.OCIArguments{}
+		s.graph.Add(node)
 	}
 
 	if register {
@@ -416,7 +429,7 @@ func (v *validator) validateServices(s *state) {
 
 var configBlockNames = [...]string{
 	foreach.BlockName, argument.BlockName, export.BlockName, "logging", "tracing",
-	importsource.BlockNameFile, importsource.BlockNameString, importsource.BlockNameHTTP, importsource.BlockNameGit,
+	importsource.BlockNameFile, importsource.BlockNameString, importsource.BlockNameHTTP, importsource.BlockNameGit, importsource.BlockNameOCI,
 }
 
 // extractBlocks extracts configs, declares and components blocks from body
@@ -3,6 +3,7 @@ package vcs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
@@ -163,6 +164,32 @@ func (repo *GitRepo) CurrentRevision() (string, error) {
 	return ref.Hash().String(), nil
 }
 
+// VerifyRevision checks that the checked out revision is signed by one of the
+// OpenPGP keys of armoredKeyRing. If the revision is an annotated tag, either
+// the tag or the commit it points to must be signed.
+func (repo *GitRepo) VerifyRevision(armoredKeyRing string) error {
+	if tagRef, err := repo.repo.Tag(repo.opts.Revision); err == nil {
+		if tag, err := repo.repo.TagObject(tagRef.Hash()); err == nil {
+			if _, err := tag.Verify(armoredKeyRing); err == nil {
+				return nil
+			}
+		}
+	}
+
+	hash, err := repo.repo.ResolveRevision(plumbing.Revision(plumbing.HEAD))
+	if err != nil {
+		return err
+	}
+	commit, err := repo.repo.CommitObject(*hash)
+	if err != nil {
+		return err
+	}
+	if _, err := commit.Verify(armoredKeyRing); err != nil {
+		return fmt.Errorf("revision %s (commit %s) isn't signed by a trusted key: %w", repo.opts.Revision, hash, err)
+	}
+	return nil
+}
+
 // Depending on the type of revision we need to handle checkout differently.
 // Tags are checked out as branches
 // Branches as branches
@@ -2,8 +2,12 @@ package vcs_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -74,12 +78,58 @@ func Test_NonFastForward(t *testing.T) {
 	repo.validate(tracker, msg)
 }
 
+func Test_VerifyRevision(t *testing.T) {
+	branchName := "main"
+	repo, repoDirectory := initRepository(t, branchName)
+
+	signKey, err := openpgp.NewEntity("Go test", "", "go-test@example.com", nil)
+	require.NoError(t, err)
+	var keyRing strings.Builder
+	w, err := armor.Encode(&keyRing, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, signKey.Serialize(w))
+	require.NoError(t, w.Close())
+
+	repo.signKey = signKey
+	repo.commit()
+
+	tracker, err := vcs.NewGitRepo(t.Context(), t.TempDir(), vcs.GitRepoOptions{
+		Repository: repoDirectory,
+		Revision:   branchName,
+	})
+	require.NoError(t, err)
+	require.NoError(t, tracker.VerifyRevision(keyRing.String()))
+
+	// Unsigned commits are rejected.
+	repo.signKey = nil
+	repo.commit()
+	require.NoError(t, tracker.Update(t.Context()))
+	require.ErrorContains(t, tracker.VerifyRevision(keyRing.String()), "isn't signed by a trusted key")
+
+	// A signed annotated tag is accepted for an unsigned commit.
+	head, err := repo.repo.Head()
+	require.NoError(t, err)
+	_, err = repo.repo.CreateTag("v1.0.0", head.Hash(), &git.CreateTagOptions{
+		Message: "v1.0.0",
+		SignKey: signKey,
+	})
+	require.NoError(t, err)
+
+	tagTracker, err := vcs.NewGitRepo(t.Context(), t.TempDir(), vcs.GitRepoOptions{
+		Repository: repoDirectory,
+		Revision:   "v1.0.0",
+	})
+	require.NoError(t, err)
+	require.NoError(t, tagTracker.VerifyRevision(keyRing.String()))
+}
+
 type testRepository struct {
 	t           *testing.T
 	repo        *git.Repository
 	worktree    *git.Worktree
 	commitCount uint
 	filename    string
+	signKey     *openpgp.Entity // Key used to sign commits, if set.
 }
 
 func (repo *testRepository) CurrentRef() (string, error) {
@@ -176,7 +226,7 @@ func (r *testRepository) commit() string {
 	_, err = r.worktree.Add(".")
 	require.NoError(r.t, err)
 
-	_, err = r.worktree.Commit(msg, &git.CommitOptions{})
+	_, err = r.worktree.Commit(msg, &git.CommitOptions{SignKey: r.signKey})
 	require.NoError(r.t, err)
 
 	return msg
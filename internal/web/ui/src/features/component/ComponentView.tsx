@@ -140,6 +140,7 @@ export const ComponentView: FC<ComponentViewProps> = (props) => {
               )}
             </h1>
             <p>{props.component.health.message}</p>
+            {props.component.restarts > 0 && <p>Restarts after exiting with an error: {props.component.restarts}</p>}
           </blockquote>
         )}
 
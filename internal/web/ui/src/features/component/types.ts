@@ -33,6 +33,11 @@ export interface ComponentInfo {
    */
   health: ComponentHealth;
 
+  /**
+   * Number of times the component was restarted after exiting with an error.
+   */
+  restarts: number;
+
   /**
    * IDs of components which are referencing this component.
    */
@@ -86,6 +91,7 @@ export enum ComponentHealthState {
   UNHEALTHY = 'unhealthy',
   UNKNOWN = 'unknown',
   EXITED = 'exited',
+  CRASH_LOOPING = 'crashlooping',
 }
 
 /*